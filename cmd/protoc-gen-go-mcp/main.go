@@ -15,6 +15,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 
 	"github.com/statico/protoc-gen-go-mcp/pkg/generator"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -40,9 +41,33 @@ func main() {
 		"Remove the most common leading substring from every tool name prior to mangling",
 	)
 
+	schemaDescriptions := flagSet.Bool(
+		"schema_descriptions",
+		true,
+		"Emit the comments of fields, messages, enums and enum values as descriptions in the JSON schemas",
+	)
+
+	listAllTools := flagSet.Bool(
+		"list_all_tools",
+		false,
+		"Add a tool to every AIP-158 list method that follows next_page_token and returns the items of all pages, up to a budget",
+	)
+
+	runtime := flagSet.String(
+		"runtime",
+		string(generator.RuntimeMark3Labs),
+		"MCP SDK to generate code for: 'mark3labs' (github.com/mark3labs/mcp-go) or 'gosdk' (github.com/modelcontextprotocol/go-sdk)",
+	)
+
 	protogen.Options{
 		ParamFunc: flagSet.Set,
 	}.Run(func(gen *protogen.Plugin) error {
+		switch generator.Runtime(*runtime) {
+		case generator.RuntimeMark3Labs, generator.RuntimeGoSDK:
+		default:
+			return fmt.Errorf("unknown runtime %q, expected %q or %q", *runtime, generator.RuntimeMark3Labs, generator.RuntimeGoSDK)
+		}
+
 		// Set supported editions and features on the plugin
 		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
 		gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
@@ -52,7 +77,14 @@ func main() {
 			if !f.Generate {
 				continue
 			}
-			generator.NewFileGenerator(f, gen, *packagePrefix).Generate(*packageSuffix, *trimToolPrefixes)
+			fg := generator.NewFileGenerator(f, gen, *packagePrefix).UseRuntime(generator.Runtime(*runtime))
+			if !*schemaDescriptions {
+				fg.OmitDescriptions()
+			}
+			if *listAllTools {
+				fg.ListAllTools()
+			}
+			fg.Generate(*packageSuffix, *trimToolPrefixes)
 		}
 		return nil
 	})
@@ -0,0 +1,69 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// protoc-gen-mcp-schema writes a tools.json manifest for every proto file with tools. It
+// contains the same tool definitions as the code of protoc-gen-go-mcp, for MCP servers
+// that are not written in Go and for offline tests.
+package main
+
+import (
+	"flag"
+
+	"github.com/statico/protoc-gen-go-mcp/pkg/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func main() {
+	var flagSet flag.FlagSet
+	trimToolPrefixes := flagSet.Bool(
+		"trim_tool_prefixes",
+		false,
+		"Remove the most common leading substring from every tool name prior to mangling",
+	)
+	schemaDescriptions := flagSet.Bool(
+		"schema_descriptions",
+		true,
+		"Emit the comments of fields, messages, enums and enum values as descriptions in the JSON schemas",
+	)
+	listAllTools := flagSet.Bool(
+		"list_all_tools",
+		false,
+		"Add a tool to every AIP-158 list method that follows next_page_token and returns the items of all pages, up to a budget",
+	)
+
+	protogen.Options{
+		ParamFunc: flagSet.Set,
+	}.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+		gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+		gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
+
+		for _, f := range gen.Files {
+			if !f.Generate {
+				continue
+			}
+			fg := generator.NewFileGenerator(f, gen, "")
+			if !*schemaDescriptions {
+				fg.OmitDescriptions()
+			}
+			if *listAllTools {
+				fg.ListAllTools()
+			}
+			fg.GenerateManifest(*trimToolPrefixes)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// protoc-mcp-gateway serves the methods of a gRPC or Connect server as MCP tools, from
+// a descriptor set or the server reflection of the server.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/statico/protoc-gen-go-mcp/pkg/gateway"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func main() {
+	descriptorSet := flag.String("descriptor_set", "", "Path of a FileDescriptorSet with the services, as written by `buf build -o`")
+	reflect := flag.Bool("reflect", false, "Load the services with the server reflection of the gRPC server")
+	grpcTarget := flag.String("grpc", "", "Address of the gRPC server to forward tool calls to")
+	connectURL := flag.String("connect", "", "Base URL of the Connect server to forward tool calls to")
+	useTLS := flag.Bool("tls", false, "Connect to the gRPC server with TLS")
+	services := flag.String("services", "", "Comma separated full names of the services to expose, all services if empty")
+	provider := flag.String("provider", string(runtime.LLMProviderStandard), "LLM provider of the tool schemas: standard, openai, gemini or anthropic")
+	httpAddr := flag.String("http", "", "Serve MCP over streamable HTTP on this address instead of stdio")
+	forwardHeaders := flag.String("forward_headers", "", "Comma separated HTTP headers of MCP requests to forward to the server, such as Authorization")
+	flag.Parse()
+
+	if err := run(config{
+		descriptorSet:  *descriptorSet,
+		reflect:        *reflect,
+		grpcTarget:     *grpcTarget,
+		connectURL:     *connectURL,
+		useTLS:         *useTLS,
+		services:       *services,
+		provider:       runtime.LLMProvider(*provider),
+		httpAddr:       *httpAddr,
+		forwardHeaders: *forwardHeaders,
+	}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type config struct {
+	descriptorSet  string
+	reflect        bool
+	grpcTarget     string
+	connectURL     string
+	useTLS         bool
+	services       string
+	provider       runtime.LLMProvider
+	httpAddr       string
+	forwardHeaders string
+}
+
+func run(c config) error {
+	switch {
+	case (c.grpcTarget == "") == (c.connectURL == ""):
+		return errors.New("exactly one of -grpc and -connect is required")
+	case (c.descriptorSet == "") == !c.reflect:
+		return errors.New("exactly one of -descriptor_set and -reflect is required")
+	case c.reflect && c.grpcTarget == "":
+		return errors.New("-reflect requires -grpc")
+	}
+	switch c.provider {
+	case runtime.LLMProviderStandard, runtime.LLMProviderOpenAI, runtime.LLMProviderGemini, runtime.LLMProviderAnthropic:
+	default:
+		return fmt.Errorf("unknown provider %q", c.provider)
+	}
+
+	var transport gateway.Transport
+	var conn *grpc.ClientConn
+	if c.grpcTarget != "" {
+		creds := insecure.NewCredentials()
+		if c.useTLS {
+			creds = credentials.NewTLS(&tls.Config{})
+		}
+		var err error
+		conn, err = grpc.NewClient(c.grpcTarget, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		transport = gateway.NewGRPCTransport(conn)
+	} else {
+		transport = gateway.NewConnectTransport(http.DefaultClient, c.connectURL)
+	}
+
+	var files *protoregistry.Files
+	var names []string
+	if c.reflect {
+		var err error
+		files, names, err = gateway.ReflectFiles(context.Background(), conn)
+		if err != nil {
+			return err
+		}
+	} else {
+		var err error
+		files, err = gateway.LoadFileDescriptorSet(c.descriptorSet)
+		if err != nil {
+			return err
+		}
+	}
+	if services := splitList(c.services); len(services) > 0 {
+		names = services
+	}
+	services, err := gateway.Services(files, names...)
+	if err != nil {
+		return err
+	}
+
+	s := mcpserver.NewMCPServer("protoc-mcp-gateway", "1.0.0")
+	var opts []runtime.Option
+	if headers := splitList(c.forwardHeaders); len(headers) > 0 {
+		opts = append(opts, runtime.WithMetadataForwarder(runtime.MetadataForwarder{Headers: headers}))
+	}
+	if err := gateway.Register(s, services, transport, c.provider, opts...); err != nil {
+		return err
+	}
+
+	if c.httpAddr != "" {
+		return mcpserver.NewStreamableHTTPServer(s).Start(c.httpAddr)
+	}
+	return mcpserver.ServeStdio(s)
+}
+
+// splitList splits a comma-separated flag value, trimming the entries and skipping
+// empty ones.
+func splitList(value string) []string {
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,724 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dialect holds the LLM providers and the transforms deriving their JSON
+// schema dialects from the standard schemas. The runtime package re-exports it, the
+// schema package uses it directly so that it does not depend on the runtime.
+package dialect
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// LLMProvider represents different LLM providers for runtime selection
+type LLMProvider string
+
+const (
+	LLMProviderStandard LLMProvider = "standard"
+	LLMProviderOpenAI   LLMProvider = "openai"
+	LLMProviderGemini   LLMProvider = "gemini"
+	// LLMProviderAnthropic uses the standard schemas, Anthropic accepts full JSON Schema.
+	LLMProviderAnthropic LLMProvider = "anthropic"
+	// LLMProviderCustom uses the standard schemas rewritten with runtime.WithSchemaTransforms.
+	LLMProviderCustom LLMProvider = "custom"
+)
+
+// SchemaTransform is a named rewrite of a JSON schema. The generator emits one
+// standard schema per tool and derives the schemas of the provider variants with
+// transforms, see SchemaTransforms. The same transforms can be combined to build a
+// custom dialect at runtime, see runtime.WithSchemaTransforms.
+type SchemaTransform struct {
+	Name string
+	// Apply rewrites the schema in place.
+	Apply func(schema map[string]any)
+}
+
+var (
+	// InlineRefs replaces "$ref" references with copies of the referenced schemas.
+	// Recursive schemas are inlined into each other at most twice along any path,
+	// whichever messages recurse, deeper levels can only be null.
+	InlineRefs = SchemaTransform{Name: "inline-refs", Apply: inPlace(inlineRefs)}
+	// FlattenOneofs turns the oneOf groups of protobuf oneofs into nullable properties.
+	FlattenOneofs = SchemaTransform{Name: "flatten-oneofs", Apply: inPlace(flattenOneofs)}
+	// MapsToKVArrays turns maps, objects with additionalProperties, into arrays of key
+	// value pairs.
+	MapsToKVArrays = SchemaTransform{Name: "maps-to-kv-arrays", Apply: inPlace(mapsToKVArrays)}
+	// WKTAsString encodes free-form JSON values as JSON strings. These are the schemas
+	// of google.protobuf.Struct, Value, ListValue and Any.
+	WKTAsString = SchemaTransform{Name: "wkt-as-string", Apply: inPlace(wktAsString)}
+	// AllRequired marks every property as required and forbids additional properties.
+	// Optional object properties become nullable, so they can still be left unset.
+	AllRequired = SchemaTransform{Name: "all-required", Apply: inPlace(allRequired)}
+)
+
+// StripUnsupportedKeywords removes the keywords a dialect does not support. Removed
+// constraints are added to the description instead.
+func StripUnsupportedKeywords(dialect SchemaDialect) SchemaTransform {
+	return SchemaTransform{
+		Name: "strip-unsupported-keywords",
+		Apply: inPlace(func(schema map[string]any) map[string]any {
+			return stripUnsupported(schema, dialect)
+		}),
+	}
+}
+
+// SchemaDialect describes the subset of JSON Schema an LLM provider accepts.
+type SchemaDialect struct {
+	// Keywords are the supported keywords, nil if all are.
+	Keywords map[string]bool
+	// Formats are the supported string formats, nil if all are.
+	Formats map[string]bool
+	// StringEnumsOnly is set if "enum" is only supported for strings.
+	StringEnumsOnly bool
+	// NullableKeyword is set if schemas have a single type, and null is allowed with
+	// "nullable: true" like in OpenAPI 3.0.
+	NullableKeyword bool
+	// InlineRefs is set if "$ref" is not supported. References are inlined, and
+	// recursion is cut off, see InlineRefs.
+	InlineRefs bool
+}
+
+// Transforms returns the pipeline turning a standard schema into a schema of the
+// dialect. References are inlined if the dialect requires it and oneofs are flattened,
+// then the given transforms run and unsupported keywords are stripped.
+func (d SchemaDialect) Transforms(transforms ...SchemaTransform) []SchemaTransform {
+	var pipeline []SchemaTransform
+	if d.InlineRefs {
+		pipeline = append(pipeline, InlineRefs)
+	}
+	pipeline = append(pipeline, FlattenOneofs)
+	pipeline = append(pipeline, transforms...)
+	return append(pipeline, StripUnsupportedKeywords(d))
+}
+
+// OpenAIDialect is the JSON Schema subset of OpenAI structured outputs.
+// See https://platform.openai.com/docs/guides/structured-outputs#supported-schemas
+var OpenAIDialect = SchemaDialect{
+	Keywords: keywordSet(
+		"type", "description", "properties", "required", "additionalProperties", "items",
+		"enum", "const", "anyOf", "$ref", "$defs",
+		"pattern", "format", "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum",
+		"multipleOf", "minItems", "maxItems",
+	),
+	Formats: keywordSet("date-time", "time", "date", "duration", "email", "hostname", "ipv4", "ipv6", "uuid"),
+}
+
+// GeminiDialect is the OpenAPI 3.0 subset of Gemini function declarations.
+// See https://ai.google.dev/api/caching#Schema
+var GeminiDialect = SchemaDialect{
+	Keywords: keywordSet(
+		"type", "format", "description", "nullable", "enum", "properties", "required", "items",
+		"pattern", "minimum", "maximum", "minLength", "maxLength", "minItems", "maxItems",
+	),
+	Formats:         keywordSet("date-time"),
+	StringEnumsOnly: true,
+	NullableKeyword: true,
+	InlineRefs:      true,
+}
+
+// SchemaTransforms returns the transforms turning a standard schema into the schema
+// of the given provider.
+func SchemaTransforms(provider LLMProvider) []SchemaTransform {
+	switch provider {
+	case LLMProviderOpenAI:
+		return OpenAIDialect.Transforms(WKTAsString, MapsToKVArrays, AllRequired)
+	case LLMProviderGemini:
+		return GeminiDialect.Transforms(WKTAsString, MapsToKVArrays)
+	}
+	return nil
+}
+
+// TransformSchema applies the transforms to the schema in order.
+func TransformSchema(schema map[string]any, transforms ...SchemaTransform) map[string]any {
+	for _, transform := range transforms {
+		transform.Apply(schema)
+	}
+	return schema
+}
+
+// maxRecursionDepth is the number of times recursive schemas are inlined into each
+// other along a path. It bounds the total depth rather than the depth per reference, so
+// messages with several recursive fields do not grow exponentially.
+const maxRecursionDepth = 2
+
+// inPlace adapts a transform returning a replacement schema to a transform rewriting
+// the root schema in place.
+func inPlace(transform func(schema map[string]any) map[string]any) func(schema map[string]any) {
+	return func(schema map[string]any) {
+		replaced := transform(schema)
+		if reflect.ValueOf(replaced).UnsafePointer() == reflect.ValueOf(schema).UnsafePointer() {
+			return
+		}
+		clear(schema)
+		maps.Copy(schema, replaced)
+	}
+}
+
+func inlineRefs(schema map[string]any) map[string]any {
+	original := CloneSchema(schema)
+	inlineSubschemas(original, schema, []string{"#"}, 0)
+	delete(schema, "$defs")
+	return schema
+}
+
+// inlineSubschemas replaces the references in the subschemas of schema with copies of
+// the referenced schemas. stack holds the references currently being inlined, and
+// depth the number of them that are recursive.
+func inlineSubschemas(root, schema map[string]any, stack []string, depth int) {
+	mapSubschemas(schema, func(sub map[string]any) map[string]any {
+		ref, ok := sub["$ref"].(string)
+		if !ok {
+			inlineSubschemas(root, sub, stack, depth)
+			return sub
+		}
+
+		subDepth := depth
+		if slices.Contains(stack, ref) {
+			if depth >= maxRecursionDepth {
+				description := "Maximum nesting depth of recursive message reached."
+				if name, ok := strings.CutPrefix(ref, "#/$defs/"); ok {
+					description = fmt.Sprintf("Maximum nesting depth of recursive message %s reached.", name)
+				}
+				return map[string]any{"type": []string{"null"}, "description": description}
+			}
+			subDepth++
+		}
+
+		target := resolvePointer(root, ref)
+		if target == nil {
+			return sub
+		}
+		inlined := CloneSchema(target)
+		delete(inlined, "$defs")
+		// Keywords next to "$ref" apply to the reference, such as the field description.
+		for keyword, value := range sub {
+			switch keyword {
+			case "$ref":
+			case "description":
+				appendDescription(inlined, value.(string), true)
+			default:
+				inlined[keyword] = value
+			}
+		}
+		inlineSubschemas(root, inlined, append(stack[:len(stack):len(stack)], ref), subDepth)
+		return inlined
+	})
+}
+
+// resolvePointer returns the schema a local reference points to, or nil.
+func resolvePointer(root map[string]any, ref string) map[string]any {
+	if ref == "#" {
+		return root
+	}
+	pointer, ok := strings.CutPrefix(ref, "#/")
+	if !ok {
+		return nil
+	}
+	current := root
+	for _, token := range strings.Split(pointer, "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		next, ok := current[token].(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// flattenOneofs turns the oneOf groups of object schemas into nullable properties.
+// Every group is an entry of "anyOf", with one alternative per field of the oneof.
+func flattenOneofs(schema map[string]any) map[string]any {
+	mapSubschemas(schema, flattenOneofs)
+
+	anyOf := subschemaList(schema["anyOf"])
+	if anyOf == nil {
+		return schema
+	}
+
+	var rest []map[string]any
+	for _, entry := range anyOf {
+		fields := map[string]map[string]any{}
+		for _, alternative := range subschemaList(entry["oneOf"]) {
+			properties, _ := alternative["properties"].(map[string]any)
+			if len(properties) != 1 {
+				fields = nil
+				break
+			}
+			for name, property := range properties {
+				fields[name], _ = property.(map[string]any)
+			}
+		}
+		if len(fields) == 0 {
+			rest = append(rest, entry)
+			continue
+		}
+
+		properties, ok := schema["properties"].(map[string]any)
+		if !ok {
+			properties = map[string]any{}
+			schema["properties"] = properties
+		}
+		names := slices.Sorted(maps.Keys(fields))
+		for _, name := range names {
+			property := fields[name]
+			if property == nil {
+				continue
+			}
+			AddNull(property)
+			others := slices.DeleteFunc(slices.Clone(names), func(other string) bool { return other == name })
+			note := "Note: This field is part of a oneof group."
+			if len(others) > 0 {
+				note = fmt.Sprintf("Note: This field is part of a oneof group with %s.", quoteAll(others))
+			}
+			appendDescription(property, note+" Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.", false)
+			properties[name] = property
+		}
+	}
+
+	if len(rest) > 0 {
+		schema["anyOf"] = rest
+	} else {
+		delete(schema, "anyOf")
+	}
+	return schema
+}
+
+func mapsToKVArrays(schema map[string]any) map[string]any {
+	mapSubschemas(schema, mapsToKVArrays)
+
+	value, ok := schema["additionalProperties"].(map[string]any)
+	types := SchemaTypes(schema)
+	if !ok || schema["properties"] != nil || !slices.Contains(types, "object") {
+		return schema
+	}
+	key, ok := schema["propertyNames"].(map[string]any)
+	if !ok {
+		key = map[string]any{"type": "string"}
+	}
+
+	result := map[string]any{
+		"type": "array",
+		"items": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"key":   key,
+				"value": value,
+			},
+			"required": []string{"key", "value"},
+		},
+	}
+	if slices.Contains(types, "null") {
+		result["type"] = []string{"array", "null"}
+	}
+	if description, ok := schema["description"].(string); ok {
+		result["description"] = description
+	}
+	appendDescription(result, "List of key value pairs", false)
+	return result
+}
+
+func wktAsString(schema map[string]any) map[string]any {
+	note := freeFormNote(schema)
+	if note == "" {
+		mapSubschemas(schema, wktAsString)
+		return schema
+	}
+
+	result := map[string]any{"type": "string"}
+	if slices.Contains(SchemaTypes(schema), "null") || schema["nullable"] == true {
+		result["type"] = []string{"string", "null"}
+	}
+	if description, ok := schema["description"].(string); ok {
+		result["description"] = description
+	}
+	appendDescription(result, note, false)
+	return result
+}
+
+// freeFormNote returns the description of the string encoding of a free-form schema,
+// or "" if the schema is not free-form.
+func freeFormNote(schema map[string]any) string {
+	types := SchemaTypes(schema)
+	properties, _ := schema["properties"].(map[string]any)
+	items, hasItems := schema["items"].(map[string]any)
+	switch {
+	case properties["@type"] != nil:
+		return `Must be a string representation of a JSON object with an "@type" field.`
+	case slices.Contains(types, "object") && properties == nil && schema["additionalProperties"] == true:
+		return "Must be a string representation of any JSON object."
+	case slices.Contains(types, "array") && hasItems && len(items) == 0:
+		return "Must be a string representation of a JSON array."
+	case isAnyValue(schema):
+		return "Must be a string representation of any JSON value."
+	}
+	return ""
+}
+
+// isAnyValue reports whether a schema accepts any JSON value.
+func isAnyValue(schema map[string]any) bool {
+	for _, keyword := range []string{"type", "$ref", "properties", "items", "anyOf", "oneOf", "allOf", "enum", "const", "not"} {
+		if _, ok := schema[keyword]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+func allRequired(schema map[string]any) map[string]any {
+	if properties, ok := schema["properties"].(map[string]any); ok {
+		required := append([]string{}, StringList(schema["required"])...)
+		for _, name := range slices.Sorted(maps.Keys(properties)) {
+			if slices.Contains(required, name) {
+				continue
+			}
+			required = append(required, name)
+			if property, ok := properties[name].(map[string]any); ok && (slices.Contains(SchemaTypes(property), "object") || property["$ref"] != nil) {
+				AddNull(property)
+			}
+		}
+		schema["required"] = required
+		schema["additionalProperties"] = false
+	}
+	mapSubschemas(schema, allRequired)
+	return schema
+}
+
+// noteKeywords are the constraint keywords described when a dialect does not support
+// them, in the order of the notes.
+var noteKeywords = []string{
+	"minLength", "maxLength", "pattern", "format", "contentEncoding",
+	"exclusiveMinimum", "minimum", "exclusiveMaximum", "maximum", "multipleOf",
+	"minItems", "maxItems", "uniqueItems", "enum", "const", "not",
+}
+
+// formatNames are the names of string formats used in notes.
+var formatNames = map[string]string{
+	"email":         "email address",
+	"hostname":      "hostname",
+	"ipv4":          "IPv4 address",
+	"ipv6":          "IPv6 address",
+	"uri":           "absolute URI",
+	"uri-reference": "URI reference",
+	"uuid":          "UUID",
+	"date-time":     "RFC 3339 date-time",
+}
+
+func stripUnsupported(schema map[string]any, dialect SchemaDialect) map[string]any {
+	mapSubschemas(schema, func(sub map[string]any) map[string]any {
+		return stripUnsupported(sub, dialect)
+	})
+
+	types := SchemaTypes(schema)
+	nullable := slices.Contains(types, "null")
+	if dialect.NullableKeyword && nullable {
+		nonNull := slices.DeleteFunc(slices.Clone(types), func(t string) bool { return t == "null" })
+		if len(nonNull) == 0 {
+			// There is no null type, a schema that only allows null is left out.
+			return nil
+		}
+		schema["type"] = nonNull[0]
+		schema["nullable"] = true
+	} else if !dialect.supports("nullable") && schema["nullable"] == true {
+		if len(types) > 0 && !nullable {
+			setTypes(schema, append(types, "null"))
+		}
+		delete(schema, "nullable")
+	}
+
+	var notes []string
+	supported := func(keyword string) bool {
+		switch {
+		case !dialect.supports(keyword):
+			return false
+		case keyword == "format" && dialect.Formats != nil:
+			format, _ := schema["format"].(string)
+			return dialect.Formats[format]
+		case keyword == "enum" && dialect.StringEnumsOnly:
+			return schema["type"] == "string"
+		}
+		return true
+	}
+	if minLength, ok := schema["minLength"]; ok && !supported("minLength") && !supported("maxLength") && fmt.Sprint(minLength) == fmt.Sprint(schema["maxLength"]) {
+		notes = append(notes, fmt.Sprintf("Must be exactly %v characters long.", minLength))
+		delete(schema, "minLength")
+		delete(schema, "maxLength")
+	}
+	for _, keyword := range noteKeywords {
+		value, ok := schema[keyword]
+		if !ok || supported(keyword) {
+			continue
+		}
+		if note := keywordNote(keyword, value); note != "" {
+			notes = append(notes, note)
+		}
+		delete(schema, keyword)
+	}
+	for keyword := range schema {
+		if !supported(keyword) {
+			delete(schema, keyword)
+		}
+	}
+	if len(notes) > 0 {
+		appendDescription(schema, strings.Join(notes, " "), false)
+	}
+	return schema
+}
+
+// keywordNote describes a constraint keyword, or returns "" if it cannot be described.
+func keywordNote(keyword string, value any) string {
+	switch keyword {
+	case "minLength":
+		return fmt.Sprintf("Must be at least %v characters long.", value)
+	case "maxLength":
+		return fmt.Sprintf("Must be at most %v characters long.", value)
+	case "pattern":
+		return fmt.Sprintf("Must match the regular expression %q.", value)
+	case "format":
+		if name, ok := formatNames[fmt.Sprint(value)]; ok {
+			return fmt.Sprintf("Must be a valid %s.", name)
+		}
+	case "contentEncoding":
+		if value == "base64" {
+			return "Base64 encoded."
+		}
+	case "exclusiveMinimum":
+		return fmt.Sprintf("Must be greater than %v.", value)
+	case "minimum":
+		return fmt.Sprintf("Must be greater than or equal to %v.", value)
+	case "exclusiveMaximum":
+		return fmt.Sprintf("Must be less than %v.", value)
+	case "maximum":
+		return fmt.Sprintf("Must be less than or equal to %v.", value)
+	case "multipleOf":
+		return fmt.Sprintf("Must be a multiple of %v.", value)
+	case "minItems":
+		return fmt.Sprintf("Must contain at least %v items.", value)
+	case "maxItems":
+		return fmt.Sprintf("Must contain at most %v items.", value)
+	case "uniqueItems":
+		if value == true {
+			return "Items must be unique."
+		}
+	case "enum":
+		return fmt.Sprintf("Must be one of %s.", FormatValues(value))
+	case "const":
+		return fmt.Sprintf("Must be %s.", FormatValues([]any{value}))
+	case "not":
+		if not, ok := value.(map[string]any); ok && not["enum"] != nil {
+			return fmt.Sprintf("Must not be one of %s.", FormatValues(not["enum"]))
+		}
+	}
+	return ""
+}
+
+// FormatValues formats a list of values, quoting strings.
+func FormatValues(values any) string {
+	if strs := StringList(values); strs != nil {
+		return fmt.Sprintf("%q", strs)
+	}
+	return fmt.Sprintf("%v", values)
+}
+
+func (d SchemaDialect) supports(keyword string) bool {
+	return d.Keywords == nil || d.Keywords[keyword]
+}
+
+func keywordSet(keywords ...string) map[string]bool {
+	set := make(map[string]bool, len(keywords))
+	for _, keyword := range keywords {
+		set[keyword] = true
+	}
+	return set
+}
+
+// mapSubschemas replaces every direct subschema of schema with the result of fn. A
+// property whose result is nil is removed.
+func mapSubschemas(schema map[string]any, fn func(map[string]any) map[string]any) {
+	if properties, ok := schema["properties"].(map[string]any); ok {
+		for name, property := range properties {
+			sub, ok := property.(map[string]any)
+			if !ok {
+				continue
+			}
+			if replaced := fn(sub); replaced != nil {
+				properties[name] = replaced
+				continue
+			}
+			delete(properties, name)
+			if required := StringList(schema["required"]); required != nil {
+				schema["required"] = slices.DeleteFunc(required, func(r string) bool { return r == name })
+			}
+		}
+	}
+	for _, keyword := range []string{"items", "additionalProperties"} {
+		if sub, ok := schema[keyword].(map[string]any); ok {
+			if replaced := fn(sub); replaced != nil {
+				schema[keyword] = replaced
+			} else {
+				delete(schema, keyword)
+			}
+		}
+	}
+	for _, keyword := range []string{"anyOf", "oneOf", "allOf"} {
+		list := subschemaList(schema[keyword])
+		if list == nil {
+			continue
+		}
+		var replaced []map[string]any
+		for _, sub := range list {
+			if sub = fn(sub); sub != nil {
+				replaced = append(replaced, sub)
+			}
+		}
+		if len(replaced) > 0 {
+			schema[keyword] = replaced
+		} else {
+			delete(schema, keyword)
+		}
+	}
+	if defs, ok := schema["$defs"].(map[string]any); ok {
+		for name, def := range defs {
+			if sub, ok := def.(map[string]any); ok {
+				if replaced := fn(sub); replaced != nil {
+					defs[name] = replaced
+				}
+			}
+		}
+	}
+}
+
+// SchemaTypes returns the types of a schema.
+func SchemaTypes(schema map[string]any) []string {
+	if t, ok := schema["type"].(string); ok {
+		return []string{t}
+	}
+	return StringList(schema["type"])
+}
+
+func setTypes(schema map[string]any, types []string) {
+	if len(types) == 1 {
+		schema["type"] = types[0]
+		return
+	}
+	schema["type"] = types
+}
+
+// AddNull allows null in addition to the types of a schema. A reference becomes the
+// first alternative of an "anyOf" with null, keeping the keywords next to it.
+func AddNull(schema map[string]any) {
+	if ref, ok := schema["$ref"]; ok {
+		delete(schema, "$ref")
+		schema["anyOf"] = []map[string]any{{"$ref": ref}, {"type": "null"}}
+		return
+	}
+	types := SchemaTypes(schema)
+	if len(types) > 0 && !slices.Contains(types, "null") {
+		schema["type"] = append(slices.Clone(types), "null")
+	}
+}
+
+// appendDescription adds text as a new paragraph to the description of a schema, or
+// in front of the description if prepend is set.
+func appendDescription(schema map[string]any, text string, prepend bool) {
+	existing, _ := schema["description"].(string)
+	switch {
+	case existing == "" || existing == text:
+		schema["description"] = text
+	case prepend:
+		schema["description"] = text + "\n\n" + existing
+	default:
+		schema["description"] = existing + "\n\n" + text
+	}
+}
+
+// StringList returns a list of strings, as built by the generator or decoded from JSON.
+func StringList(v any) []string {
+	switch v := v.(type) {
+	case []string:
+		return v
+	case []any:
+		strs := make([]string, 0, len(v))
+		for _, elem := range v {
+			s, ok := elem.(string)
+			if !ok {
+				return nil
+			}
+			strs = append(strs, s)
+		}
+		return strs
+	}
+	return nil
+}
+
+// subschemaList returns a list of schemas, as built by the generator or decoded from
+// JSON.
+func subschemaList(v any) []map[string]any {
+	switch v := v.(type) {
+	case []map[string]any:
+		return v
+	case []any:
+		list := make([]map[string]any, 0, len(v))
+		for _, elem := range v {
+			if sub, ok := elem.(map[string]any); ok {
+				list = append(list, sub)
+			}
+		}
+		return list
+	}
+	return nil
+}
+
+func quoteAll(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// CloneSchema returns a deep copy of a schema.
+func CloneSchema(schema map[string]any) map[string]any {
+	return cloneValue(schema).(map[string]any)
+}
+
+func cloneValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		clone := make(map[string]any, len(v))
+		for key, value := range v {
+			clone[key] = cloneValue(value)
+		}
+		return clone
+	case []map[string]any:
+		clone := make([]map[string]any, len(v))
+		for i, value := range v {
+			clone[i] = CloneSchema(value)
+		}
+		return clone
+	case []any:
+		clone := make([]any, len(v))
+		for i, value := range v {
+			clone[i] = cloneValue(value)
+		}
+		return clone
+	case []string:
+		return slices.Clone(v)
+	}
+	return v
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dialect
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSchemaTransforms(t *testing.T) {
+	tests := []struct {
+		name      string
+		transform SchemaTransform
+		input     string
+		expected  string
+	}{
+		{
+			name:      "inline references",
+			transform: InlineRefs,
+			input: `{"type": "object", "properties": {
+				"a": {"$ref": "#/$defs/pkg.Msg", "description": "Field."},
+				"b": {"$ref": "#/$defs/pkg.Msg"}
+			}, "$defs": {"pkg.Msg": {"type": "object", "description": "Message.", "properties": {"x": {"type": "string"}}}}}`,
+			expected: `{"type": "object", "properties": {
+				"a": {"type": "object", "description": "Field.\n\nMessage.", "properties": {"x": {"type": "string"}}},
+				"b": {"type": "object", "description": "Message.", "properties": {"x": {"type": "string"}}}
+			}}`,
+		},
+		{
+			name:      "truncate recursion",
+			transform: InlineRefs,
+			input:     `{"type": "object", "properties": {"next": {"$ref": "#"}}}`,
+			expected: `{"type": "object", "properties": {"next": {"type": "object", "properties": {"next": {"type": "object", "properties": {
+				"next": {"type": ["null"], "description": "Maximum nesting depth of recursive message reached."}
+			}}}}}}`,
+		},
+		{
+			name:      "truncate recursion across references",
+			transform: InlineRefs,
+			input: `{"type": "object", "properties": {"a": {"$ref": "#/$defs/A"}}, "$defs": {
+				"A": {"type": "object", "properties": {"b": {"$ref": "#/$defs/B"}}},
+				"B": {"type": "object", "properties": {"a": {"$ref": "#/$defs/A"}, "b": {"$ref": "#/$defs/B"}}}
+			}}`,
+			expected: `{"type": "object", "properties": {"a": {"type": "object", "properties": {"b": {"type": "object", "properties": {
+				"a": {"type": "object", "properties": {"b": {"type": "object", "properties": {
+					"a": {"type": ["null"], "description": "Maximum nesting depth of recursive message A reached."},
+					"b": {"type": ["null"], "description": "Maximum nesting depth of recursive message B reached."}
+				}}}},
+				"b": {"type": "object", "properties": {
+					"a": {"type": "object", "properties": {"b": {"type": ["null"], "description": "Maximum nesting depth of recursive message B reached."}}},
+					"b": {"type": "object", "properties": {
+						"a": {"type": ["null"], "description": "Maximum nesting depth of recursive message A reached."},
+						"b": {"type": ["null"], "description": "Maximum nesting depth of recursive message B reached."}
+					}}
+				}}
+			}}}}}}`,
+		},
+		{
+			name:      "flatten oneofs",
+			transform: FlattenOneofs,
+			input: `{"type": "object", "properties": {}, "anyOf": [{"oneOf": [
+				{"properties": {"a": {"type": "string"}}, "required": ["a"]},
+				{"properties": {"b": {"type": "integer"}}, "required": ["b"]}
+			]}]}`,
+			expected: `{"type": "object", "properties": {
+				"a": {"type": ["string", "null"], "description": "Note: This field is part of a oneof group with \"b\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply."},
+				"b": {"type": ["integer", "null"], "description": "Note: This field is part of a oneof group with \"a\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply."}
+			}}`,
+		},
+		{
+			name:      "maps to key value arrays",
+			transform: MapsToKVArrays,
+			input: `{"type": "object", "properties": {"m": {
+				"type": "object", "propertyNames": {"type": "string", "pattern": "^[0-9]+$"},
+				"additionalProperties": {"type": "object", "additionalProperties": {"type": "boolean"}}
+			}}}`,
+			expected: `{"type": "object", "properties": {"m": {"type": "array", "description": "List of key value pairs", "items": {
+				"type": "object", "required": ["key", "value"], "properties": {
+					"key": {"type": "string", "pattern": "^[0-9]+$"},
+					"value": {"type": "array", "description": "List of key value pairs", "items": {
+						"type": "object", "required": ["key", "value"], "properties": {"key": {"type": "string"}, "value": {"type": "boolean"}}
+					}}
+				}
+			}}}}`,
+		},
+		{
+			name:      "free-form values as strings",
+			transform: WKTAsString,
+			input: `{"type": "object", "properties": {
+				"struct": {"type": "object", "additionalProperties": true},
+				"value": {"description": "Any value."},
+				"list": {"type": "array", "items": {}},
+				"any": {"type": ["object", "null"], "properties": {"@type": {"type": "string"}, "value": {}}}
+			}}`,
+			expected: `{"type": "object", "properties": {
+				"struct": {"type": "string", "description": "Must be a string representation of any JSON object."},
+				"value": {"type": "string", "description": "Any value.\n\nMust be a string representation of any JSON value."},
+				"list": {"type": "string", "description": "Must be a string representation of a JSON array."},
+				"any": {"type": ["string", "null"], "description": "Must be a string representation of a JSON object with an \"@type\" field."}
+			}}`,
+		},
+		{
+			name:      "all required",
+			transform: AllRequired,
+			input: `{"type": "object", "required": ["b"], "properties": {
+				"a": {"type": "string"},
+				"b": {"type": "object", "properties": {}},
+				"c": {"type": "object", "properties": {"d": {"type": "integer"}}},
+				"e": {"$ref": "#/$defs/pkg.Msg", "description": "Field."}
+			}, "$defs": {"pkg.Msg": {"type": "object", "properties": {"f": {"type": "string"}}}}}`,
+			expected: `{"type": "object", "required": ["b", "a", "c", "e"], "additionalProperties": false, "properties": {
+				"a": {"type": "string"},
+				"b": {"type": "object", "properties": {}, "required": [], "additionalProperties": false},
+				"c": {"type": ["object", "null"], "properties": {"d": {"type": "integer"}}, "required": ["d"], "additionalProperties": false},
+				"e": {"anyOf": [{"$ref": "#/$defs/pkg.Msg"}, {"type": "null"}], "description": "Field."}
+			}, "$defs": {"pkg.Msg": {"type": "object", "properties": {"f": {"type": "string"}}, "required": ["f"], "additionalProperties": false}}}`,
+		},
+		{
+			name:      "strip keywords unsupported by OpenAI",
+			transform: StripUnsupportedKeywords(OpenAIDialect),
+			input: `{"type": "object", "properties": {
+				"code": {"type": "string", "minLength": 3, "maxLength": 3, "format": "uri", "$comment": "dropped"},
+				"count": {"type": "number", "nullable": true, "minimum": 1},
+				"tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true}
+			}}`,
+			expected: `{"type": "object", "properties": {
+				"code": {"type": "string", "description": "Must be exactly 3 characters long. Must be a valid absolute URI."},
+				"count": {"type": ["number", "null"], "minimum": 1},
+				"tags": {"type": "array", "items": {"type": "string"}, "description": "Items must be unique."}
+			}}`,
+		},
+		{
+			name:      "strip keywords unsupported by Gemini",
+			transform: StripUnsupportedKeywords(GeminiDialect),
+			input: `{"type": "object", "additionalProperties": false, "required": ["level", "gone"], "properties": {
+				"when": {"type": ["string", "null"], "format": "date-time"},
+				"level": {"type": "integer", "enum": [1, 2]},
+				"gone": {"type": ["null"]},
+				"data": {"type": "string", "contentEncoding": "base64", "format": "byte"}
+			}}`,
+			expected: `{"type": "object", "required": ["level"], "properties": {
+				"when": {"type": "string", "nullable": true, "format": "date-time"},
+				"level": {"type": "integer", "description": "Must be one of [1 2]."},
+				"data": {"type": "string", "description": "Base64 encoded."}
+			}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var schema map[string]any
+			g.Expect(json.Unmarshal([]byte(tt.input), &schema)).To(Succeed())
+			TransformSchema(schema, tt.transform)
+
+			transformed, err := json.Marshal(schema)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(transformed).To(MatchJSON(tt.expected))
+		})
+	}
+}
+
+func TestDialectTransforms(t *testing.T) {
+	const recursive = `{"type": "object", "properties": {"root": {"$ref": "#/$defs/pkg.Node"}}, "$defs": {
+		"pkg.Node": {"type": "object", "properties": {"name": {"type": "string"}, "children": {"type": "array", "items": {"$ref": "#/$defs/pkg.Node"}}}}
+	}}`
+
+	tests := []struct {
+		name     string
+		provider LLMProvider
+		expected string
+	}{
+		{
+			name:     "OpenAI keeps references",
+			provider: LLMProviderOpenAI,
+			expected: `{"type": "object", "required": ["root"], "additionalProperties": false, "properties": {
+				"root": {"anyOf": [{"$ref": "#/$defs/pkg.Node"}, {"type": "null"}]}
+			}, "$defs": {"pkg.Node": {"type": "object", "required": ["children", "name"], "additionalProperties": false, "properties": {
+				"name": {"type": "string"},
+				"children": {"type": "array", "items": {"$ref": "#/$defs/pkg.Node"}}
+			}}}}`,
+		},
+		{
+			name:     "Gemini inlines references",
+			provider: LLMProviderGemini,
+			expected: `{"type": "object", "properties": {"root": {"type": "object", "properties": {
+				"name": {"type": "string"},
+				"children": {"type": "array", "items": {"type": "object", "properties": {
+					"name": {"type": "string"},
+					"children": {"type": "array", "items": {"type": "object", "properties": {
+						"name": {"type": "string"},
+						"children": {"type": "array"}
+					}}}
+				}}}
+			}}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var schema map[string]any
+			g.Expect(json.Unmarshal([]byte(recursive), &schema)).To(Succeed())
+			TransformSchema(schema, SchemaTransforms(tt.provider)...)
+
+			transformed, err := json.Marshal(schema)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(transformed).To(MatchJSON(tt.expected))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(OpenAIDialect.InlineRefs).To(BeFalse())
+	g.Expect(GeminiDialect.InlineRefs).To(BeTrue())
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// LoadFileDescriptorSet reads a serialized google.protobuf.FileDescriptorSet, as written
+// by `buf build -o` or `protoc --include_imports --descriptor_set_out`. Comments are
+// only used as descriptions if the set includes source info.
+func LoadFileDescriptorSet(path string) (*protoregistry.Files, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(raw, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse file descriptor set %s: %w", path, err)
+	}
+	return protodesc.NewFiles(&set)
+}
+
+// Services returns the services with the given full names, or all services of the
+// files if no names are given.
+func Services(files *protoregistry.Files, names ...string) ([]protoreflect.ServiceDescriptor, error) {
+	var services []protoreflect.ServiceDescriptor
+	if len(names) == 0 {
+		files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+			for i := 0; i < fd.Services().Len(); i++ {
+				services = append(services, fd.Services().Get(i))
+			}
+			return true
+		})
+		// The files are ranged in no particular order.
+		slices.SortFunc(services, func(a, b protoreflect.ServiceDescriptor) int {
+			return strings.Compare(string(a.FullName()), string(b.FullName()))
+		})
+		return services, nil
+	}
+
+	for _, name := range names {
+		desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", name, err)
+		}
+		svc, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+		services = append(services, svc)
+	}
+	return services, nil
+}
+
+// ReflectFiles fetches the descriptors of the services of a gRPC server with server
+// reflection. It returns the files and the full names of the services, except for the
+// reflection service itself.
+func ReflectFiles(ctx context.Context, conn grpc.ClientConnInterface) (*protoregistry.Files, []string, error) {
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = stream.CloseSend() }()
+
+	request := func(req *reflectionpb.ServerReflectionRequest) (*reflectionpb.ServerReflectionResponse, error) {
+		if err := stream.Send(req); err != nil {
+			return nil, err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if errResp := resp.GetErrorResponse(); errResp != nil {
+			return nil, fmt.Errorf("server reflection: %s", errResp.GetErrorMessage())
+		}
+		return resp, nil
+	}
+
+	resp, err := request(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{ListServices: "*"},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var names []string
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		if strings.HasPrefix(svc.GetName(), "grpc.reflection.") {
+			continue
+		}
+		names = append(names, svc.GetName())
+	}
+
+	files := map[string]*descriptorpb.FileDescriptorProto{}
+	addFiles := func(resp *reflectionpb.ServerReflectionResponse) error {
+		for _, raw := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+			fdp := &descriptorpb.FileDescriptorProto{}
+			if err := proto.Unmarshal(raw, fdp); err != nil {
+				return err
+			}
+			files[fdp.GetName()] = fdp
+		}
+		return nil
+	}
+	for _, name := range names {
+		resp, err := request(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: name},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := addFiles(resp); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Servers may not send all dependencies with a file, the missing ones are fetched
+	// by name.
+	for missing := missingDependency(files); missing != ""; missing = missingDependency(files) {
+		resp, err := request(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_FileByFilename{FileByFilename: missing},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := addFiles(resp); err != nil {
+			return nil, nil, err
+		}
+		if files[missing] == nil {
+			return nil, nil, fmt.Errorf("server reflection did not return %s", missing)
+		}
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		set.File = append(set.File, files[name])
+	}
+	registry, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, nil, err
+	}
+	return registry, names, nil
+}
+
+// missingDependency returns a dependency of the files that is not part of them, or ""
+// if all dependencies are present.
+func missingDependency(files map[string]*descriptorpb.FileDescriptorProto) string {
+	for _, fdp := range files {
+		for _, dep := range fdp.GetDependency() {
+			if files[dep] == nil {
+				return dep
+			}
+		}
+	}
+	return ""
+}
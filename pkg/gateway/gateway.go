@@ -0,0 +1,172 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gateway serves the methods of gRPC and Connect services as MCP tools from
+// descriptors loaded at runtime, without generated code. The tools are built like the
+// tools of protoc-gen-go-mcp, and calls are forwarded with dynamicpb messages.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/statico/protoc-gen-go-mcp/pkg/mcptool"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Register registers a tool for every exposed method of the services, which forwards
+// tool calls with the transport. The tools use the schemas of the given provider. It
+// fails if the schema of a method can't be built, such as for invalid rules.
+func Register(s *mcpserver.MCPServer, services []protoreflect.ServiceDescriptor, transport Transport, provider runtime.LLMProvider, opts ...runtime.Option) error {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	for _, svc := range services {
+		for _, meth := range mcptool.ExposedMethods(svc) {
+			generated, err := mcptool.New(meth)
+			if err != nil {
+				return err
+			}
+			tool, provider := providerTool(generated, provider, config.SchemaTransforms)
+			// Add extra properties to schema if configured, in the dialect of the tool
+			if len(config.ExtraProperties) > 0 {
+				transforms := runtime.SchemaTransforms(provider)
+				if provider == runtime.LLMProviderCustom {
+					transforms = config.SchemaTransforms
+				}
+				tool = runtime.AddExtraPropertiesToTool(tool, config.ExtraProperties, transforms...)
+			}
+			// Remove bound fields from schema, they are set from the session
+			if len(config.FieldBindings) > 0 {
+				tool = runtime.BindFieldsToTool(tool, config.FieldBindings, meth.IsStreamingClient())
+			}
+
+			s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				message := request.GetArguments()
+
+				// Extract extra properties if configured
+				ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+				if err != nil {
+					return runtime.HandleError(err)
+				}
+				// Forward the allowed metadata of the tool call to the backend
+				ctx = runtime.ForwardMetadata(ctx, config, request)
+
+				requests, err := unmarshalRequests(meth, message, provider)
+				if err != nil {
+					return runtime.HandleError(err)
+				}
+				for _, req := range requests {
+					if err := runtime.BindFields(ctx, config, request, req); err != nil {
+						return runtime.HandleError(err)
+					}
+				}
+				var req any = requests
+				if meth.IsStreamingClient() {
+					err = runtime.ValidateStream(config, requests)
+				} else {
+					req = requests[0]
+					err = runtime.Validate(config, requests[0])
+				}
+				if err != nil {
+					return runtime.HandleError(err)
+				}
+
+				// Responses of server-streaming methods are collected, the single
+				// response of other methods is the result.
+				var collector *runtime.StreamCollector
+				if meth.IsStreamingServer() {
+					collector = runtime.NewStreamCollector(ctx, request, config)
+				}
+				resp, err := runtime.Intercept(ctx, config, request, generated.FullMethod, req, func(ctx context.Context, req any) (proto.Message, error) {
+					requests, ok := req.([]*dynamicpb.Message)
+					if !ok {
+						requests = []*dynamicpb.Message{req.(*dynamicpb.Message)}
+					}
+					var resp proto.Message
+					err := transport.Invoke(ctx, meth, requests, func(msg *dynamicpb.Message) error {
+						if collector != nil {
+							return collector.Add(msg)
+						}
+						resp = msg
+						return nil
+					})
+					return resp, err
+				})
+				if err != nil {
+					return runtime.HandleError(err)
+				}
+				if collector != nil {
+					return collector.Result()
+				}
+
+				marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+				if err != nil {
+					return nil, err
+				}
+				return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+			})
+		}
+	}
+	return nil
+}
+
+// providerTool returns the tool with the schemas of the provider, and the provider the
+// arguments of tool calls are converted from. Schema transforms apply to the standard
+// schemas, like in the generated code.
+func providerTool(generated mcptool.Tool, provider runtime.LLMProvider, transforms []runtime.SchemaTransform) (mcp.Tool, runtime.LLMProvider) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		return generated.MCPToolOpenAI, provider
+	case runtime.LLMProviderGemini:
+		return generated.MCPToolGemini, provider
+	}
+	if len(transforms) > 0 {
+		return runtime.TransformTool(generated.MCPTool, transforms), runtime.LLMProviderCustom
+	}
+	return generated.MCPTool, runtime.LLMProviderStandard
+}
+
+// unmarshalRequests decodes the arguments of a tool call into the requests of the
+// method, one unless the method is client-streaming.
+func unmarshalRequests(meth protoreflect.MethodDescriptor, message map[string]any, provider runtime.LLMProvider) ([]*dynamicpb.Message, error) {
+	if !meth.IsStreamingClient() {
+		req := dynamicpb.NewMessage(meth.Input())
+		runtime.Fix(provider, meth.Input(), message)
+		if err := runtime.UnmarshalArguments(message, req); err != nil {
+			return nil, err
+		}
+		return []*dynamicpb.Message{req}, nil
+	}
+
+	messages, err := runtime.UnmarshalStreamMessages(message, provider, func() proto.Message {
+		return dynamicpb.NewMessage(meth.Input())
+	})
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]*dynamicpb.Message, 0, len(messages))
+	for _, msg := range messages {
+		requests = append(requests, msg.(*dynamicpb.Message))
+	}
+	return requests, nil
+}
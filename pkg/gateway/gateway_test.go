@@ -0,0 +1,380 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdataconnect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type testServer struct {
+	testdata.UnimplementedTestServiceServer
+	testdata.UnimplementedStreamingTestServiceServer
+
+	lastCreate *testdata.CreateItemRequest
+}
+
+func (s *testServer) CreateItem(ctx context.Context, req *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error) {
+	s.lastCreate = req
+	return &testdata.CreateItemResponse{Id: "item-123"}, nil
+}
+
+func (s *testServer) GetItem(ctx context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	return nil, status.Error(codes.NotFound, "item not found")
+}
+
+func (s *testServer) WatchItems(req *testdata.WatchItemsRequest, stream grpc.ServerStreamingServer[testdata.WatchItemsResponse]) error {
+	for i := int32(1); i <= req.GetCount(); i++ {
+		if err := stream.Send(&testdata.WatchItemsResponse{Id: req.GetPrefix() + "-item", Sequence: i}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *testServer) UploadItems(stream grpc.ClientStreamingServer[testdata.UploadItemsRequest, testdata.UploadItemsResponse]) error {
+	resp := &testdata.UploadItemsResponse{}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+		resp.Ids = append(resp.Ids, req.GetId())
+	}
+}
+
+func (s *testServer) SyncItems(stream grpc.BidiStreamingServer[testdata.SyncItemsRequest, testdata.SyncItemsResponse]) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&testdata.SyncItemsResponse{Id: req.GetId(), InSync: true}); err != nil {
+			return err
+		}
+	}
+}
+
+// startGRPC serves the test services with server reflection on an in-memory listener.
+func startGRPC(t *testing.T, srv *testServer) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	testdata.RegisterTestServiceServer(server, srv)
+	testdata.RegisterStreamingTestServiceServer(server, srv)
+	reflection.Register(server)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// writeDescriptorSet writes the files and their dependencies as a FileDescriptorSet.
+func writeDescriptorSet(t *testing.T, files ...protoreflect.FileDescriptor) string {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, fd := range files {
+		add(fd)
+	}
+
+	raw, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "descriptors.binpb")
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// newGateway registers the services of a descriptor set with a new MCP server.
+func newGateway(g *WithT, path string, transport Transport, provider runtime.LLMProvider, names ...string) *mcpserver.MCPServer {
+	files, err := LoadFileDescriptorSet(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	services, err := Services(files, names...)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	s := mcpserver.NewMCPServer("test-gateway", "1.0.0")
+	g.Expect(Register(s, services, transport, provider)).To(Succeed())
+	return s
+}
+
+// toolNames lists the names of the registered tools.
+func toolNames(g *WithT, s *mcpserver.MCPServer) []string {
+	response := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+	result, ok := response.(mcp.JSONRPCResponse).Result.(mcp.ListToolsResult)
+	g.Expect(ok).To(BeTrue())
+	var names []string
+	for _, tool := range result.Tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
+// callTool calls a tool and returns its result.
+func callTool(g *WithT, s *mcpserver.MCPServer, name string, arguments map[string]any) *mcp.CallToolResult {
+	request, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": name, "arguments": arguments},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	response := s.HandleMessage(context.Background(), request)
+	g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+	result, ok := response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult)
+	g.Expect(ok).To(BeTrue())
+	return &result
+}
+
+// structured parses the JSON result of a successful tool call.
+func structured(g *WithT, result *mcp.CallToolResult) map[string]any {
+	g.Expect(result.IsError).To(BeFalse(), "%v", result.Content)
+	g.Expect(result.Content).To(HaveLen(1))
+	var content map[string]any
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &content)).To(Succeed())
+	return content
+}
+
+func TestGatewayGRPC(t *testing.T) {
+	g := NewWithT(t)
+
+	srv := &testServer{}
+	conn := startGRPC(t, srv)
+	path := writeDescriptorSet(t, testdata.File_testdata_test_service_proto)
+	s := newGateway(g, path, NewGRPCTransport(conn), runtime.LLMProviderOpenAI)
+
+	g.Expect(toolNames(g, s)).To(ConsistOf(
+		"testdata_TestService_CreateItem",
+		"testdata_TestService_GetItem",
+		"testdata_TestService_ProcessWellKnownTypes",
+	))
+
+	// Arguments in the schema of the provider are converted
+	result := callTool(g, s, "testdata_TestService_CreateItem", map[string]any{
+		"name":   "widget",
+		"labels": []any{map[string]any{"key": "env", "value": "prod"}},
+	})
+	g.Expect(structured(g, result)).To(HaveKeyWithValue("id", "item-123"))
+	g.Expect(srv.lastCreate.GetName()).To(Equal("widget"))
+	g.Expect(srv.lastCreate.GetLabels()).To(Equal(map[string]string{"env": "prod"}))
+
+	// Errors of the server are tool errors
+	result = callTool(g, s, "testdata_TestService_GetItem", map[string]any{"id": "missing"})
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Content[0].(mcp.TextContent).Text).To(ContainSubstring("item not found"))
+
+	// Invalid arguments are rejected before calling the server
+	result = callTool(g, s, "testdata_TestService_GetItem", map[string]any{"id": 42})
+	g.Expect(result.IsError).To(BeTrue())
+}
+
+func TestGatewayGRPCStreaming(t *testing.T) {
+	g := NewWithT(t)
+
+	conn := startGRPC(t, &testServer{})
+	path := writeDescriptorSet(t, testdata.File_testdata_streaming_test_proto)
+	s := newGateway(g, path, NewGRPCTransport(conn), runtime.LLMProviderStandard)
+
+	result := callTool(g, s, "testdata_StreamingTestService_WatchItems", map[string]any{"prefix": "a", "count": 2})
+	g.Expect(structured(g, result)).To(HaveKeyWithValue("messages", HaveLen(2)))
+
+	result = callTool(g, s, "testdata_StreamingTestService_UploadItems", map[string]any{
+		"messages": []any{map[string]any{"id": "1"}, map[string]any{"id": "2"}},
+	})
+	g.Expect(structured(g, result)).To(HaveKeyWithValue("ids", []any{"1", "2"}))
+
+	result = callTool(g, s, "testdata_StreamingTestService_SyncItems", map[string]any{
+		"messages": []any{map[string]any{"id": "1"}, map[string]any{"id": "2"}, map[string]any{"id": "3"}},
+	})
+	g.Expect(structured(g, result)).To(HaveKeyWithValue("messages", ContainElement(map[string]any{"id": "3", "in_sync": true})))
+}
+
+// brokenConn opens streams on which sending fails and the server never responds.
+type brokenConn struct {
+	grpc.ClientConnInterface
+}
+
+func (brokenConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return &brokenStream{ctx: ctx}, nil
+}
+
+type brokenStream struct {
+	grpc.ClientStream
+	ctx context.Context
+}
+
+func (s *brokenStream) SendMsg(any) error { return errors.New("connection reset") }
+
+func (s *brokenStream) RecvMsg(any) error {
+	<-s.ctx.Done()
+	return s.ctx.Err()
+}
+
+func TestGRPCTransportSendError(t *testing.T) {
+	g := NewWithT(t)
+
+	method := testdata.File_testdata_streaming_test_proto.Services().ByName("StreamingTestService").Methods().ByName("SyncItems")
+	requests := []*dynamicpb.Message{dynamicpb.NewMessage(method.Input())}
+	done := make(chan error, 1)
+	go func() {
+		done <- NewGRPCTransport(brokenConn{}).Invoke(context.Background(), method, requests, func(*dynamicpb.Message) error { return nil })
+	}()
+	g.Eventually(done).Should(Receive(MatchError("connection reset")))
+}
+
+func TestGatewayReflection(t *testing.T) {
+	g := NewWithT(t)
+
+	conn := startGRPC(t, &testServer{})
+	files, names, err := ReflectFiles(context.Background(), conn)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names).To(ConsistOf("testdata.TestService", "testdata.StreamingTestService"))
+
+	services, err := Services(files, names...)
+	g.Expect(err).ToNot(HaveOccurred())
+	s := mcpserver.NewMCPServer("test-gateway", "1.0.0")
+	g.Expect(Register(s, services, NewGRPCTransport(conn), runtime.LLMProviderStandard)).To(Succeed())
+	g.Expect(toolNames(g, s)).To(HaveLen(6))
+
+	result := callTool(g, s, "testdata_TestService_CreateItem", map[string]any{"name": "widget"})
+	g.Expect(structured(g, result)).To(HaveKeyWithValue("id", "item-123"))
+}
+
+type connectServer struct {
+	testdataconnect.UnimplementedTestServiceHandler
+	testdataconnect.UnimplementedStreamingTestServiceHandler
+}
+
+func (s *connectServer) CreateItem(ctx context.Context, req *connect.Request[testdata.CreateItemRequest]) (*connect.Response[testdata.CreateItemResponse], error) {
+	return connect.NewResponse(&testdata.CreateItemResponse{Id: "connect-" + req.Msg.GetName()}), nil
+}
+
+func (s *connectServer) SyncItems(ctx context.Context, stream *connect.BidiStream[testdata.SyncItemsRequest, testdata.SyncItemsResponse]) error {
+	for {
+		req, err := stream.Receive()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&testdata.SyncItemsResponse{Id: req.GetId(), InSync: true}); err != nil {
+			return err
+		}
+	}
+}
+
+func TestGatewayConnect(t *testing.T) {
+	g := NewWithT(t)
+
+	mux := http.NewServeMux()
+	mux.Handle(testdataconnect.NewTestServiceHandler(&connectServer{}))
+	mux.Handle(testdataconnect.NewStreamingTestServiceHandler(&connectServer{}))
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	path := writeDescriptorSet(t, testdata.File_testdata_test_service_proto, testdata.File_testdata_streaming_test_proto)
+	s := newGateway(g, path, NewConnectTransport(server.Client(), server.URL), runtime.LLMProviderStandard,
+		"testdata.TestService", "testdata.StreamingTestService")
+
+	result := callTool(g, s, "testdata_TestService_CreateItem", map[string]any{"name": "widget"})
+	g.Expect(structured(g, result)).To(HaveKeyWithValue("id", "connect-widget"))
+
+	result = callTool(g, s, "testdata_StreamingTestService_SyncItems", map[string]any{
+		"messages": []any{map[string]any{"id": "1"}, map[string]any{"id": "2"}},
+	})
+	g.Expect(structured(g, result)).To(HaveKeyWithValue("messages", HaveLen(2)))
+
+	// Unimplemented methods are tool errors
+	result = callTool(g, s, "testdata_StreamingTestService_UploadItems", map[string]any{"messages": []any{}})
+	g.Expect(result.IsError).To(BeTrue())
+}
+
+func TestServices(t *testing.T) {
+	g := NewWithT(t)
+
+	files, err := LoadFileDescriptorSet(writeDescriptorSet(t, testdata.File_testdata_test_service_proto, testdata.File_testdata_streaming_test_proto))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	services, err := Services(files)
+	g.Expect(err).ToNot(HaveOccurred())
+	var names []string
+	for _, svc := range services {
+		names = append(names, string(svc.FullName()))
+	}
+	g.Expect(names).To(Equal([]string{"testdata.StreamingTestService", "testdata.TestService"}))
+
+	_, err = Services(files, "testdata.CreateItemRequest")
+	g.Expect(err).To(MatchError("testdata.CreateItemRequest is not a service"))
+	_, err = Services(files, "testdata.Missing")
+	g.Expect(err).To(HaveOccurred())
+}
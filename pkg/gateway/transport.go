@@ -0,0 +1,214 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Transport calls the methods of a service.
+type Transport interface {
+	// Invoke calls the method with the requests, a single one unless the method is
+	// client-streaming, and passes every response to recv. The outgoing gRPC
+	// metadata of ctx is sent with the call.
+	Invoke(ctx context.Context, method protoreflect.MethodDescriptor, requests []*dynamicpb.Message, recv func(*dynamicpb.Message) error) error
+}
+
+// fullMethod returns the full RPC method name, such as "/package.Service/Method".
+func fullMethod(method protoreflect.MethodDescriptor) string {
+	return fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+}
+
+type grpcTransport struct {
+	conn grpc.ClientConnInterface
+	opts []grpc.CallOption
+}
+
+// NewGRPCTransport creates a transport that calls methods over a gRPC connection.
+func NewGRPCTransport(conn grpc.ClientConnInterface, opts ...grpc.CallOption) Transport {
+	return &grpcTransport{conn: conn, opts: opts}
+}
+
+func (t *grpcTransport) Invoke(ctx context.Context, method protoreflect.MethodDescriptor, requests []*dynamicpb.Message, recv func(*dynamicpb.Message) error) error {
+	if !method.IsStreamingClient() && !method.IsStreamingServer() {
+		resp := dynamicpb.NewMessage(method.Output())
+		if err := t.conn.Invoke(ctx, fullMethod(method), requests[0], resp, t.opts...); err != nil {
+			return err
+		}
+		return recv(resp)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := t.conn.NewStream(ctx, &grpc.StreamDesc{
+		StreamName:    string(method.Name()),
+		ServerStreams: method.IsStreamingServer(),
+		ClientStreams: method.IsStreamingClient(),
+	}, fullMethod(method), t.opts...)
+	if err != nil {
+		return err
+	}
+
+	// Responses are received while sending, like runtime.Exchange does.
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			resp := dynamicpb.NewMessage(method.Output())
+			if err := stream.RecvMsg(resp); err != nil {
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+				recvErr <- err
+				return
+			}
+			if err := recv(resp); err != nil {
+				recvErr <- err
+				return
+			}
+		}
+	}()
+
+	sendErr := runtime.SendAll(requests, func(req *dynamicpb.Message) error { return stream.SendMsg(req) })
+	if sendErr == nil {
+		sendErr = stream.CloseSend()
+	}
+	if sendErr != nil {
+		// The server might never respond, cancel the stream so that receiving returns.
+		cancel()
+		<-recvErr
+		return sendErr
+	}
+	return <-recvErr
+}
+
+type connectTransport struct {
+	httpClient connect.HTTPClient
+	baseURL    string
+	opts       []connect.ClientOption
+}
+
+// NewConnectTransport creates a transport that calls methods with Connect, at the
+// given base URL of the server. Client-streaming and bidirectional methods require an
+// HTTP client with HTTP/2 support.
+func NewConnectTransport(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) Transport {
+	return &connectTransport{httpClient: httpClient, baseURL: strings.TrimSuffix(baseURL, "/"), opts: opts}
+}
+
+func (t *connectTransport) Invoke(ctx context.Context, method protoreflect.MethodDescriptor, requests []*dynamicpb.Message, recv func(*dynamicpb.Message) error) error {
+	opts := append([]connect.ClientOption{
+		connect.WithSchema(method),
+		connect.WithResponseInitializer(initializeResponse),
+	}, t.opts...)
+	client := connect.NewClient[dynamicpb.Message, dynamicpb.Message](t.httpClient, t.baseURL+fullMethod(method), opts...)
+
+	switch {
+	case method.IsStreamingClient() && method.IsStreamingServer():
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream := client.CallBidiStream(ctx)
+		runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+		recvErr := make(chan error, 1)
+		go func() {
+			for {
+				resp, err := stream.Receive()
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						err = nil
+					}
+					recvErr <- err
+					return
+				}
+				if err := recv(resp); err != nil {
+					recvErr <- err
+					return
+				}
+			}
+		}()
+		sendErr := runtime.SendAll(requests, stream.Send)
+		if err := stream.CloseRequest(); sendErr == nil {
+			sendErr = err
+		}
+		if sendErr != nil {
+			cancel()
+			<-recvErr
+			_ = stream.CloseResponse()
+			return sendErr
+		}
+		err := <-recvErr
+		if closeErr := stream.CloseResponse(); err == nil {
+			err = closeErr
+		}
+		return err
+	case method.IsStreamingClient():
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream := client.CallClientStream(ctx)
+		runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+		if err := runtime.SendAll(requests, stream.Send); err != nil {
+			return err
+		}
+		resp, err := stream.CloseAndReceive()
+		if err != nil {
+			return err
+		}
+		return recv(resp.Msg)
+	case method.IsStreamingServer():
+		req := connect.NewRequest(requests[0])
+		runtime.SetConnectHeaders(ctx, req.Header())
+		stream, err := client.CallServerStream(ctx, req)
+		if err != nil {
+			return err
+		}
+		defer stream.Close()
+		for stream.Receive() {
+			if err := recv(stream.Msg()); err != nil {
+				return err
+			}
+		}
+		return stream.Err()
+	default:
+		req := connect.NewRequest(requests[0])
+		runtime.SetConnectHeaders(ctx, req.Header())
+		resp, err := client.CallUnary(ctx, req)
+		if err != nil {
+			return err
+		}
+		return recv(resp.Msg)
+	}
+}
+
+// initializeResponse creates the dynamic response message of the method of a call.
+func initializeResponse(spec connect.Spec, message any) error {
+	msg, ok := message.(*dynamicpb.Message)
+	if !ok {
+		return nil
+	}
+	method, ok := spec.Schema.(protoreflect.MethodDescriptor)
+	if !ok {
+		return fmt.Errorf("no method descriptor for %s", spec.Procedure)
+	}
+	*msg = *dynamicpb.NewMessage(method.Output())
+	return nil
+}
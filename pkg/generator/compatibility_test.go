@@ -23,6 +23,7 @@ import (
 	. "github.com/onsi/gomega"
 	jsonschema "github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/statico/protoc-gen-go-mcp/pkg/schema"
 	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -166,9 +167,7 @@ func TestCompat(t *testing.T) {
 				input = tt.rawJsonInput
 			}
 
-			// Create a generator instance to access messageSchema method
-			fg := &FileGenerator{openAICompat: false}
-			schemaMap := fg.messageSchema(tt.input.ProtoReflect().Descriptor())
+			schemaMap := schema.Message(tt.input.ProtoReflect().Descriptor())
 			schemaJSON, err := json.Marshal(schemaMap)
 			g.Expect(err).ToNot(HaveOccurred())
 
@@ -234,7 +233,7 @@ func TestCompatOpenAI(t *testing.T) {
 				"value_field": "42",
 				"list_value": "[true]",
 				"field_mask": "foo,bar",
-				"any": {"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "test"},
+				"any": "{\"@type\": \"type.googleapis.com/google.protobuf.StringValue\", \"value\": \"test\"}",
 				"string_value": "hello",
 				"int32_value": 123,
 				"int64_value": "1234567890123",
@@ -264,7 +263,7 @@ func TestCompatOpenAI(t *testing.T) {
 				"value_field": "42",
 				"list_value": "[true]",
 				"field_mask": "foo,bar",
-				"any": {"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "test"},
+				"any": "{\"@type\": \"type.googleapis.com/google.protobuf.StringValue\", \"value\": \"test\"}",
 				"string_value": "hello",
 				"int32_value": 123,
 				"int64_value": "1234567890123",
@@ -287,6 +286,39 @@ func TestCompatOpenAI(t *testing.T) {
 				]
 			}`),
 		},
+		{
+			name:  "maps with message values and non-string keys",
+			input: &testdata.NestedMapTestMessage{},
+			rawJsonInput: json.RawMessage(`{
+				"maps": [{"string_map": [{"key": "a", "value": "1"}]}],
+				"map_messages": [{"key": "first", "value": {"string_map": [{"key": "b", "value": "2"}]}}],
+				"wkts": [],
+				"values": [{"key": "v", "value": "{\"nested\": true}"}],
+				"structs": [],
+				"int_map": [{"key": "-7", "value": "seven"}],
+				"priorities": [{"key": "true", "value": "PRIORITY_HIGH"}]
+			}`),
+		},
+		{
+			name:  "map message value missing a property",
+			input: &testdata.NestedMapTestMessage{},
+			rawJsonInput: json.RawMessage(`{
+				"maps": [], "wkts": [], "values": [], "structs": [], "int_map": [], "priorities": [],
+				"map_messages": [{"key": "first", "value": {}}]
+			}`),
+			errorExpected: true,
+			errorContains: "missing properties: 'string_map'",
+		},
+		{
+			name:  "integer map key that is not a number",
+			input: &testdata.NestedMapTestMessage{},
+			rawJsonInput: json.RawMessage(`{
+				"maps": [], "map_messages": [], "wkts": [], "values": [], "structs": [], "priorities": [],
+				"int_map": [{"key": "seven", "value": "seven"}]
+			}`),
+			errorExpected: true,
+			errorContains: "does not match pattern",
+		},
 	}
 
 	for _, tt := range tests {
@@ -301,9 +333,7 @@ func TestCompatOpenAI(t *testing.T) {
 				input = tt.rawJsonInput
 			}
 
-			// Create a generator instance to access messageSchema method with OpenAI compatibility
-			fg := &FileGenerator{openAICompat: true}
-			schemaMap := fg.messageSchema(tt.input.ProtoReflect().Descriptor())
+			schemaMap := schema.Message(tt.input.ProtoReflect().Descriptor(), schema.WithProvider(runtime.LLMProviderOpenAI))
 			schemaJSON, err := json.Marshal(schemaMap)
 			g.Expect(err).ToNot(HaveOccurred())
 
@@ -326,9 +356,12 @@ func TestCompatOpenAI(t *testing.T) {
 			err = schema.Validate(jsonData)
 			if tt.errorExpected {
 				g.Expect(err).To(HaveOccurred())
-			} else {
-				g.Expect(err).ToNot(HaveOccurred())
+				if tt.errorContains != "" {
+					g.Expect(err).To(MatchError(ContainSubstring(tt.errorContains)))
+				}
+				return
 			}
+			g.Expect(err).ToNot(HaveOccurred())
 
 			// Then apply Fix for OpenAI compatibility mode (converts all OpenAI format back to protobuf format)
 			// This is what would happen in the actual MCP tool execution before protojson.Unmarshal
@@ -339,28 +372,10 @@ func TestCompatOpenAI(t *testing.T) {
 				fixedJSON, err := json.Marshal(rawData)
 				g.Expect(err).ToNot(HaveOccurred())
 
-				var testProto proto.Message
-				switch tt.input.(type) {
-				case *testdata.MapTestMessage:
-					testProto = &testdata.MapTestMessage{}
-				case *testdata.WktTestMessage:
-					testProto = &testdata.WktTestMessage{}
-				}
-
-				if testProto != nil {
-					err = protojson.Unmarshal(fixedJSON, testProto)
-					g.Expect(err).ToNot(HaveOccurred())
-				}
-			}
-			if tt.errorExpected {
-				g.Expect(err).To(HaveOccurred())
-			} else {
+				testProto := tt.input.ProtoReflect().New().Interface()
+				err = protojson.Unmarshal(fixedJSON, testProto)
 				g.Expect(err).ToNot(HaveOccurred())
 			}
-
-			if tt.errorContains != "" {
-				g.Expect(err).To(MatchError(ContainSubstring(tt.errorContains)))
-			}
 		})
 	}
 }
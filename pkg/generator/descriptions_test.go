@@ -0,0 +1,130 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/statico/protoc-gen-go-mcp/pkg/schema"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// commentedMessage builds a message descriptor with source info, like protoc passes it to the plugin.
+func commentedMessage(t *testing.T) protoreflect.MessageDescriptor {
+	location := func(leading, trailing string, path ...int32) *descriptorpb.SourceCodeInfo_Location {
+		loc := &descriptorpb.SourceCodeInfo_Location{Path: path, Span: []int32{0, 0, 0}}
+		if leading != "" {
+			loc.LeadingComments = proto.String(leading)
+		}
+		if trailing != "" {
+			loc.TrailingComments = proto.String(trailing)
+		}
+		return loc
+	}
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("descriptions.proto"),
+		Package: proto.String("descriptions"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Item"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     proto.String("name"),
+					JsonName: proto.String("name"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				},
+				{
+					Name:     proto.String("status"),
+					JsonName: proto.String("status"),
+					Number:   proto.Int32(2),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum(),
+					TypeName: proto.String(".descriptions.Status"),
+				},
+				{
+					Name:     proto.String("count"),
+					JsonName: proto.String("count"),
+					Number:   proto.Int32(3),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+				},
+			},
+		}},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Status"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("STATUS_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("STATUS_ACTIVE"), Number: proto.Int32(1)},
+			},
+		}},
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+			location(" An item in the inventory.\n", "", 4, 0),
+			location(" Name of the item.\n", " Must be unique.\n", 4, 0, 2, 0),
+			location(" Status of an item.\n", "", 5, 0),
+			location(" The item can be ordered.\n", "", 5, 0, 2, 1),
+		}},
+	}
+
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd.Messages().Get(0)
+}
+
+func TestSchemaDescriptions(t *testing.T) {
+	for _, provider := range []runtime.LLMProvider{runtime.LLMProviderStandard, runtime.LLMProviderOpenAI} {
+		g := NewWithT(t)
+
+		s := schema.Message(commentedMessage(t), schema.WithProvider(provider))
+		props := s["properties"].(map[string]any)
+
+		g.Expect(s["description"]).To(Equal("An item in the inventory."))
+		g.Expect(props["name"]).To(HaveKeyWithValue("description", "Name of the item.\n\nMust be unique."))
+		g.Expect(props["status"]).To(HaveKeyWithValue("description", "Status of an item.\n\nValues:\n- STATUS_ACTIVE: The item can be ordered."))
+		g.Expect(props["count"]).ToNot(HaveKey("description"))
+	}
+}
+
+func TestOmitDescriptions(t *testing.T) {
+	g := NewWithT(t)
+
+	s := schema.Message(commentedMessage(t), schema.WithoutDescriptions())
+	props := s["properties"].(map[string]any)
+
+	g.Expect(s).ToNot(HaveKey("description"))
+	g.Expect(props["name"]).ToNot(HaveKey("description"))
+	g.Expect(props["status"]).ToNot(HaveKey("description"))
+}
+
+func TestGeneratedSchemaDescriptions(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(testdatamcp.TestService_CreateItemTool.RawInputSchema, &schema)).To(Succeed())
+	props := schema["properties"].(map[string]any)
+	g.Expect(props["name"]).To(HaveKeyWithValue("description", "Required field"))
+	g.Expect(props["tags"]).To(HaveKeyWithValue("description", "Repeated field"))
+}
@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
 	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
 )
 
 func TestExtraPropertiesSchemaModification(t *testing.T) {
@@ -305,3 +306,79 @@ func TestExtraPropertiesContextIntegration(t *testing.T) {
 	// Verify the URL string was set in context and received by server
 	g.Expect(server.lastURLString).To(Equal("https://api.example.com:8080/v1"))
 }
+
+// typedExtraServer records the value of the page_size extra property.
+type typedExtraServer struct {
+	testServer
+	calls    int
+	pageSize any
+}
+
+func (s *typedExtraServer) CreateItem(ctx context.Context, in *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error) {
+	s.calls++
+	s.pageSize = ctx.Value("page_size_key")
+	return &testdata.CreateItemResponse{Id: "item-123"}, nil
+}
+
+func TestTypedExtraPropertiesGeneratedHandler(t *testing.T) {
+	pageSize := runtime.ExtraProperty{
+		Name:        "page_size",
+		Description: "Page size",
+		Schema:      map[string]any{"type": "integer"},
+		Default:     50,
+		ContextKey:  "page_size_key",
+	}
+
+	call := func(g *WithT, s *mcpserver.MCPServer, arguments map[string]any) mcp.CallToolResult {
+		request, err := json.Marshal(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"method":  "tools/call",
+			"params": map[string]any{
+				"name":      "testdata_TestService_CreateItem",
+				"arguments": arguments,
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		response := s.HandleMessage(context.Background(), request)
+		g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+		return response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult)
+	}
+
+	t.Run("standard", func(t *testing.T) {
+		g := NewWithT(t)
+
+		srv := &typedExtraServer{}
+		s := mcpserver.NewMCPServer("test-server", "1.0.0")
+		testdatamcp.RegisterTestServiceHandler(s, srv, runtime.WithExtraProperties(pageSize))
+
+		result := call(g, s, map[string]any{"name": "widget", "page_size": 10})
+		g.Expect(result.IsError).To(BeFalse())
+		g.Expect(srv.pageSize).To(Equal(int64(10)))
+
+		result = call(g, s, map[string]any{"name": "widget"})
+		g.Expect(result.IsError).To(BeFalse())
+		g.Expect(srv.pageSize).To(Equal(int64(50)))
+
+		result = call(g, s, map[string]any{"name": "widget", "page_size": "ten"})
+		g.Expect(result.IsError).To(BeTrue())
+		g.Expect(srv.calls).To(Equal(2))
+	})
+
+	t.Run("openai", func(t *testing.T) {
+		g := NewWithT(t)
+
+		srv := &typedExtraServer{}
+		s := mcpserver.NewMCPServer("test-server", "1.0.0")
+		testdatamcp.RegisterTestServiceHandlerOpenAI(s, srv, runtime.WithExtraProperties(pageSize))
+
+		// OpenAI sends null for optional properties
+		result := call(g, s, map[string]any{"name": "widget", "page_size": nil})
+		g.Expect(result.IsError).To(BeFalse())
+		g.Expect(srv.pageSize).To(Equal(int64(50)))
+
+		result = call(g, s, map[string]any{"name": "widget", "page_size": 1.5})
+		g.Expect(result.IsError).To(BeTrue())
+		g.Expect(srv.calls).To(Equal(1))
+	})
+}
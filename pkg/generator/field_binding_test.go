@@ -0,0 +1,101 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+type tenantKey struct{}
+
+// bindingServer records the requests of CreateItem.
+type bindingServer struct {
+	testServer
+	requests []*testdata.CreateItemRequest
+}
+
+func (s *bindingServer) CreateItem(ctx context.Context, in *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error) {
+	s.requests = append(s.requests, in)
+	return &testdata.CreateItemResponse{Id: "item-123"}, nil
+}
+
+func TestFieldBindings(t *testing.T) {
+	binding := runtime.WithFieldBindings(runtime.FieldBinding{Path: "name", ContextKey: tenantKey{}})
+
+	for _, register := range []struct {
+		name     string
+		register func(s *mcpserver.MCPServer, srv testdatamcp.TestServiceServer, opts ...runtime.Option)
+	}{
+		{"standard", testdatamcp.RegisterTestServiceHandler},
+		{"openai", testdatamcp.RegisterTestServiceHandlerOpenAI},
+		{"gemini", testdatamcp.RegisterTestServiceHandlerGemini},
+	} {
+		t.Run(register.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			srv := &bindingServer{}
+			s := mcpserver.NewMCPServer("test-server", "1.0.0")
+			register.register(s, srv, binding)
+
+			// The bound field is not in the schema
+			response := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+			g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+			found := false
+			for _, tool := range response.(mcp.JSONRPCResponse).Result.(mcp.ListToolsResult).Tools {
+				if tool.Name != "testdata_TestService_CreateItem" {
+					continue
+				}
+				found = true
+				var schema map[string]any
+				g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+				g.Expect(schema["properties"]).ToNot(HaveKey("name"))
+				g.Expect(schema["properties"]).To(HaveKey("tags"))
+				g.Expect(schema["required"]).ToNot(ContainElement("name"))
+			}
+			g.Expect(found).To(BeTrue())
+
+			// A value sent by the model is overwritten
+			request, err := json.Marshal(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      2,
+				"method":  "tools/call",
+				"params": map[string]any{
+					"name":      "testdata_TestService_CreateItem",
+					"arguments": map[string]any{"name": "other-tenant"},
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+			ctx := context.WithValue(context.Background(), tenantKey{}, "tenant-1")
+			response = s.HandleMessage(ctx, request)
+			g.Expect(response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult).IsError).To(BeFalse())
+			g.Expect(srv.requests).To(HaveLen(1))
+			g.Expect(srv.requests[0].GetName()).To(Equal("tenant-1"))
+
+			// Calls without a value for the field fail
+			response = s.HandleMessage(context.Background(), request)
+			g.Expect(response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult).IsError).To(BeTrue())
+			g.Expect(srv.requests).To(HaveLen(1))
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// geminiAllowedKeywords is the OpenAPI subset accepted by Gemini function declarations.
+var geminiAllowedKeywords = map[string]bool{
+	"type": true, "format": true, "description": true, "nullable": true, "enum": true,
+	"properties": true, "required": true, "items": true,
+	"minimum": true, "maximum": true, "minLength": true, "maxLength": true,
+	"minItems": true, "maxItems": true, "pattern": true,
+}
+
+func TestGeminiSchemas(t *testing.T) {
+	tools := map[string]mcp.Tool{
+		"create item":           testdatamcp.TestService_CreateItemToolGemini,
+		"well known types":      testdatamcp.TestService_ProcessWellKnownTypesToolGemini,
+		"recursive":             testdatamcp.RecursiveTestService_CreateTreeToolGemini,
+		"validate":              testdatamcp.ValidateTestService_CreateUserToolGemini,
+		"client streaming":      testdatamcp.StreamingTestService_UploadItemsToolGemini,
+		"bidirectional streams": testdatamcp.StreamingTestService_SyncItemsToolGemini,
+	}
+
+	for name, tool := range tools {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var schema map[string]any
+			g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+			checkGeminiSchema(g, "", schema)
+		})
+	}
+
+	t.Run("maps become key value arrays", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var schema map[string]any
+		g.Expect(json.Unmarshal(testdatamcp.TestService_CreateItemToolGemini.RawInputSchema, &schema)).To(Succeed())
+		labels := schema["properties"].(map[string]any)["labels"].(map[string]any)
+		g.Expect(labels).To(HaveKeyWithValue("type", "array"))
+		g.Expect(labels["items"]).To(HaveKeyWithValue("required", ConsistOf("key", "value")))
+	})
+
+	t.Run("any is a json string", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var schema map[string]any
+		g.Expect(json.Unmarshal(testdatamcp.TestService_ProcessWellKnownTypesToolGemini.RawInputSchema, &schema)).To(Succeed())
+		props := schema["properties"].(map[string]any)
+		g.Expect(props["payload"]).To(HaveKeyWithValue("type", "string"))
+		g.Expect(props["metadata"]).To(HaveKeyWithValue("type", "string"))
+		g.Expect(props["timestamp"]).To(HaveKeyWithValue("nullable", true))
+	})
+}
+
+// checkGeminiSchema asserts that a schema only uses keywords Gemini accepts.
+func checkGeminiSchema(g Gomega, path string, schema map[string]any) {
+	for keyword := range schema {
+		g.Expect(geminiAllowedKeywords).To(HaveKey(keyword), "unsupported keyword at %q", path)
+	}
+	g.Expect(schema["type"]).To(BeAssignableToTypeOf(""), "type at %q must be a single type", path)
+	if format, ok := schema["format"]; ok {
+		g.Expect(format).To(Equal("date-time"), "format at %q", path)
+	}
+	if props, ok := schema["properties"].(map[string]any); ok {
+		for name, prop := range props {
+			checkGeminiSchema(g, path+"."+name, prop.(map[string]any))
+		}
+	}
+	if items, ok := schema["items"].(map[string]any); ok {
+		checkGeminiSchema(g, path+"[]", items)
+	}
+}
+
+func TestGeminiHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	var captured *testdata.CreateItemRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterTestServiceHandlerWithProvider(s, &testServer{}, runtime.LLMProviderGemini, runtime.WithInterceptors(
+		func(ctx context.Context, req any, info *runtime.ToolInfo, handler runtime.ToolHandler) (any, error) {
+			captured = req.(*testdata.CreateItemRequest)
+			return handler(ctx, req)
+		},
+	))
+
+	result := callStreamingTool(t, s, "testdata_TestService_CreateItem", map[string]any{
+		"name": "widget",
+		"labels": []any{
+			map[string]any{"key": "env", "value": "prod"},
+		},
+		"product": nil,
+	})
+	g.Expect(result).To(HaveKeyWithValue("id", "item-123"))
+	g.Expect(captured.GetName()).To(Equal("widget"))
+	g.Expect(captured.GetLabels()).To(Equal(map[string]string{"env": "prod"}))
+}
@@ -15,21 +15,18 @@
 package generator
 
 import (
-	"crypto/sha1"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"go/token"
-	"math/big"
 	"path"
 	"path/filepath"
 	"strings"
 	"text/template"
 
 	"github.com/mark3labs/mcp-go/mcp"
-	"google.golang.org/genproto/googleapis/api/annotations"
+	"github.com/statico/protoc-gen-go-mcp/pkg/mcptool"
 	"google.golang.org/protobuf/compiler/protogen"
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
@@ -37,19 +34,51 @@ const (
 	GeneratedFilenameExtension = ".pb.mcp.go"
 )
 
+// Runtime selects the MCP SDK the generated code registers its tools with.
+type Runtime string
+
+const (
+	// RuntimeMark3Labs generates code for github.com/mark3labs/mcp-go.
+	RuntimeMark3Labs Runtime = "mark3labs"
+	// RuntimeGoSDK generates code for the official github.com/modelcontextprotocol/go-sdk.
+	RuntimeGoSDK Runtime = "gosdk"
+)
+
 type FileGenerator struct {
 	f   *protogen.File
 	gen *protogen.Plugin
 
-	gf            *protogen.GeneratedFile
-	openAICompat  bool
-	packagePrefix string
+	gf               *protogen.GeneratedFile
+	packagePrefix    string
+	omitDescriptions bool
+	listAllTools     bool
+	runtime          Runtime
 }
 
 func NewFileGenerator(f *protogen.File, gen *protogen.Plugin, packagePrefix string) *FileGenerator {
 	gen.SupportedFeatures |= uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 
-	return &FileGenerator{f: f, gen: gen, packagePrefix: packagePrefix}
+	return &FileGenerator{f: f, gen: gen, packagePrefix: packagePrefix, runtime: RuntimeMark3Labs}
+}
+
+// UseRuntime selects the MCP SDK the generated code is written against.
+func (g *FileGenerator) UseRuntime(runtime Runtime) *FileGenerator {
+	g.runtime = runtime
+	return g
+}
+
+// OmitDescriptions stops proto comments from being emitted as schema descriptions,
+// which keeps the schemas small.
+func (g *FileGenerator) OmitDescriptions() *FileGenerator {
+	g.omitDescriptions = true
+	return g
+}
+
+// ListAllTools adds a tool to every AIP-158 list method, which follows the pages of
+// the method and returns the merged list, see runtime.ListAll.
+func (g *FileGenerator) ListAllTools() *FileGenerator {
+	g.listAllTools = true
+	return g
 }
 
 // getQualifiedTypeName returns a qualified type name, optionally adding a package prefix
@@ -82,8 +111,12 @@ package {{ .GoPackage }}
 
 import (
   "context"
+  {{- if .GoSDK }}
+  "github.com/modelcontextprotocol/go-sdk/mcp"
+  {{- else }}
   "github.com/mark3labs/mcp-go/mcp"
   mcpserver "github.com/mark3labs/mcp-go/server"
+  {{- end }}
   "encoding/json"
   "google.golang.org/protobuf/encoding/protojson"
   "connectrpc.com/connect"
@@ -94,10 +127,13 @@ import (
 
 var (
 {{- range $key, $val := .Tools }}
-  {{$key}}Tool = {{ printf "%#v" $val }}
+  {{$key}}Tool = {{ toolLiteral $val }}
 {{- end }}
 {{- range $key, $val := .ToolsOpenAI }}
-  {{$key}}ToolOpenAI = {{ printf "%#v" $val }}
+  {{$key}}ToolOpenAI = {{ toolLiteral $val }}
+{{- end }}
+{{- range $key, $val := .ToolsGemini }}
+  {{$key}}ToolGemini = {{ toolLiteral $val }}
 {{- end }}
 )
 
@@ -105,120 +141,380 @@ var (
 // {{$serviceName}}Server is compatible with the grpc-go server interface.
 type {{$serviceName}}Server interface {
   {{- range $methodName, $tool := $methods }}
+  {{- if and $tool.ClientStreaming $tool.ServerStreaming }}
+  {{$methodName}}(stream grpc.BidiStreamingServer[{{$tool.RequestType}}, {{$tool.ResponseType}}]) error
+  {{- else if $tool.ClientStreaming }}
+  {{$methodName}}(stream grpc.ClientStreamingServer[{{$tool.RequestType}}, {{$tool.ResponseType}}]) error
+  {{- else if $tool.ServerStreaming }}
+  {{$methodName}}(req *{{$tool.RequestType}}, stream grpc.ServerStreamingServer[{{$tool.ResponseType}}]) error
+  {{- else }}
   {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}) (*{{$tool.ResponseType}}, error)
   {{- end }}
+  {{- end }}
 }
 {{ end }}
 
 {{- range $key, $val := .Services }}
 // Register{{$key}}Handler registers standard MCP handlers for {{$key}}
-func Register{{$key}}Handler(s *mcpserver.MCPServer, srv {{$key}}Server, opts ...runtime.Option) {
+func Register{{$key}}Handler(s {{$.Server}}, srv {{$key}}Server, opts ...runtime.Option) {
   config := runtime.NewConfig()
   for _, opt := range opts {
     opt(config)
   }
 
+  provider := runtime.LLMProviderStandard
+  if len(config.SchemaTransforms) > 0 {
+    provider = runtime.LLMProviderCustom
+  }
+
   {{- range $tool_name, $tool_val := $val }}
   {{$tool_name}}Tool := {{$key}}_{{$tool_name}}Tool
+  // Rewrite the schema if schema transforms are configured
+  if len(config.SchemaTransforms) > 0 {
+    {{$tool_name}}Tool = runtime.TransformTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.SchemaTransforms)
+  }
   // Add extra properties to schema if configured
   if len(config.ExtraProperties) > 0 {
-    {{$tool_name}}Tool = runtime.AddExtraPropertiesToTool({{$tool_name}}Tool, config.ExtraProperties)
+    {{$tool_name}}Tool = runtime.AddExtraPropertiesToTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.ExtraProperties, config.SchemaTransforms...)
+  }
+  // Remove bound fields from schema, they are set from the session
+  if len(config.FieldBindings) > 0 {
+    {{$tool_name}}Tool = runtime.BindFieldsToTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.FieldBindings, {{$tool_val.ClientStreaming}})
   }
 
-  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request {{$.CallToolRequest}}) (*mcp.CallToolResult, error) {
+    {{- if not $tool_val.ClientStreaming }}
     var req {{$tool_val.RequestType}}
-
-    message := request.GetArguments()
+    {{ end }}
+    message := {{ if $.GoSDK }}runtime.ArgumentsGoSDK(request){{ else }}request.GetArguments(){{ end }}
 
     // Extract extra properties if configured
-    for _, prop := range config.ExtraProperties {
-      if propVal, ok := message[prop.Name]; ok {
-        ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+    ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- if $tool_val.ClientStreaming }}
+
+    reqs, err := runtime.UnmarshalStreamRequests[{{$tool_val.RequestType}}](message, provider)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.ValidateStream(config, reqs); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- else }}
+
+    runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+    if err := runtime.UnmarshalArguments(message, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.Validate(config, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- end }}
+    {{- if and $tool_val.ClientStreaming $tool_val.ServerStreaming }}
+
+    collector := runtime.NewStreamCollector{{$.RuntimeSuffix}}(ctx, request, config)
+    _, err = runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", reqs, func(ctx context.Context, req any) (any, error) {
+      return nil, srv.{{$tool_name}}(runtime.NewClientStream[{{$tool_val.RequestType}}, {{$tool_val.ResponseType}}](ctx, req.([]*{{$tool_val.RequestType}}), collector))
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    return collector.Result{{$.RuntimeSuffix}}()
+    {{- else if $tool_val.ClientStreaming }}
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", reqs, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      stream := runtime.NewClientStream[{{$tool_val.RequestType}}, {{$tool_val.ResponseType}}](ctx, req.([]*{{$tool_val.RequestType}}), nil)
+      if err := srv.{{$tool_name}}(stream); err != nil {
+        return nil, err
       }
+      return stream.Response(), nil
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    marshaled, err := json.Marshal(message)
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
     if err != nil {
       return nil, err
     }
 
-    if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-      return nil, err
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+    {{- else if $tool_val.ServerStreaming }}
+
+    collector := runtime.NewStreamCollector{{$.RuntimeSuffix}}(ctx, request, config)
+    _, err = runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (any, error) {
+      return nil, srv.{{$tool_name}}(req.(*{{$tool_val.RequestType}}), runtime.NewServerStream[{{$tool_val.ResponseType}}](ctx, collector))
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    resp, err := srv.{{$tool_name}}(ctx, &req)
+    return collector.Result{{$.RuntimeSuffix}}()
+    {{- else }}
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      return srv.{{$tool_name}}(ctx, req.(*{{$tool_val.RequestType}}))
+    })
     if err != nil {
-      return runtime.HandleError(err)
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
     if err != nil {
       return nil, err
     }
 
-    return mcp.NewToolResultText(string(marshaled)), nil
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+    {{- end }}
   })
+  {{- if $tool_val.ListAll }}
+
+  // {{$tool_name}}AllTool follows the pages of {{$tool_name}}
+  {{$tool_name}}AllTool := {{$key}}_{{$tool_name}}AllTool
+  if len(config.SchemaTransforms) > 0 {
+    {{$tool_name}}AllTool = runtime.TransformTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.SchemaTransforms)
+  }
+  if len(config.ExtraProperties) > 0 {
+    {{$tool_name}}AllTool = runtime.AddExtraPropertiesToTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.ExtraProperties, config.SchemaTransforms...)
+  }
+  if len(config.FieldBindings) > 0 {
+    {{$tool_name}}AllTool = runtime.BindFieldsToTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.FieldBindings, false)
+  }
+
+  s.AddTool({{$tool_name}}AllTool, func(ctx context.Context, request {{$.CallToolRequest}}) (*mcp.CallToolResult, error) {
+    var req {{$tool_val.RequestType}}
+    message := {{ if $.GoSDK }}runtime.ArgumentsGoSDK(request){{ else }}request.GetArguments(){{ end }}
+
+    ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+    if err := runtime.UnmarshalArguments(message, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.Validate(config, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      return runtime.ListAll(ctx, config, req.(*{{$tool_val.RequestType}}), "{{$tool_val.ListAll.ItemsField}}", srv.{{$tool_name}})
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    if err != nil {
+      return nil, err
+    }
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+  })
+  {{- end }}
   {{- end }}
 }
 
-// Register{{$key}}HandlerOpenAI registers OpenAI-compatible MCP handlers for {{$key}}
-func Register{{$key}}HandlerOpenAI(s *mcpserver.MCPServer, srv {{$key}}Server, opts ...runtime.Option) {
+{{- range $variant := $.Variants }}
+
+// Register{{$key}}Handler{{$variant.Suffix}} registers {{$variant.Name}}-compatible MCP handlers for {{$key}}
+func Register{{$key}}Handler{{$variant.Suffix}}(s {{$.Server}}, srv {{$key}}Server, opts ...runtime.Option) {
   config := runtime.NewConfig()
   for _, opt := range opts {
     opt(config)
   }
 
   {{- range $tool_name, $tool_val := $val }}
-  {{$tool_name}}ToolOpenAI := {{$key}}_{{$tool_name}}ToolOpenAI
+  {{$tool_name}}Tool{{$variant.Suffix}} := {{$key}}_{{$tool_name}}Tool{{$variant.Suffix}}
   // Add extra properties to schema if configured
   if len(config.ExtraProperties) > 0 {
-    {{$tool_name}}ToolOpenAI = runtime.AddExtraPropertiesToTool({{$tool_name}}ToolOpenAI, config.ExtraProperties)
+    {{$tool_name}}Tool{{$variant.Suffix}} = runtime.AddExtraPropertiesToTool{{$.RuntimeSuffix}}({{$tool_name}}Tool{{$variant.Suffix}}, config.ExtraProperties, runtime.SchemaTransforms(runtime.{{$variant.Provider}})...)
+  }
+  // Remove bound fields from schema, they are set from the session
+  if len(config.FieldBindings) > 0 {
+    {{$tool_name}}Tool{{$variant.Suffix}} = runtime.BindFieldsToTool{{$.RuntimeSuffix}}({{$tool_name}}Tool{{$variant.Suffix}}, config.FieldBindings, {{$tool_val.ClientStreaming}})
   }
 
-  s.AddTool({{$tool_name}}ToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+  s.AddTool({{$tool_name}}Tool{{$variant.Suffix}}, func(ctx context.Context, request {{$.CallToolRequest}}) (*mcp.CallToolResult, error) {
+    {{- if not $tool_val.ClientStreaming }}
     var req {{$tool_val.RequestType}}
-
-    message := request.GetArguments()
+    {{ end }}
+    message := {{ if $.GoSDK }}runtime.ArgumentsGoSDK(request){{ else }}request.GetArguments(){{ end }}
 
     // Extract extra properties if configured
-    for _, prop := range config.ExtraProperties {
-      if propVal, ok := message[prop.Name]; ok {
-        ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-      }
+    ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- if $tool_val.ClientStreaming }}
+
+    reqs, err := runtime.UnmarshalStreamRequests[{{$tool_val.RequestType}}](message, runtime.{{$variant.Provider}})
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.ValidateStream(config, reqs); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- else }}
+
+    runtime.Fix(runtime.{{$variant.Provider}}, req.ProtoReflect().Descriptor(), message)
+
+    if err := runtime.UnmarshalArguments(message, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+    if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.Validate(config, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- end }}
+    {{- if and $tool_val.ClientStreaming $tool_val.ServerStreaming }}
 
-    marshaled, err := json.Marshal(message)
+    collector := runtime.NewStreamCollector{{$.RuntimeSuffix}}(ctx, request, config)
+    _, err = runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", reqs, func(ctx context.Context, req any) (any, error) {
+      return nil, srv.{{$tool_name}}(runtime.NewClientStream[{{$tool_val.RequestType}}, {{$tool_val.ResponseType}}](ctx, req.([]*{{$tool_val.RequestType}}), collector))
+    })
     if err != nil {
-      return nil, err
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    return collector.Result{{$.RuntimeSuffix}}()
+    {{- else if $tool_val.ClientStreaming }}
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", reqs, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      stream := runtime.NewClientStream[{{$tool_val.RequestType}}, {{$tool_val.ResponseType}}](ctx, req.([]*{{$tool_val.RequestType}}), nil)
+      if err := srv.{{$tool_name}}(stream); err != nil {
+        return nil, err
+      }
+      return stream.Response(), nil
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    if err != nil {
       return nil, err
     }
 
-    resp, err := srv.{{$tool_name}}(ctx, &req)
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+    {{- else if $tool_val.ServerStreaming }}
+
+    collector := runtime.NewStreamCollector{{$.RuntimeSuffix}}(ctx, request, config)
+    _, err = runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (any, error) {
+      return nil, srv.{{$tool_name}}(req.(*{{$tool_val.RequestType}}), runtime.NewServerStream[{{$tool_val.ResponseType}}](ctx, collector))
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    return collector.Result{{$.RuntimeSuffix}}()
+    {{- else }}
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      return srv.{{$tool_name}}(ctx, req.(*{{$tool_val.RequestType}}))
+    })
     if err != nil {
-      return runtime.HandleError(err)
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
     if err != nil {
       return nil, err
     }
 
-    return mcp.NewToolResultText(string(marshaled)), nil
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+    {{- end }}
   })
+  {{- if $tool_val.ListAll }}
+
+  // {{$tool_name}}AllTool{{$variant.Suffix}} follows the pages of {{$tool_name}}
+  {{$tool_name}}AllTool{{$variant.Suffix}} := {{$key}}_{{$tool_name}}AllTool{{$variant.Suffix}}
+  if len(config.ExtraProperties) > 0 {
+    {{$tool_name}}AllTool{{$variant.Suffix}} = runtime.AddExtraPropertiesToTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool{{$variant.Suffix}}, config.ExtraProperties, runtime.SchemaTransforms(runtime.{{$variant.Provider}})...)
+  }
+  if len(config.FieldBindings) > 0 {
+    {{$tool_name}}AllTool{{$variant.Suffix}} = runtime.BindFieldsToTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool{{$variant.Suffix}}, config.FieldBindings, false)
+  }
+
+  s.AddTool({{$tool_name}}AllTool{{$variant.Suffix}}, func(ctx context.Context, request {{$.CallToolRequest}}) (*mcp.CallToolResult, error) {
+    var req {{$tool_val.RequestType}}
+    message := {{ if $.GoSDK }}runtime.ArgumentsGoSDK(request){{ else }}request.GetArguments(){{ end }}
+
+    ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    runtime.Fix(runtime.{{$variant.Provider}}, req.ProtoReflect().Descriptor(), message)
+
+    if err := runtime.UnmarshalArguments(message, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.Validate(config, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      return runtime.ListAll(ctx, config, req.(*{{$tool_val.RequestType}}), "{{$tool_val.ListAll.ItemsField}}", srv.{{$tool_name}})
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    if err != nil {
+      return nil, err
+    }
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+  })
+  {{- end }}
   {{- end }}
 }
 
+{{- end }}
+
 // Register{{$key}}HandlerWithProvider registers handlers for the specified LLM provider
-func Register{{$key}}HandlerWithProvider(s *mcpserver.MCPServer, srv {{$key}}Server, provider runtime.LLMProvider, opts ...runtime.Option) {
+func Register{{$key}}HandlerWithProvider(s {{$.Server}}, srv {{$key}}Server, provider runtime.LLMProvider, opts ...runtime.Option) {
   switch provider {
   case runtime.LLMProviderOpenAI:
     Register{{$key}}HandlerOpenAI(s, srv, opts...)
-  case runtime.LLMProviderStandard:
+  case runtime.LLMProviderGemini:
+    Register{{$key}}HandlerGemini(s, srv, opts...)
+  case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
     fallthrough
   default:
     Register{{$key}}Handler(s, srv, opts...)
@@ -230,8 +526,16 @@ func Register{{$key}}HandlerWithProvider(s *mcpserver.MCPServer, srv {{$key}}Ser
 // {{$serviceName}}Client is compatible with the grpc-go client interface.
 type {{$serviceName}}Client interface {
   {{- range $methodName, $tool := $methods }}
+  {{- if and $tool.ClientStreaming $tool.ServerStreaming }}
+  {{$methodName}}(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[{{$tool.RequestType}}, {{$tool.ResponseType}}], error)
+  {{- else if $tool.ClientStreaming }}
+  {{$methodName}}(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[{{$tool.RequestType}}, {{$tool.ResponseType}}], error)
+  {{- else if $tool.ServerStreaming }}
+  {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, opts ...grpc.CallOption) (grpc.ServerStreamingClient[{{$tool.ResponseType}}], error)
+  {{- else }}
   {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, opts ...grpc.CallOption) (*{{$tool.ResponseType}}, error)
   {{- end }}
+  {{- end }}
 }
 {{ end }}
 
@@ -240,110 +544,436 @@ type {{$serviceName}}Client interface {
 // Connect{{$serviceName}}Client is compatible with the connectrpc-go client interface.
 type Connect{{$serviceName}}Client interface {
   {{- range $methodName, $tool := $methods }}
+  {{- if and $tool.ClientStreaming $tool.ServerStreaming }}
+  {{$methodName}}(ctx context.Context) *connect.BidiStreamForClient[{{$tool.RequestType}}, {{$tool.ResponseType}}]
+  {{- else if $tool.ClientStreaming }}
+  {{$methodName}}(ctx context.Context) *connect.ClientStreamForClient[{{$tool.RequestType}}, {{$tool.ResponseType}}]
+  {{- else if $tool.ServerStreaming }}
+  {{$methodName}}(ctx context.Context, req *connect.Request[{{$tool.RequestType}}]) (*connect.ServerStreamForClient[{{$tool.ResponseType}}], error)
+  {{- else }}
   {{$methodName}}(ctx context.Context, req *connect.Request[{{$tool.RequestType}}]) (*connect.Response[{{$tool.ResponseType}}], error)
   {{- end }}
+  {{- end }}
 }
 {{ end }}
 
 {{- range $key, $val := .Services }}
 // ForwardToConnect{{$key}}Client registers a connectrpc client, to forward MCP calls to it.
-func ForwardToConnect{{$key}}Client(s *mcpserver.MCPServer, client Connect{{$key}}Client, opts ...runtime.Option) {
+func ForwardToConnect{{$key}}Client(s {{$.Server}}, client Connect{{$key}}Client, opts ...runtime.Option) {
   config := runtime.NewConfig()
   for _, opt := range opts {
     opt(config)
   }
 
+  provider := runtime.LLMProviderStandard
+  if len(config.SchemaTransforms) > 0 {
+    provider = runtime.LLMProviderCustom
+  }
+
   {{- range $tool_name, $tool_val := $val }}
   {{$tool_name}}Tool := {{$key}}_{{$tool_name}}Tool
+  // Rewrite the schema if schema transforms are configured
+  if len(config.SchemaTransforms) > 0 {
+    {{$tool_name}}Tool = runtime.TransformTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.SchemaTransforms)
+  }
   // Add extra properties to schema if configured
   if len(config.ExtraProperties) > 0 {
-    {{$tool_name}}Tool = runtime.AddExtraPropertiesToTool({{$tool_name}}Tool, config.ExtraProperties)
+    {{$tool_name}}Tool = runtime.AddExtraPropertiesToTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.ExtraProperties, config.SchemaTransforms...)
+  }
+  // Remove bound fields from schema, they are set from the session
+  if len(config.FieldBindings) > 0 {
+    {{$tool_name}}Tool = runtime.BindFieldsToTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.FieldBindings, {{$tool_val.ClientStreaming}})
   }
 
-  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request {{$.CallToolRequest}}) (*mcp.CallToolResult, error) {
+    {{- if not $tool_val.ClientStreaming }}
     var req {{$tool_val.RequestType}}
-
-    message := request.GetArguments()
+    {{ end }}
+    message := {{ if $.GoSDK }}runtime.ArgumentsGoSDK(request){{ else }}request.GetArguments(){{ end }}
 
     // Extract extra properties if configured
-    for _, prop := range config.ExtraProperties {
-      if propVal, ok := message[prop.Name]; ok {
-        ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+    ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    // Forward the allowed metadata of the tool call to the backend
+    ctx = runtime.ForwardMetadata{{$.RuntimeSuffix}}(ctx, config, request)
+    {{- if $tool_val.ClientStreaming }}
+
+    reqs, err := runtime.UnmarshalStreamRequests[{{$tool_val.RequestType}}](message, provider)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.ValidateStream(config, reqs); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- else }}
+
+    runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+    if err := runtime.UnmarshalArguments(message, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.Validate(config, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- end }}
+    {{- if and $tool_val.ClientStreaming $tool_val.ServerStreaming }}
+
+    collector := runtime.NewStreamCollector{{$.RuntimeSuffix}}(ctx, request, config)
+    _, err = runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", reqs, func(ctx context.Context, req any) (any, error) {
+      ctx, cancel := context.WithCancel(ctx)
+      defer cancel()
+      stream := client.{{$tool_name}}(ctx)
+      defer stream.CloseResponse()
+      runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+      return nil, runtime.Exchange(collector, req.([]*{{$tool_val.RequestType}}), stream.Send, stream.CloseRequest, stream.Receive, cancel)
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    return collector.Result{{$.RuntimeSuffix}}()
+    {{- else if $tool_val.ClientStreaming }}
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", reqs, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      ctx, cancel := context.WithCancel(ctx)
+      defer cancel()
+      stream := client.{{$tool_name}}(ctx)
+      runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+      if err := runtime.SendAll(req.([]*{{$tool_val.RequestType}}), stream.Send); err != nil {
+        return nil, err
       }
+      resp, err := stream.CloseAndReceive()
+      if err != nil {
+        return nil, err
+      }
+      return resp.Msg, nil
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    marshaled, err := json.Marshal(message)
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
     if err != nil {
       return nil, err
     }
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+    {{- else if $tool_val.ServerStreaming }}
+
+    collector := runtime.NewStreamCollector{{$.RuntimeSuffix}}(ctx, request, config)
+    _, err = runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (any, error) {
+      connectReq := connect.NewRequest(req.(*{{$tool_val.RequestType}}))
+      runtime.SetConnectHeaders(ctx, connectReq.Header())
+      stream, err := client.{{$tool_name}}(ctx, connectReq)
+      if err != nil {
+        return nil, err
+      }
+      defer stream.Close()
+
+      for stream.Receive() {
+        if err := collector.Add(stream.Msg()); err != nil {
+          return nil, err
+        }
+      }
+      return nil, stream.Err()
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    return collector.Result{{$.RuntimeSuffix}}()
+    {{- else }}
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      connectReq := connect.NewRequest(req.(*{{$tool_val.RequestType}}))
+      runtime.SetConnectHeaders(ctx, connectReq.Header())
+      resp, err := client.{{$tool_name}}(ctx, connectReq)
+      if err != nil {
+        return nil, err
+      }
+      return resp.Msg, nil
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
 
-    if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    if err != nil {
       return nil, err
     }
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+    {{- end }}
+  })
+  {{- if $tool_val.ListAll }}
+
+  // {{$tool_name}}AllTool follows the pages of {{$tool_name}}
+  {{$tool_name}}AllTool := {{$key}}_{{$tool_name}}AllTool
+  if len(config.SchemaTransforms) > 0 {
+    {{$tool_name}}AllTool = runtime.TransformTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.SchemaTransforms)
+  }
+  if len(config.ExtraProperties) > 0 {
+    {{$tool_name}}AllTool = runtime.AddExtraPropertiesToTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.ExtraProperties, config.SchemaTransforms...)
+  }
+  if len(config.FieldBindings) > 0 {
+    {{$tool_name}}AllTool = runtime.BindFieldsToTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.FieldBindings, false)
+  }
+
+  s.AddTool({{$tool_name}}AllTool, func(ctx context.Context, request {{$.CallToolRequest}}) (*mcp.CallToolResult, error) {
+    var req {{$tool_val.RequestType}}
+    message := {{ if $.GoSDK }}runtime.ArgumentsGoSDK(request){{ else }}request.GetArguments(){{ end }}
+
+    ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    ctx = runtime.ForwardMetadata{{$.RuntimeSuffix}}(ctx, config, request)
+
+    runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+    if err := runtime.UnmarshalArguments(message, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
 
-    resp, err := client.{{$tool_name}}(ctx, connect.NewRequest(&req))
+    if err := runtime.Validate(config, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      return runtime.ListAll(ctx, config, req.(*{{$tool_val.RequestType}}), "{{$tool_val.ListAll.ItemsField}}", func(ctx context.Context, req *{{$tool_val.RequestType}}) (*{{$tool_val.ResponseType}}, error) {
+        connectReq := connect.NewRequest(req)
+        runtime.SetConnectHeaders(ctx, connectReq.Header())
+        resp, err := client.{{$tool_name}}(ctx, connectReq)
+        if err != nil {
+          return nil, err
+        }
+        return resp.Msg, nil
+      })
+    })
     if err != nil {
-      return runtime.HandleError(err)
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
     if err != nil {
       return nil, err
     }
-    return mcp.NewToolResultText(string(marshaled)), nil
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
   })
   {{- end }}
+  {{- end }}
 }
 {{- end }}
 
 {{- range $key, $val := .Services }}
 // ForwardTo{{$key}}Client registers a gRPC client, to forward MCP calls to it.
-func ForwardTo{{$key}}Client(s *mcpserver.MCPServer, client {{$key}}Client, opts ...runtime.Option) {
+func ForwardTo{{$key}}Client(s {{$.Server}}, client {{$key}}Client, opts ...runtime.Option) {
   config := runtime.NewConfig()
   for _, opt := range opts {
     opt(config)
   }
 
+  provider := runtime.LLMProviderStandard
+  if len(config.SchemaTransforms) > 0 {
+    provider = runtime.LLMProviderCustom
+  }
+
   {{- range $tool_name, $tool_val := $val }}
   {{$tool_name}}Tool := {{$key}}_{{$tool_name}}Tool
+  // Rewrite the schema if schema transforms are configured
+  if len(config.SchemaTransforms) > 0 {
+    {{$tool_name}}Tool = runtime.TransformTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.SchemaTransforms)
+  }
   // Add extra properties to schema if configured
   if len(config.ExtraProperties) > 0 {
-    {{$tool_name}}Tool = runtime.AddExtraPropertiesToTool({{$tool_name}}Tool, config.ExtraProperties)
+    {{$tool_name}}Tool = runtime.AddExtraPropertiesToTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.ExtraProperties, config.SchemaTransforms...)
+  }
+  // Remove bound fields from schema, they are set from the session
+  if len(config.FieldBindings) > 0 {
+    {{$tool_name}}Tool = runtime.BindFieldsToTool{{$.RuntimeSuffix}}({{$tool_name}}Tool, config.FieldBindings, {{$tool_val.ClientStreaming}})
   }
 
-  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request {{$.CallToolRequest}}) (*mcp.CallToolResult, error) {
+    {{- if not $tool_val.ClientStreaming }}
     var req {{$tool_val.RequestType}}
-
-    message := request.GetArguments()
+    {{ end }}
+    message := {{ if $.GoSDK }}runtime.ArgumentsGoSDK(request){{ else }}request.GetArguments(){{ end }}
 
     // Extract extra properties if configured
-    for _, prop := range config.ExtraProperties {
-      if propVal, ok := message[prop.Name]; ok {
-        ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+    ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    // Forward the allowed metadata of the tool call to the backend
+    ctx = runtime.ForwardMetadata{{$.RuntimeSuffix}}(ctx, config, request)
+    {{- if $tool_val.ClientStreaming }}
+
+    reqs, err := runtime.UnmarshalStreamRequests[{{$tool_val.RequestType}}](message, provider)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.ValidateStream(config, reqs); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- else }}
+
+    runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+    if err := runtime.UnmarshalArguments(message, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.Validate(config, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    {{- end }}
+    {{- if and $tool_val.ClientStreaming $tool_val.ServerStreaming }}
+
+    collector := runtime.NewStreamCollector{{$.RuntimeSuffix}}(ctx, request, config)
+    _, err = runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", reqs, func(ctx context.Context, req any) (any, error) {
+      ctx, cancel := context.WithCancel(ctx)
+      defer cancel()
+      stream, err := client.{{$tool_name}}(ctx)
+      if err != nil {
+        return nil, err
       }
+      return nil, runtime.Exchange(collector, req.([]*{{$tool_val.RequestType}}), stream.Send, stream.CloseSend, stream.Recv, cancel)
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    return collector.Result{{$.RuntimeSuffix}}()
+    {{- else if $tool_val.ClientStreaming }}
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", reqs, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      ctx, cancel := context.WithCancel(ctx)
+      defer cancel()
+      stream, err := client.{{$tool_name}}(ctx)
+      if err != nil {
+        return nil, err
+      }
+      if err := runtime.SendAll(req.([]*{{$tool_val.RequestType}}), stream.Send); err != nil {
+        return nil, err
+      }
+      return stream.CloseAndRecv()
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    marshaled, err := json.Marshal(message)
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
     if err != nil {
       return nil, err
     }
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+    {{- else if $tool_val.ServerStreaming }}
+
+    collector := runtime.NewStreamCollector{{$.RuntimeSuffix}}(ctx, request, config)
+    _, err = runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (any, error) {
+      ctx, cancel := context.WithCancel(ctx)
+      defer cancel()
+      stream, err := client.{{$tool_name}}(ctx, req.(*{{$tool_val.RequestType}}))
+      if err != nil {
+        return nil, err
+      }
+      return nil, runtime.ReceiveAll(collector, stream.Recv)
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    return collector.Result{{$.RuntimeSuffix}}()
+    {{- else }}
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      return client.{{$tool_name}}(ctx, req.(*{{$tool_val.RequestType}}))
+    })
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
 
-    if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    if err != nil {
       return nil, err
     }
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
+    {{- end }}
+  })
+  {{- if $tool_val.ListAll }}
 
-    resp, err := client.{{$tool_name}}(ctx, &req)
+  // {{$tool_name}}AllTool follows the pages of {{$tool_name}}
+  {{$tool_name}}AllTool := {{$key}}_{{$tool_name}}AllTool
+  if len(config.SchemaTransforms) > 0 {
+    {{$tool_name}}AllTool = runtime.TransformTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.SchemaTransforms)
+  }
+  if len(config.ExtraProperties) > 0 {
+    {{$tool_name}}AllTool = runtime.AddExtraPropertiesToTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.ExtraProperties, config.SchemaTransforms...)
+  }
+  if len(config.FieldBindings) > 0 {
+    {{$tool_name}}AllTool = runtime.BindFieldsToTool{{$.RuntimeSuffix}}({{$tool_name}}AllTool, config.FieldBindings, false)
+  }
+
+  s.AddTool({{$tool_name}}AllTool, func(ctx context.Context, request {{$.CallToolRequest}}) (*mcp.CallToolResult, error) {
+    var req {{$tool_val.RequestType}}
+    message := {{ if $.GoSDK }}runtime.ArgumentsGoSDK(request){{ else }}request.GetArguments(){{ end }}
+
+    ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+    if err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+    ctx = runtime.ForwardMetadata{{$.RuntimeSuffix}}(ctx, config, request)
+
+    runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+    if err := runtime.UnmarshalArguments(message, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    if err := runtime.Validate(config, &req); err != nil {
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
+    }
+
+    resp, err := runtime.Intercept{{$.RuntimeSuffix}}(ctx, config, request, "{{$tool_val.FullMethod}}", &req, func(ctx context.Context, req any) (*{{$tool_val.ResponseType}}, error) {
+      return runtime.ListAll(ctx, config, req.(*{{$tool_val.RequestType}}), "{{$tool_val.ListAll.ItemsField}}", func(ctx context.Context, req *{{$tool_val.RequestType}}) (*{{$tool_val.ResponseType}}, error) {
+        return client.{{$tool_name}}(ctx, req)
+      })
+    })
     if err != nil {
-      return runtime.HandleError(err)
+      return runtime.HandleError{{$.RuntimeSuffix}}(err)
     }
 
-    marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
     if err != nil {
       return nil, err
     }
-    return mcp.NewToolResultText(string(marshaled)), nil
+    return {{ if $.GoSDK }}runtime.StructuredResultGoSDK(marshaled){{ else }}mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)){{ end }}, nil
   })
   {{- end }}
+  {{- end }}
 }
 {{- end }}
 
@@ -356,342 +986,50 @@ type TplParams struct {
 	GoPackage   string
 	Tools       map[string]mcp.Tool
 	ToolsOpenAI map[string]mcp.Tool
+	ToolsGemini map[string]mcp.Tool
 	Services    map[string]map[string]Tool
+	// Variants are the provider-specific variants of the Register functions.
+	Variants []Variant
+
+	// GoSDK is set when generating for the official Go SDK.
+	GoSDK bool
+	// Server is the type of the MCP server the tools are registered with.
+	Server string
+	// CallToolRequest is the type of the tool call request of the SDK.
+	CallToolRequest string
+	// RuntimeSuffix selects the variant of the runtime helpers for the SDK.
+	RuntimeSuffix string
 }
 
-type Tool struct {
-	RequestType   string
-	ResponseType  string
-	MCPTool       mcp.Tool
-	MCPToolOpenAI mcp.Tool
-}
-
-func kindToType(kind protoreflect.Kind) string {
-	switch kind {
-	case protoreflect.BoolKind:
-		return "boolean"
-	case protoreflect.StringKind:
-		return "string"
-	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
-		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
-		return "integer"
-	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
-		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
-		return "string" // safely encode as string
-	case protoreflect.FloatKind, protoreflect.DoubleKind:
-		return "number"
-	case protoreflect.BytesKind:
-		return "string"
-	case protoreflect.EnumKind:
-		return "string" // optionally add enum values here
-	default:
-		return "string"
-	}
-}
-
-func isFieldRequired(fd protoreflect.FieldDescriptor) bool {
-	if proto.HasExtension(fd.Options(), annotations.E_FieldBehavior) {
-		behaviors := proto.GetExtension(fd.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior)
-		for _, behavior := range behaviors {
-			if behavior == annotations.FieldBehavior_REQUIRED {
-				return true
-			}
-		}
-	}
-	return false
+// Variant is a provider-specific variant of the Register functions, using tools with
+// the schemas of that provider.
+type Variant struct {
+	// Suffix is appended to the names of the functions and tool variables.
+	Suffix string
+	// Name is the name of the provider in doc comments.
+	Name string
+	// Provider is the name of the runtime.LLMProvider constant.
+	Provider string
 }
 
-func (g *FileGenerator) messageSchema(md protoreflect.MessageDescriptor) map[string]any {
-	required := []string{}
-	// Fields that are not oneOf
-	normalFields := map[string]any{}
-	// One entry per oneOf block in the message.
-	oneOf := map[string][]map[string]any{}
-
-	// Process all fields in the message descriptor
-	for i := 0; i < md.Fields().Len(); i++ {
-		nestedFd := md.Fields().Get(i)
-		name := string(nestedFd.Name())
-
-		// OneOf handling
-		if oneof := nestedFd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
-			if !g.openAICompat {
-				if _, ok := oneOf[string(oneof.Name())]; !ok {
-					oneOf[string(oneof.Name())] = []map[string]any{}
-				}
-				oneOf[string(oneof.Name())] = append(oneOf[string(oneof.Name())], map[string]any{
-					"properties": map[string]any{
-						name: g.getType(nestedFd),
-					},
-					"required": []string{name},
-				})
-			} else {
-				// OpenAI compatibility mode. Oneof, Anyof are not supported, therefore just use ordinary fields, BUT add extra comments.
-				schema := g.getType(nestedFd)
-
-				// Replace type with a union of the actual type and null.
-				// This is the suggested way to work around OpenAI's requirement to make all fields
-				// required, but still allowing the LLM to not put a value (kind of).
-				// See https://platform.openai.com/docs/guides/structured-outputs/supported-schemas#all-fields-must-be-required
-				if v, ok := schema["type"].(string); ok {
-					schema["type"] = []string{v, "null"}
-				}
-				normalFields[name] = schema
-				schema["description"] = fmt.Sprintf("Note: This field is part of the '%s' oneof group. Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.", oneof.Name())
-				if isFieldRequired(nestedFd) {
-					required = append(required, name)
-				}
-
-				// In OpenAI, all fields must be marked as required.
-				required = append(required, name)
-			}
-		} else {
-			// If not part of a oneof, handle as a normal field
-			normalFields[name] = g.getType(nestedFd)
-			if isFieldRequired(nestedFd) || g.openAICompat { // In OpenAI, everything is required.
-				required = append(required, name)
-			}
-		}
-	}
-
-	// OpenAPI works differently than protobuf, when it comes to oneOf.
-	// In proto, not the oneOf name's field name is used, but the actual field name of the oneOf ENTRY.
-	// Therefore, we use an anyOf, and add one oneOf entry per oneOf protobuf block.
-	var anyOf []map[string]any
-	for _, protoOneOf := range oneOf {
-		anyOf = append(anyOf, map[string]any{
-			"oneOf":    protoOneOf,
-			"$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
-		})
-	}
-
-	// Final schema includes both properties and anyOf for flexibility
-	result := map[string]any{
-		"type":       "object",
-		"properties": normalFields, // Regular properties defined
-		"required":   required,
-	}
-	if anyOf != nil {
-		result["anyOf"] = anyOf // Fields in properties are already allowed. anyOf is in addition - which covers all oneOf groups
-	}
-	// OpenAI requires "additionalProperties: false", always.
-	if g.openAICompat {
-		result["additionalProperties"] = false
-		// Replace type with a union of the actual type and null.
-		// This is the suggested way to work around OpenAI's requirement to make all fields
-		// required, but still allowing the LLM to not put a value (kind of).
-		// See https://platform.openai.com/docs/guides/structured-outputs/supported-schemas#all-fields-must-be-required
-		if v, ok := result["type"].(string); ok {
-			result["type"] = []string{v, "null"}
-		}
-
-	}
-
-	return result
-}
-
-func (g *FileGenerator) getType(fd protoreflect.FieldDescriptor) map[string]any {
-	if fd.IsMap() {
-		keyType := fd.MapKey().Kind()
-		keyConstraints := map[string]any{"type": "string"}
-
-		switch keyType {
-		case protoreflect.BoolKind:
-			keyConstraints["enum"] = []string{"true", "false"}
-		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
-			keyConstraints["pattern"] = "^(0|[1-9]\\d*)$"
-		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
-			keyConstraints["pattern"] = "^-?(0|[1-9]\\d*)$"
-		}
-
-		if g.openAICompat {
-			return map[string]any{
-				"type":        "array",
-				"description": "List of key value pairs",
-				"items": map[string]any{
-					"type": "object",
-					"properties": map[string]any{
-						"key": map[string]any{"type": "string"},
-						"value": map[string]any{
-							"type": g.getType(fd.MapValue())["type"],
-						},
-					},
-					"required":             []string{"key", "value"},
-					"additionalProperties": false,
-				},
-			}
-		}
-
-		return map[string]any{
-			"type":                 "object",
-			"propertyNames":        keyConstraints,
-			"additionalProperties": g.getType(fd.MapValue()),
-		}
-	}
-
-	var schema map[string]any
-
-	switch fd.Kind() {
-	case protoreflect.MessageKind:
-		fullName := string(fd.Message().FullName())
-		switch fullName {
-		case "google.protobuf.Timestamp":
-			schema = map[string]any{"type": []string{"string", "null"}, "format": "date-time"}
-		case "google.protobuf.Duration":
-			schema = map[string]any{"type": []string{"string", "null"}, "pattern": `^-?[0-9]+(\.[0-9]+)?s$`}
-		case "google.protobuf.Struct":
-			if g.openAICompat {
-				schema = map[string]any{
-					"type":        "string",
-					"description": "string representation of any JSON object. represents a google.protobuf.Struct, a dynamic JSON object.",
-				}
-			} else {
-				schema = map[string]any{
-					"type":                 "object",
-					"additionalProperties": true,
-				}
-			}
-		case "google.protobuf.Value":
-			if g.openAICompat {
-				schema = map[string]any{
-					"type":        "string",
-					"description": "string representation of any JSON value. represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).",
-				}
-			} else {
-				schema = map[string]any{
-					"description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).",
-				}
-			}
-		case "google.protobuf.ListValue":
-			if g.openAICompat {
-				schema = map[string]any{
-					"type":        "string",
-					"description": "string representation of a JSON array. represents a google.protobuf.ListValue, a JSON array of values.",
-				}
-			} else {
-				schema = map[string]any{
-					"type":        "array",
-					"description": "represents a google.protobuf.ListValue, a JSON array of values.",
-					"items":       map[string]any{},
-				}
-			}
-		case "google.protobuf.FieldMask":
-			if g.openAICompat {
-				schema = map[string]any{"type": []string{"string", "null"}}
-			} else {
-				schema = map[string]any{"type": "string"}
-			}
-		case "google.protobuf.Any":
-			if g.openAICompat {
-				schema = map[string]any{
-					"type": "object",
-					"properties": map[string]any{
-						"@type": map[string]any{"type": "string"},
-						"value": map[string]any{},
-					},
-					"required": []string{"@type"},
-				}
-			} else {
-				schema = map[string]any{
-					"type": []string{"object", "null"},
-					"properties": map[string]any{
-						"@type": map[string]any{"type": "string"},
-						"value": map[string]any{},
-					},
-					"required": []string{"@type"},
-				}
-			}
-		case "google.protobuf.DoubleValue", "google.protobuf.FloatValue",
-			"google.protobuf.Int32Value", "google.protobuf.UInt32Value":
-			schema = map[string]any{"type": "number", "nullable": true}
-		case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
-			schema = map[string]any{"type": "string", "nullable": true}
-		case "google.protobuf.StringValue":
-			schema = map[string]any{"type": "string", "nullable": true}
-		case "google.protobuf.BoolValue":
-			schema = map[string]any{"type": "boolean", "nullable": true}
-		case "google.protobuf.BytesValue":
-			if g.openAICompat {
-				schema = map[string]any{"type": "string", "nullable": true}
-			} else {
-				schema = map[string]any{"type": "string", "format": "byte", "nullable": true}
-			}
-		default:
-			schema = g.messageSchema(fd.Message())
-		}
-
-	case protoreflect.EnumKind:
-		var values []string
-		for i := 0; i < fd.Enum().Values().Len(); i++ {
-			values = append(values, string(fd.Enum().Values().Get(i).Name()))
-		}
-		schema = map[string]any{
-			"type": "string",
-			"enum": values,
-		}
-
-	default:
-		schema = map[string]any{
-			"type": kindToType(fd.Kind()),
-		}
-		if fd.Kind() == protoreflect.BytesKind {
-			schema["contentEncoding"] = "base64"
-			if !g.openAICompat {
-				schema["format"] = "byte"
-			}
-		}
-	}
-
-	// Handle repeated fields here, wrapping the actual schema in an array.
-	if fd.IsList() {
-		return map[string]any{
-			"type":  "array",
-			"items": schema,
-		}
-	}
-	return schema
+// variants are the provider-specific variants of the Register functions.
+var variants = []Variant{
+	{Suffix: "OpenAI", Name: "OpenAI", Provider: "LLMProviderOpenAI"},
+	{Suffix: "Gemini", Name: "Gemini", Provider: "LLMProviderGemini"},
 }
 
-var strippedCommentPrefixes = []string{"buf:lint:", "@ignore-comment"}
+// Tool is the tool of a method, see mcptool.Tool.
+type Tool = mcptool.Tool
 
-func cleanComment(comment string) string {
-	var cleanedLines []string
-outer:
-	for _, line := range strings.Split(comment, "\n") {
-		trimmed := strings.TrimSpace(line)
-		for _, strip := range strippedCommentPrefixes {
-			if strings.HasPrefix(trimmed, strip) {
-				continue outer
-			}
-		}
-		cleanedLines = append(cleanedLines, trimmed)
-	}
-	return strings.Join(cleanedLines, "\n")
-}
+// ListAllTool is the list-all tool of an AIP-158 list method, see mcptool.ListAllTool.
+type ListAllTool = mcptool.ListAllTool
 
 func Base32String(b []byte) string {
-	n := new(big.Int).SetBytes(b)
-	return n.Text(36)
+	return mcptool.Base32String(b)
 }
 
 func MangleHeadIfTooLong(name string, maxLen int) string {
-	if len(name) <= maxLen {
-		return name
-	}
-
-	// Generate short hash of full name
-	hash := sha1.Sum([]byte(name))
-	hashPrefix := Base32String(hash[:])[:6] // e.g. "3fj92a"
-
-	// Leave room for hash prefix + underscore
-	available := maxLen - len(hashPrefix) - 1
-	if available <= 0 {
-		return hashPrefix
-	}
-
-	// Preserve the end of the name (most specific)
-	tail := name[len(name)-available:]
-	return hashPrefix + "_" + tail
+	return mcptool.MangleHeadIfTooLong(name, maxLen)
 }
 
 func findCommonPrefix(names []string) string {
@@ -732,9 +1070,90 @@ func findCommonPrefix(names []string) string {
 	return prefix
 }
 
+// toolMethods returns the methods of a service that are not excluded from generation.
+func toolMethods(svc *protogen.Service) []*protogen.Method {
+	if mcptool.ServiceOptions(svc.Desc).GetExclude() {
+		return nil
+	}
+	var methods []*protogen.Method
+	for _, meth := range svc.Methods {
+		if mcptool.ToolOptions(meth.Desc).GetExclude() {
+			continue
+		}
+		methods = append(methods, meth)
+	}
+	return methods
+}
+
+// toolLiteral formats a tool as a Go literal for the template. The annotation hints
+// are pointers, which %#v would print as addresses, so they are formatted separately.
+func toolLiteral(tool mcp.Tool) string {
+	hint := func(b *bool) string {
+		if b == nil {
+			return "(*bool)(nil)"
+		}
+		return fmt.Sprintf("mcp.ToBoolPtr(%t)", *b)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "mcp.Tool{\nName: %q,\nDescription: %q,\nRawInputSchema: %s,\n", tool.Name, tool.Description, schemaLiteral(tool.RawInputSchema))
+	if tool.RawOutputSchema != nil {
+		fmt.Fprintf(&b, "RawOutputSchema: %s,\n", schemaLiteral(tool.RawOutputSchema))
+	}
+	fmt.Fprintf(&b, "Annotations: mcp.ToolAnnotation{Title: %q, ReadOnlyHint: %s, DestructiveHint: %s, IdempotentHint: %s, OpenWorldHint: %s},\n}",
+		tool.Annotations.Title,
+		hint(tool.Annotations.ReadOnlyHint),
+		hint(tool.Annotations.DestructiveHint),
+		hint(tool.Annotations.IdempotentHint),
+		hint(tool.Annotations.OpenWorldHint),
+	)
+	return b.String()
+}
+
+// goSDKToolLiteral formats a tool as a Go literal of the official Go SDK. Its
+// annotations only use pointers for the hints that default to true.
+func goSDKToolLiteral(tool mcp.Tool) string {
+	hint := func(b *bool) string {
+		if b == nil {
+			return "(*bool)(nil)"
+		}
+		return fmt.Sprintf("runtime.BoolPtr(%t)", *b)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "&mcp.Tool{\nName: %q,\nDescription: %q,\nInputSchema: %s,\n", tool.Name, tool.Description, schemaLiteral(tool.RawInputSchema))
+	if tool.RawOutputSchema != nil {
+		fmt.Fprintf(&b, "OutputSchema: %s,\n", schemaLiteral(tool.RawOutputSchema))
+	}
+	fmt.Fprintf(&b, "Annotations: &mcp.ToolAnnotations{Title: %q, ReadOnlyHint: %t, DestructiveHint: %s, IdempotentHint: %t, OpenWorldHint: %s},\n}",
+		tool.Annotations.Title,
+		tool.Annotations.ReadOnlyHint != nil && *tool.Annotations.ReadOnlyHint,
+		hint(tool.Annotations.DestructiveHint),
+		tool.Annotations.IdempotentHint != nil && *tool.Annotations.IdempotentHint,
+		hint(tool.Annotations.OpenWorldHint),
+	)
+	return b.String()
+}
+
+// schemaLiteral formats a schema as indented JSON in a raw string literal, so changes
+// of a schema are readable in diffs of the generated code. The indentation does not
+// reach clients, encoding/json compacts a json.RawMessage when marshaling it.
+func schemaLiteral(schema json.RawMessage) string {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, schema, "", "  "); err != nil {
+		return fmt.Sprintf("%#v", schema)
+	}
+	// Raw string literals cannot contain backticks, they are concatenated instead.
+	return "json.RawMessage(`" + strings.ReplaceAll(indented.String(), "`", "` + \"`\" + `") + "`)"
+}
+
 func (g *FileGenerator) Generate(packageSuffix string, trimToolPrefixes bool) {
 	file := g.f
-	if len(g.f.Services) == 0 {
+	hasTools := false
+	for _, svc := range g.f.Services {
+		if len(toolMethods(svc)) > 0 {
+			hasTools = true
+		}
+	}
+	if !hasTools {
 		return
 	}
 	goImportPath := file.GoImportPath
@@ -766,7 +1185,11 @@ func (g *FileGenerator) Generate(packageSuffix string, trimToolPrefixes bool) {
 	}
 
 	fileTpl := fileTemplate
-	tpl, err := template.New("gen").Parse(fileTpl)
+	literal := toolLiteral
+	if g.runtime == RuntimeGoSDK {
+		literal = goSDKToolLiteral
+	}
+	tpl, err := template.New("gen").Funcs(template.FuncMap{"toolLiteral": literal}).Parse(fileTpl)
 	if err != nil {
 		g.gen.Error(err)
 		return
@@ -775,13 +1198,79 @@ func (g *FileGenerator) Generate(packageSuffix string, trimToolPrefixes bool) {
 	services := map[string]map[string]Tool{}
 	tools := map[string]mcp.Tool{}
 	toolsOpenAI := map[string]mcp.Tool{}
+	toolsGemini := map[string]mcp.Tool{}
+	collected, err := g.collectTools(trimToolPrefixes)
+	if err != nil {
+		g.gen.Error(err)
+		return
+	}
+	for _, st := range collected {
+		s := map[string]Tool{}
+		for i, meth := range st.methods {
+			tool := st.tools[i]
+			tool.RequestType = g.getQualifiedTypeName(meth.Input.GoIdent)
+			tool.ResponseType = g.getQualifiedTypeName(meth.Output.GoIdent)
+			s[meth.GoName] = tool
+			tools[st.service.GoName+"_"+meth.GoName] = tool.MCPTool
+			toolsOpenAI[st.service.GoName+"_"+meth.GoName] = tool.MCPToolOpenAI
+			toolsGemini[st.service.GoName+"_"+meth.GoName] = tool.MCPToolGemini
+			if tool.ListAll != nil {
+				tools[st.service.GoName+"_"+meth.GoName+"All"] = tool.ListAll.MCPTool
+				toolsOpenAI[st.service.GoName+"_"+meth.GoName+"All"] = tool.ListAll.MCPToolOpenAI
+				toolsGemini[st.service.GoName+"_"+meth.GoName+"All"] = tool.ListAll.MCPToolGemini
+			}
+		}
+		services[string(st.service.Desc.Name())] = s
+	}
+
+	params := TplParams{
+		PackageName: string(g.f.Desc.Package()),
+		SourcePath:  g.f.Desc.Path(),
+		GoPackage:   string(g.f.GoPackageName),
+		Services:    services,
+		Tools:       tools,
+		ToolsOpenAI: toolsOpenAI,
+		ToolsGemini: toolsGemini,
+		Variants:    variants,
+
+		Server:          "*mcpserver.MCPServer",
+		CallToolRequest: "mcp.CallToolRequest",
+	}
+	if g.runtime == RuntimeGoSDK {
+		params.GoSDK = true
+		params.Server = "*mcp.Server"
+		params.CallToolRequest = "*mcp.CallToolRequest"
+		params.RuntimeSuffix = "GoSDK"
+	}
+	err = tpl.Execute(g.gf, params)
+	if err != nil {
+		g.gen.Error(err)
+	}
+}
+
+// serviceTools are the tools of the exposed methods of a service.
+type serviceTools struct {
+	service *protogen.Service
+	methods []*protogen.Method
+	// tools holds the tool of each method. The Go types of the request and response
+	// are left empty, they depend on the generated file.
+	tools []Tool
+}
+
+// collectTools builds the tools of all services of the file, in declaration order.
+func (g *FileGenerator) collectTools(trimToolPrefixes bool) ([]serviceTools, error) {
+	var result []serviceTools
 
 	// Collect all tool names to find common prefix if trimming is enabled
 	var allToolNames []string
 	if trimToolPrefixes {
 		for _, svc := range g.f.Services {
-			for _, meth := range svc.Methods {
-				if meth.Desc.IsStreamingClient() || meth.Desc.IsStreamingServer() {
+			// Explicitly named tools are not trimmed
+			if mcptool.ServiceOptions(svc.Desc).GetToolPrefix() != "" {
+				continue
+			}
+			for _, meth := range toolMethods(svc) {
+				if mcptool.ToolOptions(meth.Desc).GetName() != "" {
 					continue
 				}
 				toolName := strings.ReplaceAll(string(meth.Desc.FullName()), ".", "_")
@@ -795,76 +1284,34 @@ func (g *FileGenerator) Generate(packageSuffix string, trimToolPrefixes bool) {
 		commonPrefix = findCommonPrefix(allToolNames)
 	}
 
-	for _, svc := range g.f.Services {
-		s := map[string]Tool{}
-		for _, meth := range svc.Methods {
-			// Only unary supported at the moment
-			if meth.Desc.IsStreamingClient() || meth.Desc.IsStreamingServer() {
-				continue
-			}
+	var toolOpts []mcptool.Option
+	if g.omitDescriptions {
+		toolOpts = append(toolOpts, mcptool.WithoutDescriptions())
+	}
+	if g.listAllTools {
+		toolOpts = append(toolOpts, mcptool.WithListAll())
+	}
 
-			// Generate base tool name
-			baseToolName := strings.ReplaceAll(string(meth.Desc.FullName()), ".", "_")
+	for _, svc := range g.f.Services {
+		methods := toolMethods(svc)
+		if len(methods) == 0 {
+			continue
+		}
 
-			// Trim common prefix if enabled
-			if trimToolPrefixes && commonPrefix != "" && strings.HasPrefix(baseToolName, commonPrefix) {
+		st := serviceTools{service: svc, methods: methods}
+		for _, meth := range methods {
+			baseToolName := mcptool.Name(meth.Desc)
+			// Trim common prefix if enabled, explicitly named tools are not trimmed
+			if trimToolPrefixes && commonPrefix != "" && baseToolName == strings.ReplaceAll(string(meth.Desc.FullName()), ".", "_") {
 				baseToolName = strings.TrimPrefix(baseToolName, commonPrefix)
 			}
-
-			// Generate standard tool
-			toolStandard := mcp.Tool{
-				Name:        MangleHeadIfTooLong(baseToolName, 64),
-				Description: cleanComment(string(meth.Comments.Leading)),
-			}
-
-			// Generate standard schema
-			g.openAICompat = false
-			standardSchema := g.messageSchema(meth.Input.Desc)
-			marshaledStandard, err := json.Marshal(standardSchema)
-			if err != nil {
-				panic(err)
-			}
-			toolStandard.RawInputSchema = json.RawMessage(marshaledStandard)
-
-			// Generate OpenAI tool
-			toolOpenAI := mcp.Tool{
-				Name:        MangleHeadIfTooLong(baseToolName, 64),
-				Description: cleanComment(string(meth.Comments.Leading)),
-			}
-
-			// Generate OpenAI schema
-			g.openAICompat = true
-			openAISchema := g.messageSchema(meth.Input.Desc)
-			// In OpenAI mode, we use type ["my-type", "null"] to make it nullable, but many tools don't like this for the top-level schema object.
-			openAISchema["type"] = "object"
-			marshaledOpenAI, err := json.Marshal(openAISchema)
+			tool, err := mcptool.New(meth.Desc, append(toolOpts, mcptool.WithName(baseToolName))...)
 			if err != nil {
-				panic(err)
+				return nil, err
 			}
-			toolOpenAI.RawInputSchema = json.RawMessage(marshaledOpenAI)
-
-			s[meth.GoName] = Tool{
-				RequestType:   g.getQualifiedTypeName(meth.Input.GoIdent),
-				ResponseType:  g.getQualifiedTypeName(meth.Output.GoIdent),
-				MCPTool:       toolStandard,
-				MCPToolOpenAI: toolOpenAI,
-			}
-			tools[svc.GoName+"_"+meth.GoName] = toolStandard
-			toolsOpenAI[svc.GoName+"_"+meth.GoName] = toolOpenAI
+			st.tools = append(st.tools, tool)
 		}
-		services[string(svc.Desc.Name())] = s
-	}
-
-	params := TplParams{
-		PackageName: string(g.f.Desc.Package()),
-		SourcePath:  g.f.Desc.Path(),
-		GoPackage:   string(g.f.GoPackageName),
-		Services:    services,
-		Tools:       tools,
-		ToolsOpenAI: toolsOpenAI,
-	}
-	err = tpl.Execute(g.gf, params)
-	if err != nil {
-		g.gen.Error(err)
+		result = append(result, st)
 	}
+	return result, nil
 }
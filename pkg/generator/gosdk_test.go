@@ -0,0 +1,187 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcpgosdk"
+)
+
+// connectGoSDK connects a client to the server over an in-memory transport.
+func connectGoSDK(t *testing.T, server *mcp.Server, opts *mcp.ClientOptions) *mcp.ClientSession {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, opts)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
+
+func structuredContent(g *WithT, result *mcp.CallToolResult) map[string]any {
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(result.Content).To(HaveLen(1))
+
+	var parsed map[string]any
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &parsed)).To(Succeed())
+	return parsed
+}
+
+func TestGoSDKRuntime(t *testing.T) {
+	t.Run("tools", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+		testdatamcpgosdk.RegisterTestServiceHandler(server, &testServer{}, runtime.WithExtraProperties(runtime.ExtraProperty{
+			Name:        "api_url",
+			Description: "API base URL",
+			Required:    true,
+			ContextKey:  "base_url_key",
+		}))
+		session := connectGoSDK(t, server, nil)
+
+		tools, err := session.ListTools(context.Background(), nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tools.Tools).ToNot(BeEmpty())
+
+		var createItem *mcp.Tool
+		for _, tool := range tools.Tools {
+			if tool.Name == "testdata_TestService_CreateItem" {
+				createItem = tool
+			}
+		}
+		g.Expect(createItem).ToNot(BeNil())
+		g.Expect(createItem.InputSchema).To(HaveKeyWithValue("properties", HaveKey("api_url")))
+		g.Expect(createItem.InputSchema).To(HaveKeyWithValue("required", ContainElement("api_url")))
+		g.Expect(createItem.OutputSchema).To(HaveKeyWithValue("type", "object"))
+	})
+
+	t.Run("unary", func(t *testing.T) {
+		g := NewWithT(t)
+
+		srv := &testServer{}
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+		testdatamcpgosdk.RegisterTestServiceHandler(server, srv, runtime.WithExtraProperties(runtime.ExtraProperty{
+			Name:       "api_url",
+			ContextKey: "base_url_key",
+		}))
+		session := connectGoSDK(t, server, nil)
+
+		result, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "testdata_TestService_CreateItem",
+			Arguments: map[string]any{"name": "widget", "api_url": "https://example.com"},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(structuredContent(g, result)).To(HaveKeyWithValue("id", "item-123"))
+		g.Expect(result.StructuredContent).To(HaveKeyWithValue("id", "item-123"))
+		g.Expect(srv.lastURLString).To(Equal("https://example.com"))
+	})
+
+	t.Run("interceptors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var info *runtime.ToolInfo
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+		testdatamcpgosdk.RegisterTestServiceHandler(server, &testServer{}, runtime.WithInterceptors(
+			func(ctx context.Context, req any, i *runtime.ToolInfo, handler runtime.ToolHandler) (any, error) {
+				info = i
+				return handler(ctx, req)
+			},
+		))
+		session := connectGoSDK(t, server, nil)
+
+		_, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "testdata_TestService_CreateItem",
+			Arguments: map[string]any{"name": "widget"},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(info.Name).To(Equal("testdata_TestService_CreateItem"))
+		g.Expect(info.FullMethod).To(Equal("/testdata.TestService/CreateItem"))
+		g.Expect(info.Arguments).To(HaveKeyWithValue("name", "widget"))
+		g.Expect(info.Request).To(BeAssignableToTypeOf(&mcp.CallToolRequest{}))
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+		testdatamcpgosdk.RegisterTestServiceHandlerOpenAI(server, &testServer{})
+		session := connectGoSDK(t, server, nil)
+
+		result, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "testdata_TestService_CreateItem",
+			Arguments: map[string]any{"name": 42},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsError).To(BeTrue())
+		g.Expect(result.Content[0].(*mcp.TextContent).Text).To(ContainSubstring(`"field":"name"`))
+	})
+
+	t.Run("server streaming", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var progress atomic.Int32
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+		testdatamcpgosdk.RegisterStreamingTestServiceHandler(server, &streamingTestServer{})
+		session := connectGoSDK(t, server, &mcp.ClientOptions{
+			ProgressNotificationHandler: func(context.Context, *mcp.ProgressNotificationClientRequest) {
+				progress.Add(1)
+			},
+		})
+
+		result, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+			Meta:      mcp.Meta{"progressToken": "watch"},
+			Name:      "testdata_StreamingTestService_WatchItems",
+			Arguments: map[string]any{"prefix": "item", "count": 2},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(structuredContent(g, result)["messages"]).To(Equal([]any{
+			map[string]any{"id": "item-0", "sequence": float64(0)},
+			map[string]any{"id": "item-1", "sequence": float64(1)},
+		}))
+		g.Eventually(progress.Load).Should(Equal(int32(2)))
+	})
+
+	t.Run("client streaming", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+		testdatamcpgosdk.RegisterStreamingTestServiceHandler(server, &streamingTestServer{})
+		session := connectGoSDK(t, server, nil)
+
+		result, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+			Name: "testdata_StreamingTestService_UploadItems",
+			Arguments: map[string]any{"messages": []any{
+				map[string]any{"id": "a"},
+				map[string]any{"id": "b"},
+			}},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(structuredContent(g, result)).To(Equal(map[string]any{"ids": []any{"a", "b"}}))
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInterceptors(t *testing.T) {
+	t.Run("unary", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var infos []*runtime.ToolInfo
+		var resp any
+		s := mcpserver.NewMCPServer("test-server", "1.0.0")
+		testdatamcp.RegisterTestServiceHandler(s, &testServer{}, runtime.WithInterceptors(
+			func(ctx context.Context, req any, info *runtime.ToolInfo, handler runtime.ToolHandler) (any, error) {
+				infos = append(infos, info)
+				g.Expect(req).To(BeAssignableToTypeOf(&testdata.CreateItemRequest{}))
+				g.Expect(req.(*testdata.CreateItemRequest).GetName()).To(Equal("widget"))
+
+				var err error
+				resp, err = handler(ctx, req)
+				return resp, err
+			},
+		))
+
+		result := callStreamingTool(t, s, "testdata_TestService_CreateItem", map[string]any{"name": "widget"})
+		g.Expect(result).To(HaveKeyWithValue("id", "item-123"))
+
+		g.Expect(infos).To(HaveLen(1))
+		g.Expect(infos[0].Name).To(Equal("testdata_TestService_CreateItem"))
+		g.Expect(infos[0].FullMethod).To(Equal("/testdata.TestService/CreateItem"))
+		g.Expect(infos[0].Arguments).To(HaveKeyWithValue("name", "widget"))
+		g.Expect(resp).To(BeAssignableToTypeOf(&testdata.CreateItemResponse{}))
+	})
+
+	t.Run("client streaming", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s := mcpserver.NewMCPServer("test-server", "1.0.0")
+		testdatamcp.RegisterStreamingTestServiceHandler(s, &streamingTestServer{}, runtime.WithInterceptors(
+			func(ctx context.Context, req any, info *runtime.ToolInfo, handler runtime.ToolHandler) (any, error) {
+				g.Expect(info.FullMethod).To(Equal("/testdata.StreamingTestService/UploadItems"))
+				reqs, ok := req.([]*testdata.UploadItemsRequest)
+				g.Expect(ok).To(BeTrue())
+				// Drop all but the first request.
+				return handler(ctx, reqs[:1])
+			},
+		))
+
+		result := callStreamingTool(t, s, "testdata_StreamingTestService_UploadItems", map[string]any{
+			"messages": []any{
+				map[string]any{"id": "a"},
+				map[string]any{"id": "b"},
+			},
+		})
+		g.Expect(result).To(Equal(map[string]any{"ids": []any{"a"}}))
+	})
+
+	t.Run("short circuit", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s := mcpserver.NewMCPServer("test-server", "1.0.0")
+		testdatamcp.RegisterTestServiceHandler(s, &testServer{}, runtime.WithInterceptors(
+			func(ctx context.Context, req any, info *runtime.ToolInfo, handler runtime.ToolHandler) (any, error) {
+				return nil, status.Error(codes.PermissionDenied, "not allowed")
+			},
+		))
+
+		message, err := json.Marshal(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"method":  "tools/call",
+			"params": map[string]any{
+				"name":      "testdata_TestService_CreateItem",
+				"arguments": map[string]any{"name": "widget"},
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		response := s.HandleMessage(context.Background(), message)
+		g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+
+		result := response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult)
+		g.Expect(result.IsError).To(BeTrue())
+		g.Expect(result.Content[0].(mcp.TextContent).Text).To(ContainSubstring("not allowed"))
+	})
+}
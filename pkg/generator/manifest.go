@@ -0,0 +1,104 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+)
+
+const (
+	ManifestFilenameExtension = ".tools.json"
+)
+
+// Manifest lists the tools of a proto file with the schemas of every provider, for MCP
+// servers and tests that are not written in Go.
+type Manifest struct {
+	// Source is the path of the proto file.
+	Source string `json:"source"`
+	// Package is the proto package of the file.
+	Package string         `json:"package"`
+	Tools   []ManifestTool `json:"tools"`
+}
+
+// ManifestTool is a tool of the manifest.
+type ManifestTool struct {
+	// FullMethod is the full RPC method name, such as "/package.Service/Method".
+	FullMethod      string `json:"full_method"`
+	ClientStreaming bool   `json:"client_streaming,omitempty"`
+	ServerStreaming bool   `json:"server_streaming,omitempty"`
+	// ListAllItems is set for the list-all tools of AIP-158 list methods, see
+	// FileGenerator.ListAllTools. It is the name of the repeated field of the response
+	// holding the items, which are collected from all pages of FullMethod.
+	ListAllItems string `json:"list_all_items,omitempty"`
+	// Providers holds the tool definition for each provider, keyed by the name of
+	// the runtime.LLMProvider. The definitions are in the format of the MCP tools/list
+	// response.
+	Providers map[runtime.LLMProvider]mcp.Tool `json:"providers"`
+}
+
+// GenerateManifest writes the tool manifest of the file, a JSON file next to the proto
+// file. Files without tools are skipped.
+func (g *FileGenerator) GenerateManifest(trimToolPrefixes bool) {
+	manifest := Manifest{
+		Source:  g.f.Desc.Path(),
+		Package: string(g.f.Desc.Package()),
+	}
+	collected, err := g.collectTools(trimToolPrefixes)
+	if err != nil {
+		g.gen.Error(err)
+		return
+	}
+	for _, st := range collected {
+		for _, tool := range st.tools {
+			manifest.Tools = append(manifest.Tools, ManifestTool{
+				FullMethod:      tool.FullMethod,
+				ClientStreaming: tool.ClientStreaming,
+				ServerStreaming: tool.ServerStreaming,
+				Providers: map[runtime.LLMProvider]mcp.Tool{
+					runtime.LLMProviderStandard: tool.MCPTool,
+					runtime.LLMProviderOpenAI:   tool.MCPToolOpenAI,
+					runtime.LLMProviderGemini:   tool.MCPToolGemini,
+				},
+			})
+			if tool.ListAll != nil {
+				manifest.Tools = append(manifest.Tools, ManifestTool{
+					FullMethod:   tool.FullMethod,
+					ListAllItems: tool.ListAll.ItemsField,
+					Providers: map[runtime.LLMProvider]mcp.Tool{
+						runtime.LLMProviderStandard: tool.ListAll.MCPTool,
+						runtime.LLMProviderOpenAI:   tool.ListAll.MCPToolOpenAI,
+						runtime.LLMProviderGemini:   tool.ListAll.MCPToolGemini,
+					},
+				})
+			}
+		}
+	}
+	if len(manifest.Tools) == 0 {
+		return
+	}
+
+	marshaled, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		g.gen.Error(err)
+		return
+	}
+	gf := g.gen.NewGeneratedFile(g.f.GeneratedFilenamePrefix+ManifestFilenameExtension, "")
+	if _, err := gf.Write(append(marshaled, '\n')); err != nil {
+		g.gen.Error(err)
+	}
+}
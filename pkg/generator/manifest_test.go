@@ -0,0 +1,163 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// newPlugin creates a plugin generating the given file, as protoc would run it.
+func newPlugin(g *WithT, file protoreflect.FileDescriptor) *protogen.Plugin {
+	var files []*descriptorpb.FileDescriptorProto
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(file)
+
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.Path()},
+		ProtoFile:      files,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	return plugin
+}
+
+// parsedManifest is a manifest with the tool definitions parsed as plain JSON, mcp.Tool
+// does not unmarshal the output schema.
+type parsedManifest struct {
+	Source  string `json:"source"`
+	Package string `json:"package"`
+	Tools   []struct {
+		FullMethod      string                                 `json:"full_method"`
+		ClientStreaming bool                                   `json:"client_streaming"`
+		ServerStreaming bool                                   `json:"server_streaming"`
+		ListAllItems    string                                 `json:"list_all_items"`
+		Providers       map[runtime.LLMProvider]map[string]any `json:"providers"`
+	} `json:"tools"`
+}
+
+// generateManifest runs GenerateManifest for the file and parses the manifest.
+func generateManifest(g *WithT, file protoreflect.FileDescriptor) (string, parsedManifest) {
+	plugin := newPlugin(g, file)
+	for _, f := range plugin.Files {
+		if f.Generate {
+			NewFileGenerator(f, plugin, "").GenerateManifest(false)
+		}
+	}
+	response := plugin.Response()
+	g.Expect(response.GetError()).To(BeEmpty())
+	g.Expect(response.GetFile()).To(HaveLen(1))
+
+	var manifest parsedManifest
+	g.Expect(json.Unmarshal([]byte(response.GetFile()[0].GetContent()), &manifest)).To(Succeed())
+	return response.GetFile()[0].GetName(), manifest
+}
+
+func TestManifest(t *testing.T) {
+	g := NewWithT(t)
+
+	name, manifest := generateManifest(g, testdata.File_testdata_test_service_proto)
+	g.Expect(name).To(Equal("github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/test_service.tools.json"))
+	g.Expect(manifest.Source).To(Equal("testdata/test_service.proto"))
+	g.Expect(manifest.Package).To(Equal("testdata"))
+	g.Expect(manifest.Tools).To(HaveLen(3))
+
+	createItem := manifest.Tools[0]
+	g.Expect(createItem.FullMethod).To(Equal("/testdata.TestService/CreateItem"))
+	g.Expect(createItem.Providers).To(HaveLen(3))
+
+	// Every provider has the tool of the generated code, with the schema of the provider.
+	// The descriptors compiled into Go have no comments, so there are no descriptions.
+	labels := func(provider runtime.LLMProvider) map[string]any {
+		tool := createItem.Providers[provider]
+		g.Expect(tool).To(HaveKeyWithValue("name", testdatamcp.TestService_CreateItemTool.Name))
+		g.Expect(tool).To(HaveKey("annotations"))
+		g.Expect(tool).To(HaveKeyWithValue("outputSchema", HaveKeyWithValue("properties", HaveKey("id"))))
+		return tool["inputSchema"].(map[string]any)["properties"].(map[string]any)["labels"].(map[string]any)
+	}
+	g.Expect(labels(runtime.LLMProviderStandard)).To(HaveKeyWithValue("type", "object"))
+	g.Expect(labels(runtime.LLMProviderOpenAI)).To(HaveKeyWithValue("type", "array"))
+	g.Expect(labels(runtime.LLMProviderGemini)).To(HaveKeyWithValue("type", "array"))
+}
+
+func TestManifestStreaming(t *testing.T) {
+	g := NewWithT(t)
+
+	_, manifest := generateManifest(g, testdata.File_testdata_streaming_test_proto)
+	g.Expect(manifest.Tools).To(HaveLen(3))
+
+	g.Expect(manifest.Tools[0].FullMethod).To(Equal("/testdata.StreamingTestService/WatchItems"))
+	g.Expect(manifest.Tools[0].ServerStreaming).To(BeTrue())
+	g.Expect(manifest.Tools[0].ClientStreaming).To(BeFalse())
+	g.Expect(manifest.Tools[2].ClientStreaming).To(BeTrue())
+	g.Expect(manifest.Tools[2].ServerStreaming).To(BeTrue())
+}
+
+func TestManifestListAllTools(t *testing.T) {
+	g := NewWithT(t)
+
+	plugin := newPlugin(g, testdata.File_testdata_pagination_test_proto)
+	for _, f := range plugin.Files {
+		if f.Generate {
+			NewFileGenerator(f, plugin, "").ListAllTools().GenerateManifest(false)
+		}
+	}
+	var manifest parsedManifest
+	g.Expect(json.Unmarshal([]byte(plugin.Response().GetFile()[0].GetContent()), &manifest)).To(Succeed())
+
+	// The list-all tool follows the list method it calls
+	g.Expect(manifest.Tools).To(HaveLen(3))
+	g.Expect(manifest.Tools[0].ListAllItems).To(BeEmpty())
+	listAll := manifest.Tools[1]
+	g.Expect(listAll.FullMethod).To(Equal("/testdata.PaginationTestService/ListShelves"))
+	g.Expect(listAll.ListAllItems).To(Equal("shelves"))
+	g.Expect(listAll.Providers).To(HaveLen(3))
+	for _, tool := range listAll.Providers {
+		g.Expect(tool).To(HaveKeyWithValue("name", testdatamcp.PaginationTestService_ListShelvesAllTool.Name))
+	}
+	g.Expect(manifest.Tools[2].FullMethod).To(Equal("/testdata.PaginationTestService/SearchShelves"))
+}
+
+func TestManifestWithoutTools(t *testing.T) {
+	g := NewWithT(t)
+
+	plugin := newPlugin(g, testdata.File_testdata_compatibility_test_proto)
+	for _, f := range plugin.Files {
+		if f.Generate {
+			NewFileGenerator(f, plugin, "").GenerateManifest(false)
+		}
+	}
+	g.Expect(plugin.Response().GetFile()).To(BeEmpty())
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataClient records the outgoing metadata of the calls of ForwardToTestServiceClient.
+type metadataClient struct {
+	testdatamcp.TestServiceClient
+	md metadata.MD
+}
+
+func (c *metadataClient) CreateItem(ctx context.Context, req *testdata.CreateItemRequest, opts ...grpc.CallOption) (*testdata.CreateItemResponse, error) {
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	return &testdata.CreateItemResponse{Id: "item-123"}, nil
+}
+
+// connectMetadataClient records the request headers of the calls of
+// ForwardToConnectTestServiceClient.
+type connectMetadataClient struct {
+	testdatamcp.ConnectTestServiceClient
+	header http.Header
+}
+
+func (c *connectMetadataClient) CreateItem(ctx context.Context, req *connect.Request[testdata.CreateItemRequest]) (*connect.Response[testdata.CreateItemResponse], error) {
+	c.header = req.Header()
+	return connect.NewResponse(&testdata.CreateItemResponse{Id: "item-123"}), nil
+}
+
+func callWithMeta(g *WithT, s *mcpserver.MCPServer) {
+	request, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params": map[string]any{
+			"name":      "testdata_TestService_CreateItem",
+			"arguments": map[string]any{"name": "widget", "tenant_id": "acme"},
+			"_meta":     map[string]any{"clientName": "inspector", "private": "secret"},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	response := s.HandleMessage(context.Background(), request)
+	g.Expect(response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult).IsError).To(BeFalse())
+}
+
+func TestMetadataForwarder(t *testing.T) {
+	opts := []runtime.Option{
+		runtime.WithExtraProperties(runtime.ExtraProperty{Name: "tenant_id", Description: "Tenant", ContextKey: "tenant_id"}),
+		runtime.WithMetadataForwarder(runtime.MetadataForwarder{
+			Meta:            []string{"clientName"},
+			ExtraProperties: []string{"tenant_id"},
+			Prefix:          "x-mcp-",
+		}),
+	}
+
+	t.Run("grpc", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := &metadataClient{}
+		s := mcpserver.NewMCPServer("test", "1.0.0")
+		testdatamcp.ForwardToTestServiceClient(s, client, opts...)
+		callWithMeta(g, s)
+
+		g.Expect(client.md).To(Equal(metadata.MD{
+			"x-mcp-clientname": {"inspector"},
+			"x-mcp-tenant_id":  {"acme"},
+		}))
+	})
+
+	t.Run("connect", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := &connectMetadataClient{}
+		s := mcpserver.NewMCPServer("test", "1.0.0")
+		testdatamcp.ForwardToConnectTestServiceClient(s, client, opts...)
+		callWithMeta(g, s)
+
+		g.Expect(client.header.Get("X-Mcp-Clientname")).To(Equal("inspector"))
+		g.Expect(client.header.Get("X-Mcp-Tenant_id")).To(Equal("acme"))
+		g.Expect(client.header.Get("X-Mcp-Private")).To(BeEmpty())
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// The generated interfaces only contain the exposed methods, so the full grpc-go server
+// and client still satisfy them.
+var (
+	_ testdatamcp.OptionsTestServiceServer = testdata.UnimplementedOptionsTestServiceServer{}
+	_ testdatamcp.OptionsTestServiceClient = testdata.NewOptionsTestServiceClient(nil)
+)
+
+func TestToolOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterOptionsTestServiceHandler(s, testdata.UnimplementedOptionsTestServiceServer{})
+
+	response := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+	tools := map[string]mcp.Tool{}
+	for _, tool := range response.(mcp.JSONRPCResponse).Result.(mcp.ListToolsResult).Tools {
+		tools[tool.Name] = tool
+	}
+
+	// The service prefix replaces the package and service name, excluded methods are skipped
+	g.Expect(tools).To(HaveLen(4))
+	g.Expect(tools).To(HaveKey("inventory_ListProducts"))
+	g.Expect(tools).To(HaveKey("inventory_GetProduct"))
+	g.Expect(tools).To(HaveKey("inventory_UpdateProduct"))
+	g.Expect(tools).To(HaveKey("remove_product"))
+
+	list := tools["inventory_ListProducts"]
+	g.Expect(list.Description).To(Equal("ListProducts lists all products\n"))
+	g.Expect(list.Annotations).To(Equal(mcp.ToolAnnotation{
+		Title:          "List products",
+		ReadOnlyHint:   mcp.ToBoolPtr(true),
+		IdempotentHint: mcp.ToBoolPtr(true),
+		OpenWorldHint:  mcp.ToBoolPtr(false),
+	}))
+
+	// Hints derived from the idempotency level and the HTTP method
+	g.Expect(tools["inventory_GetProduct"].Annotations).To(Equal(mcp.ToolAnnotation{
+		ReadOnlyHint: mcp.ToBoolPtr(true),
+	}))
+	g.Expect(tools["inventory_UpdateProduct"].Annotations).To(Equal(mcp.ToolAnnotation{
+		IdempotentHint: mcp.ToBoolPtr(true),
+	}))
+
+	remove := tools["remove_product"]
+	g.Expect(remove.Description).To(Equal("Removes a product from the inventory. This cannot be undone."))
+	g.Expect(remove.Annotations).To(Equal(mcp.ToolAnnotation{
+		DestructiveHint: mcp.ToBoolPtr(true),
+	}))
+}
+
+func TestToolLiteral(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations mcp.ToolAnnotation
+		expected    string
+	}{
+		{
+			name:     "no annotations",
+			expected: `mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}`,
+		},
+		{
+			name: "all annotations",
+			annotations: mcp.ToolAnnotation{
+				Title:           `Say "hi"`,
+				ReadOnlyHint:    mcp.ToBoolPtr(true),
+				DestructiveHint: mcp.ToBoolPtr(false),
+				IdempotentHint:  mcp.ToBoolPtr(true),
+				OpenWorldHint:   mcp.ToBoolPtr(false),
+			},
+			expected: `mcp.ToolAnnotation{Title: "Say \"hi\"", ReadOnlyHint: mcp.ToBoolPtr(true), DestructiveHint: mcp.ToBoolPtr(false), IdempotentHint: mcp.ToBoolPtr(true), OpenWorldHint: mcp.ToBoolPtr(false)}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			literal := toolLiteral(mcp.Tool{Name: "tool", RawInputSchema: json.RawMessage(`{}`), Annotations: tt.annotations})
+			g.Expect(literal).To(ContainSubstring(`Name: "tool"`))
+			g.Expect(literal).To(HaveSuffix("Annotations: " + tt.expected + ",\n}"))
+		})
+	}
+}
+
+func TestSchemaLiteral(t *testing.T) {
+	g := NewWithT(t)
+
+	literal := schemaLiteral(json.RawMessage(`{"description":"Use ` + "`name`" + `.","type":"object"}`))
+	g.Expect(literal).To(Equal("json.RawMessage(`{\n  \"description\": \"Use ` + \"`\" + `name` + \"`\" + `.\",\n  \"type\": \"object\"\n}`)"))
+
+	// The literal is valid Go that evaluates to the same schema.
+	expr, err := parser.ParseExpr(literal)
+	g.Expect(err).ToNot(HaveOccurred())
+	var value strings.Builder
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok {
+			unquoted, err := strconv.Unquote(lit.Value)
+			g.Expect(err).ToNot(HaveOccurred())
+			value.WriteString(unquoted)
+		}
+		return true
+	})
+	g.Expect(value.String()).To(MatchJSON(`{"description":"Use ` + "`name`" + `.","type":"object"}`))
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestStructuredOutput(t *testing.T) {
+	tests := []struct {
+		name      string
+		tool      mcp.Tool
+		register  func(s *mcpserver.MCPServer)
+		arguments map[string]any
+	}{
+		{
+			name:      "unary",
+			tool:      testdatamcp.TestService_GetItemTool,
+			register:  func(s *mcpserver.MCPServer) { testdatamcp.RegisterTestServiceHandler(s, &testServer{}) },
+			arguments: map[string]any{"id": "item-1"},
+		},
+		{
+			name:      "unary OpenAI",
+			tool:      testdatamcp.TestService_CreateItemToolOpenAI,
+			register:  func(s *mcpserver.MCPServer) { testdatamcp.RegisterTestServiceHandlerOpenAI(s, &testServer{}) },
+			arguments: map[string]any{"name": "item"},
+		},
+		{
+			name: "server streaming",
+			tool: testdatamcp.StreamingTestService_WatchItemsTool,
+			register: func(s *mcpserver.MCPServer) {
+				testdatamcp.RegisterStreamingTestServiceHandler(s, &streamingTestServer{})
+			},
+			arguments: map[string]any{"prefix": "item", "count": 2},
+		},
+		{
+			name: "client streaming",
+			tool: testdatamcp.StreamingTestService_UploadItemsTool,
+			register: func(s *mcpserver.MCPServer) {
+				testdatamcp.RegisterStreamingTestServiceHandler(s, &streamingTestServer{})
+			},
+			arguments: map[string]any{"messages": []any{
+				map[string]any{"id": "a"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(tt.tool.RawOutputSchema).ToNot(BeNil())
+			compiler := jsonschema.NewCompiler()
+			g.Expect(compiler.AddResource("schema.json", bytes.NewReader(tt.tool.RawOutputSchema))).To(Succeed())
+			schema, err := compiler.Compile("schema.json")
+			g.Expect(err).ToNot(HaveOccurred())
+
+			s := mcpserver.NewMCPServer("test-server", "1.0.0")
+			tt.register(s)
+
+			messageBytes, err := json.Marshal(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"method":  "tools/call",
+				"params": map[string]any{
+					"name":      tt.tool.Name,
+					"arguments": tt.arguments,
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			response := s.HandleMessage(context.Background(), json.RawMessage(messageBytes))
+			g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+			result := response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult)
+			g.Expect(result.IsError).To(BeFalse())
+
+			// The structured content must match the text content and validate against the output schema
+			structured, err := json.Marshal(result.StructuredContent)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(structured).To(MatchJSON(result.Content[0].(mcp.TextContent).Text))
+
+			var data any
+			g.Expect(json.Unmarshal(structured, &data)).To(Succeed())
+			g.Expect(schema.Validate(data)).To(Succeed())
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/grpc"
+)
+
+// shelfServer serves 25 shelves in pages of up to 10, with the offset as page token.
+type shelfServer struct {
+	testdata.UnimplementedPaginationTestServiceServer
+	calls int
+}
+
+func (s *shelfServer) ListShelves(ctx context.Context, req *testdata.ListShelvesRequest) (*testdata.ListShelvesResponse, error) {
+	s.calls++
+	offset, _ := strconv.Atoi(req.GetPageToken())
+	end := min(offset+int(min(req.GetPageSize(), 10)), 25)
+	resp := &testdata.ListShelvesResponse{TotalSize: 25}
+	for i := offset; i < end; i++ {
+		resp.Shelves = append(resp.Shelves, &testdata.Shelf{Name: fmt.Sprintf("shelves/%d", i)})
+	}
+	if end < 25 {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+// shelfClient calls a shelfServer like a gRPC client.
+type shelfClient struct {
+	testdatamcp.PaginationTestServiceClient
+	srv *shelfServer
+}
+
+func (c *shelfClient) ListShelves(ctx context.Context, req *testdata.ListShelvesRequest, opts ...grpc.CallOption) (*testdata.ListShelvesResponse, error) {
+	return c.srv.ListShelves(ctx, req)
+}
+
+func callPaginationTool(g *WithT, s *mcpserver.MCPServer, name string, arguments map[string]any) map[string]any {
+	request, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": name, "arguments": arguments},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	response := s.HandleMessage(context.Background(), request)
+	g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+	result := response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult)
+	g.Expect(result.IsError).To(BeFalse())
+
+	var parsed map[string]any
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &parsed)).To(Succeed())
+	return parsed
+}
+
+func TestListAllTools(t *testing.T) {
+	g := NewWithT(t)
+
+	// Only AIP-158 list methods get a list-all tool
+	g.Expect(testdatamcp.PaginationTestService_ListShelvesAllTool.Name).To(Equal("testdata_PaginationTestService_ListShelvesAll"))
+	g.Expect(testdatamcp.PaginationTestService_ListShelvesAllTool.Description).To(HavePrefix("ListShelves lists shelves\n\nFollows next_page_token"))
+	g.Expect(testdatamcp.PaginationTestService_ListShelvesAllTool.RawInputSchema).To(MatchJSON(testdatamcp.PaginationTestService_ListShelvesTool.RawInputSchema))
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterPaginationTestServiceHandler(s, &shelfServer{})
+	response := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	var names []string
+	for _, tool := range response.(mcp.JSONRPCResponse).Result.(mcp.ListToolsResult).Tools {
+		names = append(names, tool.Name)
+	}
+	g.Expect(names).To(ConsistOf(
+		"testdata_PaginationTestService_ListShelves",
+		"testdata_PaginationTestService_ListShelvesAll",
+		"testdata_PaginationTestService_SearchShelves",
+	))
+}
+
+func TestListAllTool(t *testing.T) {
+	budget := runtime.WithListAllBudget(runtime.ListAllBudget{MaxItems: 15})
+
+	for _, tt := range []struct {
+		name     string
+		register func(s *mcpserver.MCPServer, srv *shelfServer)
+	}{
+		{"server", func(s *mcpserver.MCPServer, srv *shelfServer) {
+			testdatamcp.RegisterPaginationTestServiceHandler(s, srv, budget)
+		}},
+		{"openai", func(s *mcpserver.MCPServer, srv *shelfServer) {
+			testdatamcp.RegisterPaginationTestServiceHandlerOpenAI(s, srv, budget)
+		}},
+		{"client", func(s *mcpserver.MCPServer, srv *shelfServer) {
+			testdatamcp.ForwardToPaginationTestServiceClient(s, &shelfClient{srv: srv}, budget)
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			srv := &shelfServer{}
+			s := mcpserver.NewMCPServer("test-server", "1.0.0")
+			tt.register(s, srv)
+
+			result := callPaginationTool(g, s, "testdata_PaginationTestService_ListShelvesAll", map[string]any{})
+			g.Expect(result["shelves"]).To(HaveLen(15))
+			g.Expect(result["next_page_token"]).To(Equal("15"))
+			g.Expect(result["total_size"]).To(Equal(25.0))
+			g.Expect(srv.calls).To(Equal(2))
+
+			// The continuation token lists the rest
+			result = callPaginationTool(g, s, "testdata_PaginationTestService_ListShelvesAll", map[string]any{"page_token": "15"})
+			g.Expect(result["shelves"]).To(HaveLen(10))
+			g.Expect(result["next_page_token"]).To(BeEmpty())
+		})
+	}
+}
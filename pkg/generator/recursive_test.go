@@ -0,0 +1,196 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func compileSchema(g *WithT, raw json.RawMessage) *jsonschema.Schema {
+	compiler := jsonschema.NewCompiler()
+	g.Expect(compiler.AddResource("schema.json", bytes.NewReader(raw))).To(Succeed())
+	schema, err := compiler.Compile("schema.json")
+	g.Expect(err).ToNot(HaveOccurred())
+	return schema
+}
+
+func TestRecursiveSchemas(t *testing.T) {
+	tree := map[string]any{
+		"name": "root",
+		"children": []any{
+			map[string]any{
+				"name": "child",
+				"children": []any{
+					map[string]any{"name": "grandchild", "labeled_children": map[string]any{
+						"leaf": map[string]any{"name": "leaf"},
+					}},
+				},
+			},
+		},
+		"expression": map[string]any{"binary": map[string]any{
+			"operator": "+",
+			"left":     map[string]any{"literal": "1"},
+			"right":    map[string]any{"binary": map[string]any{"operator": "*", "left": map[string]any{"literal": "2"}}},
+		}},
+	}
+	invalidTree := map[string]any{
+		"name": "root",
+		"children": []any{
+			map[string]any{"children": []any{map[string]any{"name": 42}}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		schema  json.RawMessage
+		valid   any
+		invalid any
+		defs    []string
+	}{
+		{
+			name:    "input",
+			schema:  testdatamcp.RecursiveTestService_CreateTreeTool.RawInputSchema,
+			valid:   map[string]any{"root": tree, "billing_address": map[string]any{"city": "Hamburg"}},
+			invalid: map[string]any{"root": invalidTree},
+			defs:    []string{"testdata.Address", "testdata.Expression", "testdata.TreeNode"},
+		},
+		{
+			name:    "streamed input",
+			schema:  testdatamcp.RecursiveTestService_WalkTreeTool.RawInputSchema,
+			valid:   map[string]any{"messages": []any{map[string]any{"root": tree}}},
+			invalid: map[string]any{"messages": []any{map[string]any{"root": invalidTree}}},
+			defs:    []string{"testdata.Address", "testdata.Expression", "testdata.TreeNode"},
+		},
+		{
+			name:    "streamed output with recursive root",
+			schema:  testdatamcp.RecursiveTestService_WalkTreeTool.RawOutputSchema,
+			valid:   map[string]any{"messages": []any{tree}},
+			invalid: map[string]any{"messages": []any{invalidTree}},
+			defs:    []string{"testdata.Expression"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var parsed map[string]any
+			g.Expect(json.Unmarshal(tt.schema, &parsed)).To(Succeed())
+			g.Expect(parsed["type"]).To(Equal("object"))
+			defs, ok := parsed["$defs"].(map[string]any)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(defs).To(HaveLen(len(tt.defs)))
+			for _, def := range tt.defs {
+				g.Expect(defs).To(HaveKey(def))
+			}
+
+			schema := compileSchema(g, tt.schema)
+			g.Expect(schema.Validate(tt.valid)).To(Succeed())
+			g.Expect(schema.Validate(tt.invalid)).ToNot(Succeed())
+		})
+	}
+}
+
+func TestSharedMessagesAreReferenced(t *testing.T) {
+	g := NewWithT(t)
+
+	var compacted bytes.Buffer
+	g.Expect(json.Compact(&compacted, testdatamcp.RecursiveTestService_CreateTreeTool.RawInputSchema)).To(Succeed())
+	schema := compacted.String()
+	g.Expect(strings.Count(schema, `"street"`)).To(Equal(1))
+	g.Expect(strings.Count(schema, `"$ref":"#/$defs/testdata.Address"`)).To(Equal(2))
+
+	// Messages used once are still inlined.
+	g.Expect(string(testdatamcp.TestService_CreateItemTool.RawInputSchema)).ToNot(ContainSubstring("$defs"))
+}
+
+func TestOpenAIRecursiveSchemas(t *testing.T) {
+	g := NewWithT(t)
+
+	// OpenAI supports "$ref", recursive messages stay references.
+	for _, tool := range []mcp.Tool{
+		testdatamcp.RecursiveTestService_CreateTreeToolOpenAI,
+		testdatamcp.RecursiveTestService_WalkTreeToolOpenAI,
+	} {
+		var parsed map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &parsed)).To(Succeed())
+		g.Expect(parsed["$defs"]).To(HaveKey("testdata.TreeNode"))
+		g.Expect(parsed["$defs"]).To(HaveKey("testdata.Expression"))
+		g.Expect(string(tool.RawInputSchema)).ToNot(ContainSubstring("Maximum nesting depth"))
+		compileSchema(g, tool.RawInputSchema)
+	}
+}
+
+// messageDepth returns the maximum number of nested object schemas.
+func messageDepth(schema any) int {
+	depth := 0
+	switch schema := schema.(type) {
+	case map[string]any:
+		for _, value := range schema {
+			depth = max(depth, messageDepth(value))
+		}
+		if _, ok := schema["properties"]; ok {
+			depth++
+		}
+	case []any:
+		for _, value := range schema {
+			depth = max(depth, messageDepth(value))
+		}
+	}
+	return depth
+}
+
+func TestGeminiRecursionLimit(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, tool := range []mcp.Tool{
+		testdatamcp.RecursiveTestService_CreateTreeToolGemini,
+		testdatamcp.RecursiveTestService_WalkTreeToolGemini,
+	} {
+		g.Expect(string(tool.RawInputSchema)).ToNot(ContainSubstring("$ref"))
+		g.Expect(string(tool.RawInputSchema)).ToNot(ContainSubstring("$defs"))
+	}
+
+	var compacted bytes.Buffer
+	g.Expect(json.Compact(&compacted, testdatamcp.RecursiveTestService_CreateTreeToolGemini.RawInputSchema)).To(Succeed())
+	var parsed map[string]any
+	g.Expect(json.Unmarshal(compacted.Bytes(), &parsed)).To(Succeed())
+
+	// Recursion is limited across all messages, TreeNode and Expression both recurse
+	// and the schema must not grow exponentially with their number.
+	g.Expect(compacted.Len()).To(BeNumerically("<", 16<<10))
+	g.Expect(messageDepth(parsed)).To(Equal(8))
+
+	// Follow root.children until the recursion is cut off, deeper items are omitted.
+	node := parsed["properties"].(map[string]any)["root"].(map[string]any)
+	depth := 1
+	for {
+		items, ok := node["properties"].(map[string]any)["children"].(map[string]any)["items"].(map[string]any)
+		if !ok {
+			break
+		}
+		depth++
+		node = items
+	}
+	g.Expect(depth).To(Equal(3))
+}
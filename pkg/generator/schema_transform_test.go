@@ -0,0 +1,74 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestSchemaTransformsHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	var captured *testdata.CreateItemRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterTestServiceHandler(s, &testServer{},
+		runtime.WithSchemaTransforms(runtime.MapsToKVArrays, runtime.SchemaTransform{
+			Name: "tag",
+			Apply: func(schema map[string]any) {
+				schema["description"] = "Transformed."
+			},
+		}),
+		runtime.WithInterceptors(func(ctx context.Context, req any, info *runtime.ToolInfo, handler runtime.ToolHandler) (any, error) {
+			if r, ok := req.(*testdata.CreateItemRequest); ok {
+				captured = r
+			}
+			return handler(ctx, req)
+		}),
+	)
+
+	response := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+	var createItem mcp.Tool
+	for _, tool := range response.(mcp.JSONRPCResponse).Result.(mcp.ListToolsResult).Tools {
+		if tool.Name == "testdata_TestService_CreateItem" {
+			createItem = tool
+		}
+	}
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(createItem.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema).To(HaveKeyWithValue("description", "Transformed."))
+	labels := schema["properties"].(map[string]any)["labels"].(map[string]any)
+	g.Expect(labels).To(HaveKeyWithValue("type", "array"))
+
+	// Arguments in the transformed shape are converted back before unmarshaling
+	result := callStreamingTool(t, s, "testdata_TestService_CreateItem", map[string]any{
+		"name": "widget",
+		"labels": []any{
+			map[string]any{"key": "env", "value": "prod"},
+		},
+	})
+	g.Expect(result).To(HaveKeyWithValue("id", "item-123"))
+	g.Expect(captured.GetLabels()).To(Equal(map[string]string{"env": "prod"}))
+}
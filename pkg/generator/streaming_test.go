@@ -0,0 +1,186 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdataconnect"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/grpc"
+)
+
+// The generated client interfaces must be satisfied by the grpc-go and connect-go clients.
+var (
+	_ testdatamcp.StreamingTestServiceClient        = testdata.NewStreamingTestServiceClient(nil)
+	_ testdatamcp.ConnectStreamingTestServiceClient = testdataconnect.NewStreamingTestServiceClient(nil, "")
+)
+
+type streamingTestServer struct{}
+
+func (s *streamingTestServer) WatchItems(in *testdata.WatchItemsRequest, stream grpc.ServerStreamingServer[testdata.WatchItemsResponse]) error {
+	for i := range in.GetCount() {
+		err := stream.Send(&testdata.WatchItemsResponse{
+			Id:       fmt.Sprintf("%s-%d", in.GetPrefix(), i),
+			Sequence: i,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *streamingTestServer) UploadItems(stream grpc.ClientStreamingServer[testdata.UploadItemsRequest, testdata.UploadItemsResponse]) error {
+	resp := &testdata.UploadItemsResponse{}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+		resp.Ids = append(resp.Ids, req.GetId())
+	}
+}
+
+func (s *streamingTestServer) SyncItems(stream grpc.BidiStreamingServer[testdata.SyncItemsRequest, testdata.SyncItemsResponse]) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&testdata.SyncItemsResponse{Id: req.GetId(), InSync: true}); err != nil {
+			return err
+		}
+	}
+}
+
+func callStreamingTool(t *testing.T, s *mcpserver.MCPServer, name string, arguments map[string]any) map[string]any {
+	g := NewWithT(t)
+
+	callToolMessage := map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params": map[string]any{
+			"name":      name,
+			"arguments": arguments,
+		},
+	}
+	messageBytes, err := json.Marshal(callToolMessage)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	response := s.HandleMessage(context.Background(), json.RawMessage(messageBytes))
+	g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+
+	result := response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult)
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(result.Content).To(HaveLen(1))
+
+	var parsed map[string]any
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &parsed)).To(Succeed())
+	return parsed
+}
+
+func TestServerStreamingTool(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterStreamingTestServiceHandler(s, &streamingTestServer{})
+
+	result := callStreamingTool(t, s, "testdata_StreamingTestService_WatchItems", map[string]any{"prefix": "item", "count": 3})
+	g.Expect(result).ToNot(HaveKey("dropped_messages"))
+	g.Expect(result["messages"]).To(Equal([]any{
+		map[string]any{"id": "item-0", "sequence": float64(0)},
+		map[string]any{"id": "item-1", "sequence": float64(1)},
+		map[string]any{"id": "item-2", "sequence": float64(2)},
+	}))
+}
+
+func TestServerStreamingToolMessageLimit(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterStreamingTestServiceHandlerOpenAI(s, &streamingTestServer{}, runtime.WithStreamMessageLimit(2))
+
+	result := callStreamingTool(t, s, "testdata_StreamingTestService_WatchItems", map[string]any{"prefix": "item", "count": 5})
+	g.Expect(result["dropped_messages"]).To(Equal(float64(3)))
+	g.Expect(result["messages"]).To(Equal([]any{
+		map[string]any{"id": "item-3", "sequence": float64(3)},
+		map[string]any{"id": "item-4", "sequence": float64(4)},
+	}))
+}
+
+func TestClientStreamingTool(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterStreamingTestServiceHandler(s, &streamingTestServer{})
+
+	result := callStreamingTool(t, s, "testdata_StreamingTestService_UploadItems", map[string]any{
+		"messages": []any{
+			map[string]any{"id": "a", "name": "first"},
+			map[string]any{"id": "b", "name": "second"},
+		},
+	})
+	g.Expect(result).To(Equal(map[string]any{"ids": []any{"a", "b"}}))
+}
+
+func TestBidiStreamingTool(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterStreamingTestServiceHandlerOpenAI(s, &streamingTestServer{})
+
+	result := callStreamingTool(t, s, "testdata_StreamingTestService_SyncItems", map[string]any{
+		"messages": []any{
+			map[string]any{"id": "a"},
+			map[string]any{"id": "b"},
+		},
+	})
+	g.Expect(result["messages"]).To(Equal([]any{
+		map[string]any{"id": "a", "in_sync": true},
+		map[string]any{"id": "b", "in_sync": true},
+	}))
+}
+
+func TestClientStreamingToolSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, tool := range []mcp.Tool{testdatamcp.StreamingTestService_UploadItemsTool, testdatamcp.StreamingTestService_UploadItemsToolOpenAI} {
+		var schema map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+		g.Expect(schema["required"]).To(Equal([]any{"messages"}))
+
+		messages := schema["properties"].(map[string]any)["messages"].(map[string]any)
+		g.Expect(messages["type"]).To(Equal("array"))
+		g.Expect(messages["items"].(map[string]any)["properties"]).To(HaveKey("name"))
+	}
+}
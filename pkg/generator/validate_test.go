@@ -0,0 +1,258 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestValidateRules(t *testing.T) {
+	properties := func(g *WithT, raw json.RawMessage) map[string]any {
+		var schema map[string]any
+		g.Expect(json.Unmarshal(raw, &schema)).To(Succeed())
+		return schema["properties"].(map[string]any)
+	}
+
+	tests := []struct {
+		field    string
+		standard map[string]any
+		openAI   map[string]any
+	}{
+		{
+			field:    "name",
+			standard: map[string]any{"type": "string", "minLength": 1.0, "maxLength": 64.0},
+			openAI:   map[string]any{"type": "string", "description": "Must be at least 1 characters long. Must be at most 64 characters long."},
+		},
+		{
+			field:    "email",
+			standard: map[string]any{"type": "string", "format": "email"},
+			openAI:   map[string]any{"type": "string", "format": "email"},
+		},
+		{
+			field:    "id",
+			standard: map[string]any{"type": "string", "format": "uuid"},
+			openAI:   map[string]any{"type": "string", "format": "uuid"},
+		},
+		{
+			field:    "website",
+			standard: map[string]any{"type": "string", "format": "uri"},
+			openAI:   map[string]any{"type": "string", "description": "Must be a valid absolute URI."},
+		},
+		{
+			field:    "handle",
+			standard: map[string]any{"type": "string", "pattern": "^[a-z][a-z0-9_]*$"},
+			openAI:   map[string]any{"type": "string", "pattern": "^[a-z][a-z0-9_]*$"},
+		},
+		{
+			field:    "age",
+			standard: map[string]any{"type": "integer", "minimum": 0.0, "exclusiveMaximum": 150.0},
+			openAI:   map[string]any{"type": "integer", "minimum": 0.0, "exclusiveMaximum": 150.0},
+		},
+		{
+			field:    "score",
+			standard: map[string]any{"type": "number", "exclusiveMinimum": 0.0, "maximum": 1.0},
+			openAI:   map[string]any{"type": "number", "exclusiveMinimum": 0.0, "maximum": 1.0},
+		},
+		{
+			field:    "quota",
+			standard: map[string]any{"type": "string", "description": "Must be greater than or equal to 1."},
+			openAI:   map[string]any{"type": "string", "description": "Must be greater than or equal to 1."},
+		},
+		{
+			field: "tags",
+			standard: map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string", "maxLength": 32.0},
+				"minItems":    1.0,
+				"maxItems":    10.0,
+				"uniqueItems": true,
+			},
+			openAI: map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string", "description": "Must be at most 32 characters long."},
+				"minItems":    1.0,
+				"maxItems":    10.0,
+				"description": "Items must be unique.",
+			},
+		},
+		{
+			field:    "role",
+			standard: map[string]any{"type": "string", "enum": []any{"ROLE_VIEWER", "ROLE_EDITOR", "ROLE_ADMIN"}},
+			openAI:   map[string]any{"type": "string", "enum": []any{"ROLE_VIEWER", "ROLE_EDITOR", "ROLE_ADMIN"}},
+		},
+		{
+			field:    "default_role",
+			standard: map[string]any{"type": "string", "enum": []any{"ROLE_VIEWER", "ROLE_EDITOR"}},
+			openAI:   map[string]any{"type": "string", "enum": []any{"ROLE_VIEWER", "ROLE_EDITOR"}},
+		},
+		{
+			// JSON has no infinity or NaN, these rules are described
+			field:    "ratio",
+			standard: map[string]any{"type": "number", "maximum": 1.0, "description": "Must be greater than -Inf. Must not be one of NaN."},
+			openAI:   map[string]any{"type": "number", "maximum": 1.0, "description": "Must be greater than -Inf. Must not be one of NaN."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			g := NewWithT(t)
+
+			standard := properties(g, testdatamcp.ValidateTestService_CreateUserTool.RawInputSchema)
+			g.Expect(standard[tt.field]).To(Equal(tt.standard))
+
+			openAI := properties(g, testdatamcp.ValidateTestService_CreateUserToolOpenAI.RawInputSchema)
+			g.Expect(openAI[tt.field]).To(Equal(tt.openAI))
+		})
+	}
+}
+
+func TestValidateRequired(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(testdatamcp.ValidateTestService_CreateUserTool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["required"]).To(Equal([]any{"email"}))
+}
+
+func TestValidateSchemaInstances(t *testing.T) {
+	g := NewWithT(t)
+	schema := compileSchema(g, testdatamcp.ValidateTestService_CreateUserTool.RawInputSchema)
+
+	valid := map[string]any{
+		"name":  "Jane",
+		"email": "jane@example.com",
+		"age":   42,
+		"score": 0.5,
+		"tags":  []any{"a", "b"},
+		"role":  "ROLE_ADMIN",
+	}
+	g.Expect(schema.Validate(valid)).To(Succeed())
+
+	for field, value := range map[string]any{
+		"name":  "",
+		"age":   150,
+		"score": 0,
+		"tags":  []any{"a", "a"},
+		"role":  "ROLE_UNSPECIFIED",
+	} {
+		invalid := map[string]any{}
+		for k, v := range valid {
+			invalid[k] = v
+		}
+		invalid[field] = value
+		g.Expect(schema.Validate(invalid)).ToNot(Succeed(), field)
+	}
+}
+
+type validateTestServer struct {
+	calls int
+}
+
+func (s *validateTestServer) CreateUser(_ context.Context, req *testdata.CreateUserRequest) (*testdata.CreateUserResponse, error) {
+	s.calls++
+	return &testdata.CreateUserResponse{Id: req.GetId()}, nil
+}
+
+func TestValidationOption(t *testing.T) {
+	arguments := map[string]any{
+		"name":         "Jane",
+		"email":        "jane@example.com",
+		"id":           "9b2f3c1e-8c1a-4c55-9d43-2f1b6c1d2e3f",
+		"website":      "https://example.com",
+		"handle":       "jane",
+		"age":          42,
+		"score":        0.5,
+		"quota":        "10",
+		"tags":         []any{"a"},
+		"role":         "ROLE_ADMIN",
+		"default_role": "ROLE_VIEWER",
+	}
+	call := func(g *WithT, s *mcpserver.MCPServer, arguments map[string]any) mcp.CallToolResult {
+		message, err := json.Marshal(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"method":  "tools/call",
+			"params": map[string]any{
+				"name":      "testdata_ValidateTestService_CreateUser",
+				"arguments": arguments,
+			},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		response := s.HandleMessage(context.Background(), message)
+		g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+		return response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult)
+	}
+
+	g := NewWithT(t)
+	srv := &validateTestServer{}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterValidateTestServiceHandler(s, srv, runtime.WithValidation())
+
+	result := call(g, s, arguments)
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(srv.calls).To(Equal(1))
+
+	arguments["email"] = "not-an-email"
+	result = call(g, s, arguments)
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Content[0].(mcp.TextContent).Text).To(ContainSubstring(`"field":"email"`))
+	g.Expect(srv.calls).To(Equal(1), "the handler must not be called for invalid requests")
+
+	// Without the option, requests are passed on unchanged.
+	s = mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterValidateTestServiceHandler(s, srv)
+	result = call(g, s, arguments)
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(srv.calls).To(Equal(2))
+}
+
+func TestDecodeErrorResult(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterValidateTestServiceHandler(s, &validateTestServer{})
+
+	message, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params": map[string]any{
+			"name":      "testdata_ValidateTestService_CreateUser",
+			"arguments": map[string]any{"tags": []any{"a", 42}},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Decoding failures are tool results the model can see, not JSON-RPC errors.
+	response := s.HandleMessage(context.Background(), message)
+	g.Expect(response).To(BeAssignableToTypeOf(mcp.JSONRPCResponse{}))
+	result := response.(mcp.JSONRPCResponse).Result.(mcp.CallToolResult)
+	g.Expect(result.IsError).To(BeTrue())
+
+	var payload map[string]any
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &payload)).To(Succeed())
+	g.Expect(payload).To(HaveKeyWithValue("field", "tags[1]"))
+	g.Expect(payload).To(HaveKeyWithValue("expected", "string"))
+	g.Expect(payload).To(HaveKeyWithValue("received", 42.0))
+}
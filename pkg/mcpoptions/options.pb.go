@@ -0,0 +1,324 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: mcp/options.proto
+
+package mcpoptions
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ToolOptions configures the MCP tool generated for a method.
+type ToolOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tool name, replacing the name derived from the method's full name.
+	// The name is used as is, without any prefix.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Human-readable title of the tool.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Tool description, replacing the method's leading comments.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Do not generate a tool for this method.
+	Exclude bool `protobuf:"varint,4,opt,name=exclude,proto3" json:"exclude,omitempty"`
+	// The tool does not modify its environment.
+	ReadOnly *bool `protobuf:"varint,5,opt,name=read_only,json=readOnly,proto3,oneof" json:"read_only,omitempty"`
+	// The tool may perform destructive updates.
+	Destructive *bool `protobuf:"varint,6,opt,name=destructive,proto3,oneof" json:"destructive,omitempty"`
+	// Calling the tool repeatedly with the same arguments has no additional effect.
+	Idempotent *bool `protobuf:"varint,7,opt,name=idempotent,proto3,oneof" json:"idempotent,omitempty"`
+	// The tool may interact with an open world of external entities.
+	OpenWorld     *bool `protobuf:"varint,8,opt,name=open_world,json=openWorld,proto3,oneof" json:"open_world,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolOptions) Reset() {
+	*x = ToolOptions{}
+	mi := &file_mcp_options_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolOptions) ProtoMessage() {}
+
+func (x *ToolOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolOptions.ProtoReflect.Descriptor instead.
+func (*ToolOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ToolOptions) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolOptions) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ToolOptions) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ToolOptions) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+func (x *ToolOptions) GetReadOnly() bool {
+	if x != nil && x.ReadOnly != nil {
+		return *x.ReadOnly
+	}
+	return false
+}
+
+func (x *ToolOptions) GetDestructive() bool {
+	if x != nil && x.Destructive != nil {
+		return *x.Destructive
+	}
+	return false
+}
+
+func (x *ToolOptions) GetIdempotent() bool {
+	if x != nil && x.Idempotent != nil {
+		return *x.Idempotent
+	}
+	return false
+}
+
+func (x *ToolOptions) GetOpenWorld() bool {
+	if x != nil && x.OpenWorld != nil {
+		return *x.OpenWorld
+	}
+	return false
+}
+
+// ServiceOptions configures the MCP tools generated for a service.
+type ServiceOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prefix of the tool names, replacing the package and service name.
+	// Tool names become <tool_prefix>_<method name>.
+	ToolPrefix string `protobuf:"bytes,1,opt,name=tool_prefix,json=toolPrefix,proto3" json:"tool_prefix,omitempty"`
+	// Do not generate tools for any method of this service.
+	Exclude       bool `protobuf:"varint,2,opt,name=exclude,proto3" json:"exclude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceOptions) Reset() {
+	*x = ServiceOptions{}
+	mi := &file_mcp_options_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceOptions) ProtoMessage() {}
+
+func (x *ServiceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceOptions.ProtoReflect.Descriptor instead.
+func (*ServiceOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServiceOptions) GetToolPrefix() string {
+	if x != nil {
+		return x.ToolPrefix
+	}
+	return ""
+}
+
+func (x *ServiceOptions) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+var file_mcp_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*ToolOptions)(nil),
+		Field:         50551,
+		Name:          "mcp.tool",
+		Tag:           "bytes,50551,opt,name=tool",
+		Filename:      "mcp/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*ServiceOptions)(nil),
+		Field:         50552,
+		Name:          "mcp.service",
+		Tag:           "bytes,50552,opt,name=service",
+		Filename:      "mcp/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// Configures the MCP tool generated for a method.
+	//
+	// optional mcp.ToolOptions tool = 50551;
+	E_Tool = &file_mcp_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// Configures the MCP tools generated for all methods of a service.
+	//
+	// optional mcp.ServiceOptions service = 50552;
+	E_Service = &file_mcp_options_proto_extTypes[1]
+)
+
+var File_mcp_options_proto protoreflect.FileDescriptor
+
+const file_mcp_options_proto_rawDesc = "" +
+	"\n" +
+	"\x11mcp/options.proto\x12\x03mcp\x1a google/protobuf/descriptor.proto\"\xc1\x02\n" +
+	"\vToolOptions\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aexclude\x18\x04 \x01(\bR\aexclude\x12 \n" +
+	"\tread_only\x18\x05 \x01(\bH\x00R\breadOnly\x88\x01\x01\x12%\n" +
+	"\vdestructive\x18\x06 \x01(\bH\x01R\vdestructive\x88\x01\x01\x12#\n" +
+	"\n" +
+	"idempotent\x18\a \x01(\bH\x02R\n" +
+	"idempotent\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"open_world\x18\b \x01(\bH\x03R\topenWorld\x88\x01\x01B\f\n" +
+	"\n" +
+	"_read_onlyB\x0e\n" +
+	"\f_destructiveB\r\n" +
+	"\v_idempotentB\r\n" +
+	"\v_open_world\"K\n" +
+	"\x0eServiceOptions\x12\x1f\n" +
+	"\vtool_prefix\x18\x01 \x01(\tR\n" +
+	"toolPrefix\x12\x18\n" +
+	"\aexclude\x18\x02 \x01(\bR\aexclude:F\n" +
+	"\x04tool\x12\x1e.google.protobuf.MethodOptions\x18\xf7\x8a\x03 \x01(\v2\x10.mcp.ToolOptionsR\x04tool:P\n" +
+	"\aservice\x12\x1f.google.protobuf.ServiceOptions\x18\xf8\x8a\x03 \x01(\v2\x13.mcp.ServiceOptionsR\aserviceB@Z>github.com/statico/protoc-gen-go-mcp/pkg/mcpoptions;mcpoptionsb\x06proto3"
+
+var (
+	file_mcp_options_proto_rawDescOnce sync.Once
+	file_mcp_options_proto_rawDescData []byte
+)
+
+func file_mcp_options_proto_rawDescGZIP() []byte {
+	file_mcp_options_proto_rawDescOnce.Do(func() {
+		file_mcp_options_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mcp_options_proto_rawDesc), len(file_mcp_options_proto_rawDesc)))
+	})
+	return file_mcp_options_proto_rawDescData
+}
+
+var file_mcp_options_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mcp_options_proto_goTypes = []any{
+	(*ToolOptions)(nil),                 // 0: mcp.ToolOptions
+	(*ServiceOptions)(nil),              // 1: mcp.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 2: google.protobuf.MethodOptions
+	(*descriptorpb.ServiceOptions)(nil), // 3: google.protobuf.ServiceOptions
+}
+var file_mcp_options_proto_depIdxs = []int32{
+	2, // 0: mcp.tool:extendee -> google.protobuf.MethodOptions
+	3, // 1: mcp.service:extendee -> google.protobuf.ServiceOptions
+	0, // 2: mcp.tool:type_name -> mcp.ToolOptions
+	1, // 3: mcp.service:type_name -> mcp.ServiceOptions
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	2, // [2:4] is the sub-list for extension type_name
+	0, // [0:2] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mcp_options_proto_init() }
+func file_mcp_options_proto_init() {
+	if File_mcp_options_proto != nil {
+		return
+	}
+	file_mcp_options_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mcp_options_proto_rawDesc), len(file_mcp_options_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_mcp_options_proto_goTypes,
+		DependencyIndexes: file_mcp_options_proto_depIdxs,
+		MessageInfos:      file_mcp_options_proto_msgTypes,
+		ExtensionInfos:    file_mcp_options_proto_extTypes,
+	}.Build()
+	File_mcp_options_proto = out.File
+	file_mcp_options_proto_goTypes = nil
+	file_mcp_options_proto_depIdxs = nil
+}
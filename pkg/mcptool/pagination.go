@@ -0,0 +1,85 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcptool
+
+import (
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ListAllTool is the tool of an AIP-158 list method that follows next_page_token and
+// returns the items of all pages, see runtime.ListAll.
+type ListAllTool struct {
+	MCPTool       mcp.Tool
+	MCPToolOpenAI mcp.Tool
+	MCPToolGemini mcp.Tool
+	// ItemsField is the name of the repeated field of the response holding the items.
+	ItemsField string
+}
+
+// listAllDescription is appended to the description of the list method.
+const listAllDescription = "Follows next_page_token and returns the items of all pages, up to a budget. " +
+	"If next_page_token is set in the result, call again with it as page_token to list the remaining items."
+
+// listItemsField returns the field holding the items of an AIP-158 list method, or nil
+// if the method does not have the shape: a unary method with page_size and page_token
+// in the request, and next_page_token and a repeated field in the response. Methods
+// that would clash with the tool variable of the list-all tool are skipped.
+func listItemsField(meth protoreflect.MethodDescriptor) protoreflect.FieldDescriptor {
+	if meth.IsStreamingClient() || meth.IsStreamingServer() {
+		return nil
+	}
+	if meth.Parent().(protoreflect.ServiceDescriptor).Methods().ByName(meth.Name()+"All") != nil {
+		return nil
+	}
+
+	isScalar := func(fd protoreflect.FieldDescriptor, kind protoreflect.Kind) bool {
+		return fd != nil && fd.Kind() == kind && !fd.IsList() && fd.ContainingOneof() == nil
+	}
+	req, resp := meth.Input().Fields(), meth.Output().Fields()
+	if !isScalar(req.ByName("page_size"), protoreflect.Int32Kind) ||
+		!isScalar(req.ByName("page_token"), protoreflect.StringKind) ||
+		!isScalar(resp.ByName("next_page_token"), protoreflect.StringKind) {
+		return nil
+	}
+
+	// The items are the first repeated field, like the resources of AIP-158 responses.
+	for i := 0; i < resp.Len(); i++ {
+		if fd := resp.Get(i); fd.IsList() {
+			return fd
+		}
+	}
+	return nil
+}
+
+// listAllTool returns the list-all tool of a list method, based on its tool.
+func listAllTool(tool Tool, baseToolName string, items protoreflect.FieldDescriptor) *ListAllTool {
+	variant := func(tool mcp.Tool) mcp.Tool {
+		tool.Name = MangleHeadIfTooLong(baseToolName+"All", 64)
+		if description := strings.TrimRight(tool.Description, "\n"); description != "" {
+			tool.Description = description + "\n\n"
+		}
+		tool.Description += listAllDescription
+		return tool
+	}
+	return &ListAllTool{
+		MCPTool:       variant(tool.MCPTool),
+		MCPToolOpenAI: variant(tool.MCPToolOpenAI),
+		MCPToolGemini: variant(tool.MCPToolGemini),
+		ItemsField:    string(items.Name()),
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mcptool describes the MCP tools of protobuf methods: their names, annotations
+// and the schemas of every provider. It works on protoreflect descriptors only, so the
+// generator and the gateway expose the same tools.
+package mcptool
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/statico/protoc-gen-go-mcp/internal/dialect"
+	"github.com/statico/protoc-gen-go-mcp/pkg/mcpoptions"
+	"github.com/statico/protoc-gen-go-mcp/pkg/schema"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Tool is the tool of a method, with the schemas of every provider.
+type Tool struct {
+	// RequestType and ResponseType are the Go types of the request and response. They
+	// depend on the generated file and are left empty by New.
+	RequestType   string
+	ResponseType  string
+	MCPTool       mcp.Tool
+	MCPToolOpenAI mcp.Tool
+	MCPToolGemini mcp.Tool
+	// ClientStreaming is set for methods accepting a stream of requests.
+	ClientStreaming bool
+	// ServerStreaming is set for methods returning a stream of responses.
+	ServerStreaming bool
+	// FullMethod is the full RPC method name, such as "/package.Service/Method".
+	FullMethod string
+	// ListAll is the list-all tool of AIP-158 list methods, if enabled with
+	// WithListAll.
+	ListAll *ListAllTool
+}
+
+// Option configures the tools built by New.
+type Option func(*config)
+
+type config struct {
+	name             string
+	omitDescriptions bool
+	listAll          bool
+}
+
+// WithName names the tool instead of Name, such as with a trimmed prefix. Names longer
+// than 64 characters are mangled.
+func WithName(name string) Option {
+	return func(c *config) {
+		c.name = name
+	}
+}
+
+// WithoutDescriptions leaves out the comments of fields, messages, enums and enum
+// values from the schemas.
+func WithoutDescriptions() Option {
+	return func(c *config) {
+		c.omitDescriptions = true
+	}
+}
+
+// WithListAll adds the list-all tool of AIP-158 list methods, see ListAllTool.
+func WithListAll() Option {
+	return func(c *config) {
+		c.listAll = true
+	}
+}
+
+// New returns the tool of a method with the schemas of every provider. It fails if a
+// schema can't be marshaled.
+func New(meth protoreflect.MethodDescriptor, opts ...Option) (Tool, error) {
+	c := &config{name: Name(meth)}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	toolOpts := ToolOptions(meth)
+	description := schema.CleanComment(meth.ParentFile().SourceLocations().ByDescriptor(meth).LeadingComments)
+	if toolOpts.GetDescription() != "" {
+		description = toolOpts.GetDescription()
+	}
+	toolAnnotations := Annotations(meth)
+
+	var schemaOpts []schema.Option
+	if c.omitDescriptions {
+		schemaOpts = append(schemaOpts, schema.WithoutDescriptions())
+	}
+	// The first schema that can't be marshaled fails the tool.
+	var marshalErr error
+	marshal := func(s map[string]any) json.RawMessage {
+		marshaled, err := json.Marshal(s)
+		if err != nil && marshalErr == nil {
+			marshalErr = fmt.Errorf("schema of %s: %w", meth.FullName(), err)
+		}
+		return json.RawMessage(marshaled)
+	}
+	// Responses are always marshaled with protojson, so all variants share the
+	// standard output schema.
+	outputSchema := marshal(schema.Output(meth, schemaOpts...))
+
+	newTool := func(provider dialect.LLMProvider) mcp.Tool {
+		return mcp.Tool{
+			Name:            MangleHeadIfTooLong(c.name, 64),
+			Description:     description,
+			Annotations:     toolAnnotations,
+			RawInputSchema:  marshal(schema.Input(meth, append(schemaOpts, schema.WithProvider(provider))...)),
+			RawOutputSchema: outputSchema,
+		}
+	}
+	tool := Tool{
+		MCPTool:         newTool(dialect.LLMProviderStandard),
+		MCPToolOpenAI:   newTool(dialect.LLMProviderOpenAI),
+		MCPToolGemini:   newTool(dialect.LLMProviderGemini),
+		ClientStreaming: meth.IsStreamingClient(),
+		ServerStreaming: meth.IsStreamingServer(),
+		FullMethod:      fmt.Sprintf("/%s/%s", meth.Parent().FullName(), meth.Name()),
+	}
+	if marshalErr != nil {
+		return Tool{}, marshalErr
+	}
+	if c.listAll {
+		if items := listItemsField(meth); items != nil {
+			tool.ListAll = listAllTool(tool, c.name, items)
+		}
+	}
+	return tool, nil
+}
+
+// Name returns the name of the tool of a method before trimming and mangling: the name
+// set in the (mcp.tool) options, the method name with the tool prefix of the service,
+// or the full name of the method.
+func Name(meth protoreflect.MethodDescriptor) string {
+	switch {
+	case ToolOptions(meth).GetName() != "":
+		return ToolOptions(meth).GetName()
+	case ServiceOptions(meth.Parent().(protoreflect.ServiceDescriptor)).GetToolPrefix() != "":
+		return ServiceOptions(meth.Parent().(protoreflect.ServiceDescriptor)).GetToolPrefix() + "_" + string(meth.Name())
+	}
+	return strings.ReplaceAll(string(meth.FullName()), ".", "_")
+}
+
+// ServiceOptions returns the (mcp.service) options of a service, or nil if not set.
+func ServiceOptions(svc protoreflect.ServiceDescriptor) *mcpoptions.ServiceOptions {
+	if !proto.HasExtension(svc.Options(), mcpoptions.E_Service) {
+		return nil
+	}
+	return proto.GetExtension(svc.Options(), mcpoptions.E_Service).(*mcpoptions.ServiceOptions)
+}
+
+// ToolOptions returns the (mcp.tool) options of a method, or nil if not set.
+func ToolOptions(meth protoreflect.MethodDescriptor) *mcpoptions.ToolOptions {
+	if !proto.HasExtension(meth.Options(), mcpoptions.E_Tool) {
+		return nil
+	}
+	return proto.GetExtension(meth.Options(), mcpoptions.E_Tool).(*mcpoptions.ToolOptions)
+}
+
+// ExposedMethods returns the methods of a service that are exposed as tools, the
+// methods of excluded services and excluded methods are skipped.
+func ExposedMethods(svc protoreflect.ServiceDescriptor) []protoreflect.MethodDescriptor {
+	if ServiceOptions(svc).GetExclude() {
+		return nil
+	}
+	var methods []protoreflect.MethodDescriptor
+	for i := 0; i < svc.Methods().Len(); i++ {
+		meth := svc.Methods().Get(i)
+		if ToolOptions(meth).GetExclude() {
+			continue
+		}
+		methods = append(methods, meth)
+	}
+	return methods
+}
+
+// Annotations derives the MCP tool annotations of a method from its idempotency level
+// and HTTP rule. Hints set explicitly in the (mcp.tool) options take precedence.
+func Annotations(meth protoreflect.MethodDescriptor) mcp.ToolAnnotation {
+	result := mcp.ToolAnnotation{}
+
+	methodOpts, _ := meth.Options().(*descriptorpb.MethodOptions)
+	switch methodOpts.GetIdempotencyLevel() {
+	case descriptorpb.MethodOptions_NO_SIDE_EFFECTS:
+		result.ReadOnlyHint = mcp.ToBoolPtr(true)
+	case descriptorpb.MethodOptions_IDEMPOTENT:
+		result.IdempotentHint = mcp.ToBoolPtr(true)
+	}
+	if proto.HasExtension(methodOpts, annotations.E_Http) {
+		rule := proto.GetExtension(methodOpts, annotations.E_Http).(*annotations.HttpRule)
+		if rule.GetDelete() != "" {
+			result.DestructiveHint = mcp.ToBoolPtr(true)
+		}
+	}
+
+	opts := ToolOptions(meth)
+	if opts == nil {
+		return result
+	}
+	result.Title = opts.GetTitle()
+	if opts.ReadOnly != nil {
+		result.ReadOnlyHint = opts.ReadOnly
+	}
+	if opts.Destructive != nil {
+		result.DestructiveHint = opts.Destructive
+	}
+	if opts.Idempotent != nil {
+		result.IdempotentHint = opts.Idempotent
+	}
+	if opts.OpenWorld != nil {
+		result.OpenWorldHint = opts.OpenWorld
+	}
+	return result
+}
+
+func Base32String(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	return n.Text(36)
+}
+
+func MangleHeadIfTooLong(name string, maxLen int) string {
+	if len(name) <= maxLen {
+		return name
+	}
+
+	// Generate short hash of full name
+	hash := sha1.Sum([]byte(name))
+	hashPrefix := Base32String(hash[:])[:6] // e.g. "3fj92a"
+
+	// Leave room for hash prefix + underscore
+	available := maxLen - len(hashPrefix) - 1
+	if available <= 0 {
+		return hashPrefix
+	}
+
+	// Preserve the end of the name (most specific)
+	tail := name[len(name)-available:]
+	return hashPrefix + "_" + tail
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcptool
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestExposedMethods(t *testing.T) {
+	g := NewWithT(t)
+
+	services := testdata.File_testdata_options_test_proto.Services()
+	var names []protoreflect.Name
+	for _, meth := range ExposedMethods(services.ByName("OptionsTestService")) {
+		names = append(names, meth.Name())
+	}
+	g.Expect(names).To(Equal([]protoreflect.Name{"ListProducts", "GetProduct", "UpdateProduct", "DeleteProduct"}))
+	g.Expect(ExposedMethods(services.ByName("ExcludedTestService"))).To(BeEmpty())
+}
+
+func TestNew(t *testing.T) {
+	methods := testdata.File_testdata_options_test_proto.Services().ByName("OptionsTestService").Methods()
+
+	t.Run("options", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tool, err := New(methods.ByName("DeleteProduct"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tool.FullMethod).To(Equal("/testdata.OptionsTestService/DeleteProduct"))
+		for _, variant := range []string{tool.MCPTool.Name, tool.MCPToolOpenAI.Name, tool.MCPToolGemini.Name} {
+			g.Expect(variant).To(Equal("remove_product"))
+		}
+		g.Expect(tool.MCPTool.Description).To(Equal("Removes a product from the inventory. This cannot be undone."))
+		g.Expect(tool.MCPTool.Annotations.DestructiveHint).To(HaveValue(BeTrue()))
+		g.Expect(tool.ListAll).To(BeNil())
+	})
+
+	t.Run("tool prefix", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tool, err := New(methods.ByName("GetProduct"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tool.MCPTool.Name).To(Equal("inventory_GetProduct"))
+		g.Expect(tool.MCPTool.Annotations.ReadOnlyHint).To(HaveValue(BeTrue()))
+	})
+
+	t.Run("name", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tool, err := New(methods.ByName("GetProduct"), WithName("get_product"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tool.MCPTool.Name).To(Equal("get_product"))
+
+		tool, err = New(methods.ByName("GetProduct"), WithName(strings.Repeat("x", 70)))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tool.MCPTool.Name).To(HaveLen(64))
+	})
+
+	t.Run("list all", func(t *testing.T) {
+		g := NewWithT(t)
+
+		listShelves := testdata.File_testdata_pagination_test_proto.Services().Get(0).Methods().ByName("ListShelves")
+		tool, err := New(listShelves, WithListAll())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tool.ListAll).ToNot(BeNil())
+		g.Expect(tool.ListAll.MCPTool.Name).To(Equal(tool.MCPTool.Name + "All"))
+		g.Expect(tool.ListAll.ItemsField).To(Equal("shelves"))
+	})
+}
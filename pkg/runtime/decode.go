@@ -0,0 +1,234 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// DecodeError describes tool arguments that could not be decoded into the request
+// message. HandleError turns it into a tool error, so the model can correct the call.
+type DecodeError struct {
+	// Field is the path of the offending argument, such as "items[2].price".
+	Field string `json:"field,omitempty"`
+	// Expected describes the type the field accepts.
+	Expected string `json:"expected,omitempty"`
+	// Received is the value that was sent.
+	Received any    `json:"received,omitempty"`
+	Message  string `json:"message"`
+}
+
+func (e *DecodeError) Error() string {
+	return e.Message
+}
+
+var unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// UnmarshalArguments decodes the arguments of a tool call into a request message.
+// If decoding fails, the returned *DecodeError points to the offending field. Fields
+// marked as OUTPUT_ONLY with google.api.field_behavior are cleared, they are not in
+// the input schemas and only the server may set them.
+func UnmarshalArguments(arguments map[string]any, msg proto.Message) error {
+	marshaled, err := json.Marshal(arguments)
+	if err != nil {
+		return &DecodeError{Message: err.Error()}
+	}
+	if err := unmarshalOptions.Unmarshal(marshaled, msg); err != nil {
+		if decodeErr := locateDecodeError(msg.ProtoReflect().Descriptor(), arguments, ""); decodeErr != nil {
+			return decodeErr
+		}
+		return &DecodeError{Message: err.Error()}
+	}
+	clearOutputOnly(msg.ProtoReflect())
+	return nil
+}
+
+// clearOutputOnly clears the output-only fields of a message and the messages it
+// holds.
+func clearOutputOnly(msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if proto.HasExtension(fd.Options(), annotations.E_FieldBehavior) &&
+			slices.Contains(proto.GetExtension(fd.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior), annotations.FieldBehavior_OUTPUT_ONLY) {
+			msg.Clear(fd)
+			return true
+		}
+
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					clearOutputOnly(v.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				for i := 0; i < value.List().Len(); i++ {
+					clearOutputOnly(value.List().Get(i).Message())
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			clearOutputOnly(value.Message())
+		}
+		return true
+	})
+}
+
+// locateDecodeError finds the first argument that protojson rejects by decoding the
+// arguments one at a time, descending into messages, lists and maps.
+func locateDecodeError(md protoreflect.MessageDescriptor, arguments map[string]any, path string) *DecodeError {
+	for _, key := range sortedKeys(arguments) {
+		fd := md.Fields().ByJSONName(key)
+		if fd == nil {
+			fd = md.Fields().ByTextName(key)
+		}
+		if fd == nil {
+			// Unknown arguments are discarded.
+			continue
+		}
+		value := arguments[key]
+		if decodes(md, key, value) {
+			continue
+		}
+
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		switch {
+		case fd.IsList():
+			if elems, ok := value.([]any); ok {
+				for i, elem := range elems {
+					if !decodes(md, key, []any{elem}) {
+						return locateValue(fd, elem, fmt.Sprintf("%s[%d]", fieldPath, i))
+					}
+				}
+			}
+		case fd.IsMap():
+			if entries, ok := value.(map[string]any); ok {
+				for _, k := range sortedKeys(entries) {
+					if !decodes(md, key, map[string]any{k: entries[k]}) {
+						return locateValue(fd.MapValue(), entries[k], fmt.Sprintf("%s[%q]", fieldPath, k))
+					}
+				}
+			}
+		default:
+			return locateValue(fd, value, fieldPath)
+		}
+		return newDecodeError(fieldPath, describeField(fd), value)
+	}
+	return nil
+}
+
+// locateValue returns the error of a single value, descending into messages.
+func locateValue(fd protoreflect.FieldDescriptor, value any, path string) *DecodeError {
+	if fd.Message() != nil && fd.Message().FullName().Parent() != "google.protobuf" {
+		if obj, ok := value.(map[string]any); ok {
+			if decodeErr := locateDecodeError(fd.Message(), obj, path); decodeErr != nil {
+				return decodeErr
+			}
+		}
+	}
+	return newDecodeError(path, describeKind(fd), value)
+}
+
+// decodes reports whether a single argument can be decoded into a message of type md.
+func decodes(md protoreflect.MessageDescriptor, key string, value any) bool {
+	marshaled, err := json.Marshal(map[string]any{key: value})
+	if err != nil {
+		return false
+	}
+	return unmarshalOptions.Unmarshal(marshaled, dynamicpb.NewMessage(md)) == nil
+}
+
+func newDecodeError(path, expected string, received any) *DecodeError {
+	marshaled, _ := json.Marshal(received)
+	return &DecodeError{
+		Field:    path,
+		Expected: expected,
+		Received: received,
+		Message:  fmt.Sprintf("invalid value for %s: expected %s, got %s", path, expected, marshaled),
+	}
+}
+
+// describeField describes the JSON type a field accepts.
+func describeField(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return fmt.Sprintf("object mapping %s keys to %s values", describeKind(fd.MapKey()), describeKind(fd.MapValue()))
+	case fd.IsList():
+		return "array of " + describeKind(fd)
+	}
+	return describeKind(fd)
+}
+
+// describeKind describes the JSON type of a single value of a field.
+func describeKind(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch fd.Message().FullName() {
+		case "google.protobuf.Timestamp":
+			return "RFC 3339 timestamp string"
+		case "google.protobuf.Duration":
+			return `duration string such as "1.5s"`
+		case "google.protobuf.FieldMask":
+			return "comma-separated field paths string"
+		case "google.protobuf.Value":
+			return "any JSON value"
+		case "google.protobuf.ListValue":
+			return "array"
+		case "google.protobuf.Struct":
+			return "object"
+		case "google.protobuf.Any":
+			return `object with an "@type" URL`
+		}
+		if value := fd.Message().Fields().ByName("value"); value != nil && fd.Message().FullName().Parent() == "google.protobuf" {
+			// Wrapper types such as google.protobuf.Int32Value are their value in JSON.
+			return describeKind(value)
+		}
+		return fmt.Sprintf("object (%s)", fd.Message().FullName())
+	case protoreflect.EnumKind:
+		var names []string
+		for i := 0; i < fd.Enum().Values().Len(); i++ {
+			names = append(names, string(fd.Enum().Values().Get(i).Name()))
+		}
+		return fmt.Sprintf("enum %s (one of %s)", fd.Enum().FullName(), strings.Join(names, ", "))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return fd.Kind().String() + " (number or decimal string)"
+	case protoreflect.BytesKind:
+		return "base64-encoded bytes"
+	}
+	return fd.Kind().String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
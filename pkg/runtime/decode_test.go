@@ -0,0 +1,153 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUnmarshalArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       proto.Message
+		arguments map[string]any
+		expected  *DecodeError
+	}{
+		{
+			name:      "valid arguments",
+			msg:       &testdata.CreateItemRequest{},
+			arguments: map[string]any{"name": "item", "tags": []any{"a"}, "unknown": true},
+		},
+		{
+			name:      "scalar of the wrong type",
+			msg:       &testdata.CreateItemRequest{},
+			arguments: map[string]any{"name": 5.0},
+			expected:  &DecodeError{Field: "name", Expected: "string", Received: 5.0},
+		},
+		{
+			name:      "list element",
+			msg:       &testdata.CreateItemRequest{},
+			arguments: map[string]any{"name": "item", "tags": []any{"a", 3.0}},
+			expected:  &DecodeError{Field: "tags[1]", Expected: "string", Received: 3.0},
+		},
+		{
+			name:      "list that is not an array",
+			msg:       &testdata.CreateItemRequest{},
+			arguments: map[string]any{"tags": "a"},
+			expected:  &DecodeError{Field: "tags", Expected: "array of string", Received: "a"},
+		},
+		{
+			name:      "map value",
+			msg:       &testdata.CreateItemRequest{},
+			arguments: map[string]any{"labels": map[string]any{"env": "prod", "tier": 1.0}},
+			expected:  &DecodeError{Field: `labels["tier"]`, Expected: "string", Received: 1.0},
+		},
+		{
+			name:      "nested message",
+			msg:       &testdata.CreateItemRequest{},
+			arguments: map[string]any{"product": map[string]any{"price": "cheap"}},
+			expected:  &DecodeError{Field: "product.price", Expected: "double", Received: "cheap"},
+		},
+		{
+			name:      "enum",
+			msg:       &testdata.CreateUserRequest{},
+			arguments: map[string]any{"role": true},
+			expected: &DecodeError{
+				Field:    "role",
+				Expected: "enum testdata.Role (one of ROLE_UNSPECIFIED, ROLE_VIEWER, ROLE_EDITOR, ROLE_ADMIN)",
+				Received: true,
+			},
+		},
+		{
+			name:      "64-bit integer",
+			msg:       &testdata.CreateUserRequest{},
+			arguments: map[string]any{"quota": "many"},
+			expected:  &DecodeError{Field: "quota", Expected: "int64 (number or decimal string)", Received: "many"},
+		},
+		{
+			name:      "well-known type",
+			msg:       &testdata.CreateItemResponse{},
+			arguments: map[string]any{"created_at": "yesterday"},
+			expected:  &DecodeError{Field: "created_at", Expected: "RFC 3339 timestamp string", Received: "yesterday"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := UnmarshalArguments(tt.arguments, tt.msg)
+			if tt.expected == nil {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).To(BeAssignableToTypeOf(&DecodeError{}))
+			decodeErr := err.(*DecodeError)
+			g.Expect(decodeErr.Field).To(Equal(tt.expected.Field))
+			g.Expect(decodeErr.Expected).To(Equal(tt.expected.Expected))
+			g.Expect(decodeErr.Received).To(Equal(tt.expected.Received))
+			g.Expect(decodeErr.Message).To(HavePrefix("invalid value for " + tt.expected.Field + ": expected "))
+		})
+	}
+}
+
+func TestHandleDecodeError(t *testing.T) {
+	g := NewWithT(t)
+
+	err := UnmarshalArguments(map[string]any{"tags": []any{"a", 3.0}}, &testdata.CreateItemRequest{})
+	result, handleErr := HandleError(err)
+	g.Expect(handleErr).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+
+	var payload map[string]any
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &payload)).To(Succeed())
+	g.Expect(payload).To(Equal(map[string]any{
+		"code":     "INVALID_ARGUMENT",
+		"field":    "tags[1]",
+		"expected": "string",
+		"received": 3.0,
+		"message":  "invalid value for tags[1]: expected string, got 3",
+	}))
+}
+
+func TestUnmarshalArgumentsOutputOnly(t *testing.T) {
+	g := NewWithT(t)
+
+	req := &testdata.UpdateBookRequest{}
+	g.Expect(UnmarshalArguments(map[string]any{
+		"book": map[string]any{
+			"name":        "books/1",
+			"title":       "Title",
+			"create_time": "2025-01-02T03:04:05Z",
+			"etag":        "spoofed",
+			"authors":     []any{map[string]any{"display_name": "Jane", "uid": "spoofed"}},
+		},
+	}, req)).To(Succeed())
+
+	g.Expect(proto.Equal(req, &testdata.UpdateBookRequest{
+		Book: &testdata.Book{
+			Name:    "books/1",
+			Title:   "Title",
+			Authors: []*testdata.Author{{DisplayName: "Jane"}},
+		},
+	})).To(BeTrue())
+}
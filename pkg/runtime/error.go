@@ -15,11 +15,13 @@
 package runtime
 
 import (
+	"encoding/json"
 	"errors"
 
 	"connectrpc.com/connect"
 	"github.com/mark3labs/mcp-go/mcp"
 	apierrors "github.com/redpanda-data/common-go/api/errors"
+	"google.golang.org/genproto/googleapis/rpc/code"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,6 +34,24 @@ func HandleError(err error) (*mcp.CallToolResult, error) {
 	if err == nil {
 		return nil, nil
 	}
+	return mcp.NewToolResultError(errorText(err)), nil
+}
+
+// errorText formats an error as the JSON text of an error tool result.
+func errorText(err error) string {
+	// Arguments that could not be decoded are reported with the offending field, so
+	// the model can correct the call.
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		payload, marshalErr := json.Marshal(struct {
+			Code string `json:"code"`
+			*DecodeError
+		}{Code: code.Code_INVALID_ARGUMENT.String(), DecodeError: decodeErr})
+		if marshalErr != nil {
+			return "Error: " + err.Error()
+		}
+		return string(payload)
+	}
 
 	// Convert to google.rpc.Status regardless of source
 	var statusProto *spb.Status
@@ -58,8 +78,8 @@ func HandleError(err error) (*mcp.CallToolResult, error) {
 	finalJSON, marshalErr := protojson.Marshal(niceStatus)
 	if marshalErr != nil {
 		// Fallback to simple error message if JSON marshaling fails
-		return mcp.NewToolResultError("Error: " + err.Error()), nil
+		return "Error: " + err.Error()
 	}
 
-	return mcp.NewToolResultError(string(finalJSON)), nil
+	return string(finalJSON)
 }
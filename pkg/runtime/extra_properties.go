@@ -1,9 +1,15 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/statico/protoc-gen-go-mcp/internal/dialect"
 )
 
 // Option defines functional options for MCP functions
@@ -15,10 +21,27 @@ type ExtraProperty struct {
 	Description string
 	Required    bool
 	ContextKey  interface{}
+	// Schema is the JSON schema of the property, such as {"type": "integer"}. The
+	// property is a string if nil.
+	Schema map[string]any
+	// Default is the argument used if the property is not set. It is added to the
+	// schema and decoded like a value sent by the client.
+	Default any
+	// Decode converts the argument into the value stored in the context. Calls with
+	// arguments it returns an error for are rejected before the RPC. Without Decode,
+	// integers are stored as int64 and other values as decoded from JSON.
+	Decode func(value any) (any, error)
 }
 
 type config struct {
-	ExtraProperties []ExtraProperty
+	ExtraProperties    []ExtraProperty
+	StreamMessageLimit int
+	Validate           bool
+	Interceptors       []ToolInterceptor
+	SchemaTransforms   []SchemaTransform
+	MetadataForwarder  *MetadataForwarder
+	FieldBindings      []FieldBinding
+	ListAllBudget      ListAllBudget
 }
 
 // WithExtraProperties adds extra properties to tool schemas and extracts them from request arguments
@@ -28,22 +51,46 @@ func WithExtraProperties(properties ...ExtraProperty) Option {
 	}
 }
 
+// WithStreamMessageLimit limits the result of streaming tools to the last n messages.
+// All messages are still sent as progress notifications. Zero means no limit.
+func WithStreamMessageLimit(n int) Option {
+	return func(c *config) {
+		c.StreamMessageLimit = n
+	}
+}
+
 // NewConfig creates a new config instance
 func NewConfig() *config {
 	return &config{}
 }
 
-// AddExtraPropertiesToTool modifies a tool's schema to include additional properties
-func AddExtraPropertiesToTool(tool mcp.Tool, properties []ExtraProperty) mcp.Tool {
+// AddExtraPropertiesToTool modifies a tool's schema to include additional properties.
+// The transforms of the dialect of the tool's schema, if any, are applied to the
+// schemas of the properties.
+func AddExtraPropertiesToTool(tool mcp.Tool, properties []ExtraProperty, transforms ...SchemaTransform) mcp.Tool {
 	if len(properties) == 0 {
 		return tool
 	}
 
+	modifiedSchema, ok := addExtraProperties(tool.RawInputSchema, properties, transforms)
+	if !ok {
+		return tool
+	}
+
+	// Create a new tool with the modified schema
+	modifiedTool := tool
+	modifiedTool.RawInputSchema = modifiedSchema
+	return modifiedTool
+}
+
+// addExtraProperties adds the extra properties to a JSON schema. It reports false if
+// the schema could not be modified.
+func addExtraProperties(rawSchema json.RawMessage, properties []ExtraProperty, transforms []SchemaTransform) (json.RawMessage, bool) {
 	// Parse the existing schema
 	var schema map[string]interface{}
-	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
-		// If we can't parse the schema, return the original tool
-		return tool
+	if err := json.Unmarshal(rawSchema, &schema); err != nil {
+		// If we can't parse the schema, the original tool is kept
+		return nil, false
 	}
 
 	// Add extra properties to schema
@@ -61,19 +108,15 @@ func AddExtraPropertiesToTool(tool mcp.Tool, properties []ExtraProperty) mcp.Too
 		requiredFields = req
 	}
 
-	// Add each extra property
-	for _, prop := range properties {
-		// All extra properties are treated as strings by default
-		propertyDef := map[string]interface{}{
-			"type":        "string",
-			"description": prop.Description,
-		}
-
-		schemaProperties[prop.Name] = propertyDef
-
-		// Add to required fields if needed
-		if prop.Required {
-			requiredFields = append(requiredFields, prop.Name)
+	// The properties are transformed on their own, the rest of the schema is already
+	// in the dialect of the transforms.
+	extra := extraPropertiesSchema(properties, transforms)
+	for name, propertyDef := range extra["properties"].(map[string]any) {
+		schemaProperties[name] = propertyDef
+	}
+	for _, name := range dialect.StringList(extra["required"]) {
+		if !slices.Contains(requiredFields, any(name)) {
+			requiredFields = append(requiredFields, name)
 		}
 	}
 
@@ -85,12 +128,205 @@ func AddExtraPropertiesToTool(tool mcp.Tool, properties []ExtraProperty) mcp.Too
 	// Marshal the modified schema back
 	modifiedSchema, err := json.Marshal(schema)
 	if err != nil {
-		// If marshaling fails, return the original tool
-		return tool
+		// If marshaling fails, the original tool is kept
+		return nil, false
 	}
+	return json.RawMessage(modifiedSchema), true
+}
 
-	// Create a new tool with the modified schema
-	modifiedTool := tool
-	modifiedTool.RawInputSchema = json.RawMessage(modifiedSchema)
-	return modifiedTool
+// extraPropertiesSchema returns an object schema with the extra properties, in the
+// dialect of the transforms.
+func extraPropertiesSchema(properties []ExtraProperty, transforms []SchemaTransform) map[string]any {
+	// Dialects requiring all properties can only leave out nullable ones.
+	allRequired := slices.ContainsFunc(transforms, func(t SchemaTransform) bool { return t.Name == AllRequired.Name })
+
+	schemaProperties := map[string]any{}
+	required := []string{}
+	for _, prop := range properties {
+		// Extra properties are strings unless they have a schema
+		propertyDef := map[string]any{"type": "string"}
+		if prop.Schema != nil {
+			propertyDef = dialect.CloneSchema(prop.Schema)
+		}
+		if prop.Description != "" {
+			propertyDef["description"] = prop.Description
+		}
+		if prop.Default != nil {
+			propertyDef["default"] = prop.Default
+		}
+		if allRequired && !prop.Required {
+			dialect.AddNull(propertyDef)
+			if enum, ok := propertyDef["enum"]; ok {
+				propertyDef["enum"] = append(reflectList(enum), nil)
+			}
+		}
+
+		schemaProperties[prop.Name] = propertyDef
+		if prop.Required {
+			required = append(required, prop.Name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": schemaProperties,
+		"required":   required,
+	}
+	return TransformSchema(schema, transforms...)
+}
+
+// ExtractExtraProperties decodes the extra properties of a tool call and stores them
+// in the context. Missing required properties and values that do not match the schema
+// of a property are returned as *DecodeError.
+func ExtractExtraProperties(ctx context.Context, c *config, arguments map[string]any) (context.Context, error) {
+	for _, prop := range c.ExtraProperties {
+		value, err := prop.decode(arguments)
+		if err != nil {
+			return ctx, err
+		}
+		if value != nil {
+			ctx = context.WithValue(ctx, prop.ContextKey, value)
+		}
+	}
+	return ctx, nil
+}
+
+// decode returns the value of the property in the arguments, or nil if it is not set.
+// Null is treated as not set, as dialects requiring all properties send null for
+// optional ones.
+func (p ExtraProperty) decode(arguments map[string]any) (any, error) {
+	value := arguments[p.Name]
+	if value == nil && p.Default != nil {
+		// Defaults built in Go are decoded like values sent by the client
+		marshaled, err := json.Marshal(p.Default)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(marshaled, &value); err != nil {
+			return nil, err
+		}
+	}
+	if value == nil {
+		if p.Required {
+			return nil, &DecodeError{
+				Field:    p.Name,
+				Expected: p.expected(),
+				Message:  fmt.Sprintf("missing required argument %q", p.Name),
+			}
+		}
+		return nil, nil
+	}
+
+	schema := p.Schema
+	if schema == nil {
+		schema = map[string]any{"type": "string"}
+	}
+	value = unfixExtraValue(schema, value)
+	if !matchesSchema(schema, value) {
+		return nil, newDecodeError(p.Name, p.expected(), value)
+	}
+
+	if p.Decode == nil {
+		if number, ok := value.(float64); ok && slices.Contains(dialect.SchemaTypes(schema), "integer") {
+			return int64(number), nil
+		}
+		return value, nil
+	}
+	decoded, err := p.Decode(value)
+	if err != nil {
+		return nil, &DecodeError{
+			Field:    p.Name,
+			Expected: p.expected(),
+			Received: value,
+			Message:  fmt.Sprintf("invalid value for %s: %v", p.Name, err),
+		}
+	}
+	return decoded, nil
+}
+
+// expected describes the values the property accepts.
+func (p ExtraProperty) expected() string {
+	if p.Schema == nil {
+		return "string"
+	}
+	expected := strings.Join(dialect.SchemaTypes(p.Schema), " or ")
+	if enum, ok := p.Schema["enum"]; ok {
+		expected = "one of " + dialect.FormatValues(enum)
+	}
+	if expected == "" {
+		return "any JSON value"
+	}
+	return expected
+}
+
+// unfixExtraValue converts a value sent in a provider dialect back to the schema of the
+// property: objects may be sent as key value pairs, and free-form values as JSON
+// strings.
+func unfixExtraValue(schema map[string]any, value any) any {
+	types := dialect.SchemaTypes(schema)
+	switch v := value.(type) {
+	case []any:
+		if slices.Contains(types, "object") && !slices.Contains(types, "array") {
+			return kvArrayToMap(v)
+		}
+	case string:
+		if len(types) > 0 && !slices.Contains(types, "string") {
+			var parsed any
+			if err := json.Unmarshal([]byte(v), &parsed); err == nil {
+				return parsed
+			}
+		}
+	}
+	return value
+}
+
+// matchesSchema checks the type and the allowed values of a JSON value. Other keywords
+// are left to Decode.
+func matchesSchema(schema map[string]any, value any) bool {
+	if types := dialect.SchemaTypes(schema); len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool { return hasType(value, t) }) {
+		return false
+	}
+	if enum, ok := schema["enum"]; ok {
+		return slices.ContainsFunc(reflectList(enum), func(allowed any) bool { return jsonEqual(allowed, value) })
+	}
+	return true
+}
+
+// hasType reports whether a JSON value has a JSON Schema type.
+func hasType(value any, t string) bool {
+	switch v := value.(type) {
+	case string:
+		return t == "string"
+	case bool:
+		return t == "boolean"
+	case float64:
+		return t == "number" || t == "integer" && v == float64(int64(v))
+	case map[string]any:
+		return t == "object"
+	case []any:
+		return t == "array"
+	}
+	return false
+}
+
+// jsonEqual reports whether two values have the same JSON encoding, so values built in
+// Go compare equal to values decoded from JSON.
+func jsonEqual(a, b any) bool {
+	marshaledA, errA := json.Marshal(a)
+	marshaledB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(marshaledA) == string(marshaledB)
+}
+
+// reflectList returns the elements of a list of any element type, such as the values
+// of an "enum" built in Go.
+func reflectList(list any) []any {
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+	values := make([]any, v.Len())
+	for i := range values {
+		values[i] = v.Index(i).Interface()
+	}
+	return values
 }
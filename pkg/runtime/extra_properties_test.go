@@ -15,7 +15,10 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -226,3 +229,139 @@ func TestAddExtraPropertiesToToolWithCustomFieldName(t *testing.T) {
 	// Verify the URL field was added to required fields
 	g.Expect(modifiedSchema["required"]).To(Equal([]interface{}{"name", "api_url"}))
 }
+
+// extraProperties adds the properties to an empty object schema and returns the
+// resulting schema.
+func extraProperties(g *WithT, properties []ExtraProperty, transforms ...SchemaTransform) map[string]any {
+	tool := AddExtraPropertiesToTool(mcp.Tool{RawInputSchema: json.RawMessage(`{"type":"object","properties":{}}`)}, properties, transforms...)
+	var schema map[string]any
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	return schema
+}
+
+var typedProperties = []ExtraProperty{
+	{Name: "page_size", Description: "Page size", Schema: map[string]any{"type": "integer", "minimum": 1}, Default: 50},
+	{Name: "region", Schema: map[string]any{"type": "string", "enum": []string{"eu", "us"}}, Required: true},
+	{Name: "labels", Schema: map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}}},
+}
+
+func TestAddTypedExtraProperties(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := extraProperties(g, typedProperties)
+	properties := schema["properties"].(map[string]any)
+	g.Expect(properties["page_size"]).To(Equal(map[string]any{"type": "integer", "minimum": 1.0, "description": "Page size", "default": 50.0}))
+	g.Expect(properties["region"]).To(Equal(map[string]any{"type": "string", "enum": []any{"eu", "us"}}))
+	g.Expect(properties["labels"]).To(HaveKeyWithValue("additionalProperties", map[string]any{"type": "string"}))
+	g.Expect(schema["required"]).To(Equal([]any{"region"}))
+
+	// The schema of the property is not modified
+	g.Expect(typedProperties[0].Schema).ToNot(HaveKey("description"))
+}
+
+func TestAddTypedExtraPropertiesOpenAI(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := extraProperties(g, typedProperties, SchemaTransforms(LLMProviderOpenAI)...)
+	properties := schema["properties"].(map[string]any)
+
+	// All properties are required, optional ones are nullable
+	g.Expect(schema["required"]).To(ConsistOf("page_size", "region", "labels"))
+	g.Expect(properties["page_size"]).To(HaveKeyWithValue("type", []any{"integer", "null"}))
+	g.Expect(properties["region"]).To(Equal(map[string]any{"type": "string", "enum": []any{"eu", "us"}}))
+
+	// Maps are key value pairs, unsupported keywords are described
+	g.Expect(properties["labels"]).To(HaveKeyWithValue("type", []any{"array", "null"}))
+	g.Expect(properties["page_size"]).ToNot(HaveKey("default"))
+	g.Expect(properties["page_size"]).To(HaveKeyWithValue("description", ContainSubstring("Page size")))
+
+	// Nullable enums allow null
+	schema = extraProperties(g, []ExtraProperty{{Name: "region", Schema: map[string]any{"type": "string", "enum": []string{"eu"}}}}, SchemaTransforms(LLMProviderOpenAI)...)
+	g.Expect(schema["properties"]).To(HaveKeyWithValue("region", HaveKeyWithValue("enum", []any{"eu", nil})))
+}
+
+type pageSizeKey struct{}
+
+func TestExtractExtraProperties(t *testing.T) {
+	properties := []ExtraProperty{
+		{Name: "page_size", ContextKey: pageSizeKey{}, Schema: map[string]any{"type": "integer"}, Default: 50},
+		{Name: "region", ContextKey: "region", Schema: map[string]any{"type": "string", "enum": []string{"eu", "us"}}, Required: true},
+		{Name: "labels", ContextKey: "labels", Schema: map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}}},
+		{Name: "port", ContextKey: "port", Decode: func(value any) (any, error) {
+			port, err := strconv.Atoi(value.(string))
+			if err != nil {
+				return nil, errors.New("not a port number")
+			}
+			return port, nil
+		}},
+	}
+	c := NewConfig()
+	WithExtraProperties(properties...)(c)
+
+	t.Run("typed values", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx, err := ExtractExtraProperties(context.Background(), c, map[string]any{
+			"page_size": 10.0,
+			"region":    "eu",
+			"labels":    map[string]any{"env": "prod"},
+			"port":      "8080",
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ctx.Value(pageSizeKey{})).To(Equal(int64(10)))
+		g.Expect(ctx.Value("region")).To(Equal("eu"))
+		g.Expect(ctx.Value("labels")).To(Equal(map[string]any{"env": "prod"}))
+		g.Expect(ctx.Value("port")).To(Equal(8080))
+	})
+
+	t.Run("defaults and null", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx, err := ExtractExtraProperties(context.Background(), c, map[string]any{"region": "us", "page_size": nil})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ctx.Value(pageSizeKey{})).To(Equal(int64(50)))
+		g.Expect(ctx.Value("labels")).To(BeNil())
+	})
+
+	t.Run("provider encodings", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx, err := ExtractExtraProperties(context.Background(), c, map[string]any{
+			"region": "eu",
+			"labels": []any{map[string]any{"key": "env", "value": "prod"}},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ctx.Value("labels")).To(Equal(map[string]any{"env": "prod"}))
+	})
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		err       *DecodeError
+	}{
+		{
+			name:      "missing required",
+			arguments: map[string]any{},
+			err:       &DecodeError{Field: "region", Expected: `one of ["eu" "us"]`, Message: `missing required argument "region"`},
+		},
+		{
+			name:      "value not allowed",
+			arguments: map[string]any{"region": "asia"},
+			err:       &DecodeError{Field: "region", Expected: `one of ["eu" "us"]`, Received: "asia", Message: `invalid value for region: expected one of ["eu" "us"], got "asia"`},
+		},
+		{
+			name:      "wrong type",
+			arguments: map[string]any{"region": "eu", "page_size": 1.5},
+			err:       &DecodeError{Field: "page_size", Expected: "integer", Received: 1.5, Message: "invalid value for page_size: expected integer, got 1.5"},
+		},
+		{
+			name:      "decode error",
+			arguments: map[string]any{"region": "eu", "port": "http"},
+			err:       &DecodeError{Field: "port", Expected: "string", Received: "http", Message: "invalid value for port: not a port number"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := ExtractExtraProperties(context.Background(), c, tt.arguments)
+			g.Expect(err).To(Equal(tt.err))
+		})
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/statico/protoc-gen-go-mcp/internal/dialect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldBinding sets a field of the request messages from the MCP session instead of
+// the tool arguments. The field is removed from the input schemas of the tools, and
+// a value sent for it anyway is overwritten, so the model can't choose it.
+type FieldBinding struct {
+	// Path is the dot-separated path of the field in the request message, such as
+	// "parent" or "project.id". Requests without the field are left unchanged.
+	Path string
+	// ContextKey is the key of the context value the field is set to.
+	ContextKey any
+	// Value returns the value of the field, instead of ContextKey. request is the tool
+	// call as received from the MCP SDK, like ToolInfo.Request, for attributes of the
+	// session.
+	Value func(ctx context.Context, request any) (any, error)
+}
+
+// WithFieldBindings binds request fields to values of the MCP session. Values are
+// converted like tool arguments, so a string binds to a string, an enum or a
+// google.protobuf.Timestamp field. Calls fail if there is no value for a field.
+func WithFieldBindings(bindings ...FieldBinding) Option {
+	return func(c *config) {
+		c.FieldBindings = append(c.FieldBindings, bindings...)
+	}
+}
+
+// BindFieldsToTool returns a copy of the tool without the bound fields in its input
+// schema. clientStreaming reports whether the schema lists the request messages under
+// "messages".
+func BindFieldsToTool(tool mcp.Tool, bindings []FieldBinding, clientStreaming bool) mcp.Tool {
+	modifiedSchema, ok := removeBoundFields(tool.RawInputSchema, bindings, clientStreaming)
+	if !ok {
+		return tool
+	}
+
+	modifiedTool := tool
+	modifiedTool.RawInputSchema = modifiedSchema
+	return modifiedTool
+}
+
+// removeBoundFields removes the bound fields from a JSON schema. It reports false if
+// the schema could not be modified.
+func removeBoundFields(rawSchema json.RawMessage, bindings []FieldBinding, clientStreaming bool) (json.RawMessage, bool) {
+	if len(bindings) == 0 {
+		return nil, false
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(rawSchema, &schema); err != nil {
+		return nil, false
+	}
+
+	message := schema
+	if clientStreaming {
+		messages, _ := schemaProperty(schema, schema, "messages")
+		message, _ = messages["items"].(map[string]any)
+	}
+	for _, binding := range bindings {
+		removeSchemaField(schema, message, strings.Split(binding.Path, "."))
+	}
+
+	modifiedSchema, err := json.Marshal(schema)
+	if err != nil {
+		return nil, false
+	}
+	return json.RawMessage(modifiedSchema), true
+}
+
+// removeSchemaField removes the field at path from an object schema. References are
+// resolved against the "$defs" of root, and replaced by a copy of the definition, so
+// other uses of the same message keep the field.
+func removeSchemaField(root, schema map[string]any, path []string) {
+	schema = inlineRef(root, schema)
+	if len(path) > 1 {
+		if nested, ok := schemaProperty(root, schema, path[0]); ok {
+			removeSchemaField(root, nested, path[1:])
+		}
+		return
+	}
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return
+	}
+	delete(properties, path[0])
+	if required := dialect.StringList(schema["required"]); required != nil {
+		schema["required"] = slices.DeleteFunc(required, func(name string) bool { return name == path[0] })
+	}
+	removeOneofGroup(schema, path[0])
+}
+
+// removeOneofGroup removes the "anyOf" entry of the oneof containing a bound field.
+// The bound value replaces any other field of the oneof, so none of them can be set.
+func removeOneofGroup(schema map[string]any, name string) {
+	anyOf, ok := schema["anyOf"].([]any)
+	if !ok {
+		return
+	}
+	anyOf = slices.DeleteFunc(anyOf, func(entry any) bool {
+		group, _ := entry.(map[string]any)
+		alternatives, _ := group["oneOf"].([]any)
+		return slices.ContainsFunc(alternatives, func(alternative any) bool {
+			alt, _ := alternative.(map[string]any)
+			properties, _ := alt["properties"].(map[string]any)
+			_, ok := properties[name]
+			return ok
+		})
+	})
+	if len(anyOf) == 0 {
+		delete(schema, "anyOf")
+		return
+	}
+	schema["anyOf"] = anyOf
+}
+
+// schemaProperty returns the schema of a property of an object schema.
+func schemaProperty(root, schema map[string]any, name string) (map[string]any, bool) {
+	properties, ok := resolveRef(root, schema)["properties"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	property, ok := properties[name].(map[string]any)
+	return property, ok
+}
+
+// inlineRef replaces a reference of a schema by a copy of the definition. Keywords
+// next to the reference, such as the description of the field, are kept.
+func inlineRef(root, schema map[string]any) map[string]any {
+	if _, ok := schema["$ref"].(string); !ok {
+		return schema
+	}
+	def := dialect.CloneSchema(resolveRef(root, schema))
+	if _, ok := def["$ref"]; ok {
+		return schema
+	}
+	delete(def, "$defs")
+	delete(schema, "$ref")
+	for keyword, value := range def {
+		if _, ok := schema[keyword]; !ok {
+			schema[keyword] = value
+		}
+	}
+	return schema
+}
+
+// resolveRef returns the definition a "#/$defs/..." reference points to, or the
+// schema itself.
+func resolveRef(root, schema map[string]any) map[string]any {
+	ref, ok := schema["$ref"].(string)
+	if !ok {
+		return schema
+	}
+	if ref == "#" {
+		return root
+	}
+	defs, _ := root["$defs"].(map[string]any)
+	if def, ok := defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any); ok {
+		return def
+	}
+	return schema
+}
+
+// BindFields sets the bound fields of a request. request is the tool call as received
+// from the MCP SDK. Missing values are returned as a FAILED_PRECONDITION status error.
+func BindFields(ctx context.Context, c *config, request any, msg proto.Message) error {
+	for _, binding := range c.FieldBindings {
+		if err := binding.bind(ctx, request, msg.ProtoReflect()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BindStreamFields sets the bound fields of the requests of a client-streaming or
+// bidirectional call.
+func BindStreamFields[T any, PT interface {
+	*T
+	proto.Message
+}](ctx context.Context, c *config, request any, requests []*T) error {
+	for _, req := range requests {
+		if err := BindFields(ctx, c, request, PT(req)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bind sets the bound field of msg, if it has the field.
+func (b FieldBinding) bind(ctx context.Context, request any, msg protoreflect.Message) error {
+	parent, fd := b.field(msg)
+	if fd == nil {
+		return nil
+	}
+
+	var value any
+	if b.Value != nil {
+		var err error
+		if value, err = b.Value(ctx, request); err != nil {
+			return err
+		}
+	} else if b.ContextKey != nil {
+		value = ctx.Value(b.ContextKey)
+	}
+	if value == nil {
+		return status.Errorf(codes.FailedPrecondition, "no value for bound field %q", b.Path)
+	}
+
+	// The value is decoded like a tool argument, into a message of the same type.
+	marshaled, err := json.Marshal(map[string]any{string(fd.Name()): value})
+	if err != nil {
+		return status.Errorf(codes.Internal, "bound field %q: %v", b.Path, err)
+	}
+	decoded := parent.New()
+	if err := protojson.Unmarshal(marshaled, decoded.Interface()); err != nil {
+		return status.Errorf(codes.Internal, "bound field %q: %v", b.Path, err)
+	}
+	parent.Set(fd, decoded.Get(fd))
+	return nil
+}
+
+// field returns the message containing the bound field, and the field, or a nil field
+// if msg has no field at the path. Messages on the path are created as needed.
+func (b FieldBinding) field(msg protoreflect.Message) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	// Resolve the whole path first, so nothing is created for a missing field.
+	var fds []protoreflect.FieldDescriptor
+	md := msg.Descriptor()
+	for _, name := range strings.Split(b.Path, ".") {
+		if md == nil {
+			return nil, nil
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(name)
+		}
+		if fd == nil {
+			return nil, nil
+		}
+		fds = append(fds, fd)
+		md = nil
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+
+	for _, fd := range fds[:len(fds)-1] {
+		msg = msg.Mutable(fd).Message()
+	}
+	return msg, fds[len(fds)-1]
+}
@@ -0,0 +1,229 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBindFieldsToTool(t *testing.T) {
+	item := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"parent": map[string]any{"type": "string"},
+			"name":   map[string]any{"type": "string"},
+			"item": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"id":   map[string]any{"type": "string"},
+					"name": map[string]any{"type": "string"},
+				},
+				"required": []any{"id"},
+			},
+		},
+		"required": []any{"parent", "name"},
+	}
+
+	schemaOf := func(g *WithT, schema map[string]any, bindings []FieldBinding, clientStreaming bool) map[string]any {
+		raw, err := json.Marshal(schema)
+		g.Expect(err).ToNot(HaveOccurred())
+		tool := BindFieldsToTool(mcp.Tool{Name: "test", RawInputSchema: raw}, bindings, clientStreaming)
+		var result map[string]any
+		g.Expect(json.Unmarshal(tool.RawInputSchema, &result)).To(Succeed())
+		return result
+	}
+
+	t.Run("top-level field", func(t *testing.T) {
+		g := NewWithT(t)
+
+		schema := schemaOf(g, item, []FieldBinding{{Path: "parent"}}, false)
+		g.Expect(schema["properties"]).ToNot(HaveKey("parent"))
+		g.Expect(schema["properties"]).To(HaveKey("name"))
+		g.Expect(schema["required"]).To(Equal([]any{"name"}))
+	})
+
+	t.Run("nested field", func(t *testing.T) {
+		g := NewWithT(t)
+
+		schema := schemaOf(g, item, []FieldBinding{{Path: "item.id"}, {Path: "missing.id"}}, false)
+		nested := schema["properties"].(map[string]any)["item"].(map[string]any)
+		g.Expect(nested["properties"]).To(Equal(map[string]any{"name": map[string]any{"type": "string"}}))
+		g.Expect(nested["required"]).To(BeEmpty())
+		g.Expect(schema["required"]).To(Equal([]any{"parent", "name"}))
+	})
+
+	t.Run("referenced message", func(t *testing.T) {
+		g := NewWithT(t)
+
+		schema := schemaOf(g, map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"item":  map[string]any{"$ref": "#/$defs/Item", "description": "The item"},
+				"other": map[string]any{"$ref": "#/$defs/Item"},
+			},
+			"$defs": map[string]any{
+				"Item": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"id":   map[string]any{"type": "string"},
+						"name": map[string]any{"type": "string"},
+					},
+					"required": []any{"id"},
+				},
+			},
+		}, []FieldBinding{{Path: "item.id"}}, false)
+		properties := schema["properties"].(map[string]any)
+		g.Expect(properties["item"]).To(Equal(map[string]any{
+			"type":        "object",
+			"description": "The item",
+			"properties":  map[string]any{"name": map[string]any{"type": "string"}},
+			"required":    []any{},
+		}))
+		// The other field of the same type is unchanged
+		g.Expect(properties["other"]).To(Equal(map[string]any{"$ref": "#/$defs/Item"}))
+		g.Expect(schema["$defs"].(map[string]any)["Item"].(map[string]any)["properties"]).To(HaveKey("id"))
+	})
+
+	t.Run("oneof member", func(t *testing.T) {
+		g := NewWithT(t)
+
+		alternative := func(name string) map[string]any {
+			return map[string]any{
+				"properties": map[string]any{name: map[string]any{"type": "string"}},
+				"required":   []any{name},
+			}
+		}
+		schema := schemaOf(g, map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"name": map[string]any{"type": "string"}},
+			"anyOf": []any{
+				map[string]any{"oneOf": []any{alternative("project_id"), alternative("organization_id")}},
+				map[string]any{"oneOf": []any{alternative("email"), alternative("phone")}},
+			},
+		}, []FieldBinding{{Path: "project_id"}}, false)
+		g.Expect(schema["anyOf"]).To(Equal([]any{
+			map[string]any{"oneOf": []any{alternative("email"), alternative("phone")}},
+		}))
+
+		schema = schemaOf(g, schema, []FieldBinding{{Path: "phone"}}, false)
+		g.Expect(schema).ToNot(HaveKey("anyOf"))
+		g.Expect(schema["properties"]).To(HaveKey("name"))
+	})
+
+	t.Run("client streaming", func(t *testing.T) {
+		g := NewWithT(t)
+
+		schema := schemaOf(g, map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"messages": map[string]any{"type": "array", "items": item},
+			},
+			"required": []any{"messages"},
+		}, []FieldBinding{{Path: "parent"}}, true)
+		messages := schema["properties"].(map[string]any)["messages"].(map[string]any)
+		g.Expect(messages["items"].(map[string]any)["properties"]).ToNot(HaveKey("parent"))
+		g.Expect(schema["required"]).To(Equal([]any{"messages"}))
+	})
+
+	t.Run("no bindings", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tool := mcp.Tool{Name: "test", RawInputSchema: json.RawMessage(`{"type":"object"}`)}
+		g.Expect(BindFieldsToTool(tool, nil, false)).To(Equal(tool))
+	})
+}
+
+type tenantKey struct{}
+
+func TestBindFields(t *testing.T) {
+	t.Run("context value overwrites arguments", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := validateTestConfig(WithFieldBindings(FieldBinding{Path: "name", ContextKey: tenantKey{}}))
+		ctx := context.WithValue(context.Background(), tenantKey{}, "tenant-1")
+		req := &testdata.CreateItemRequest{Name: "spoofed"}
+		g.Expect(BindFields(ctx, c, mcp.CallToolRequest{}, req)).To(Succeed())
+		g.Expect(req.GetName()).To(Equal("tenant-1"))
+	})
+
+	t.Run("value function", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var received any
+		c := validateTestConfig(WithFieldBindings(FieldBinding{
+			Path: "item.created_at",
+			Value: func(ctx context.Context, request any) (any, error) {
+				received = request
+				return "2025-01-02T03:04:05Z", nil
+			},
+		}))
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "test"
+		msg := &testdata.GetItemResponse{}
+		g.Expect(BindFields(context.Background(), c, request, msg)).To(Succeed())
+		g.Expect(received).To(Equal(request))
+		g.Expect(msg.GetItem().GetCreatedAt().AsTime()).To(Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)))
+	})
+
+	t.Run("requests without the field", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := validateTestConfig(WithFieldBindings(FieldBinding{Path: "item.project", ContextKey: tenantKey{}}))
+		msg := &testdata.GetItemResponse{}
+		g.Expect(BindFields(context.Background(), c, mcp.CallToolRequest{}, msg)).To(Succeed())
+		g.Expect(msg.Item).To(BeNil())
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := validateTestConfig(WithFieldBindings(FieldBinding{Path: "name", ContextKey: tenantKey{}}))
+		err := BindFields(context.Background(), c, mcp.CallToolRequest{}, &testdata.CreateItemRequest{Name: "spoofed"})
+		g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+	})
+
+	t.Run("value function error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		failure := errors.New("no session")
+		c := validateTestConfig(WithFieldBindings(FieldBinding{
+			Path:  "name",
+			Value: func(context.Context, any) (any, error) { return nil, failure },
+		}))
+		err := BindFields(context.Background(), c, mcp.CallToolRequest{}, &testdata.CreateItemRequest{})
+		g.Expect(err).To(MatchError(failure))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := validateTestConfig(WithFieldBindings(FieldBinding{Path: "name", ContextKey: tenantKey{}}))
+		ctx := context.WithValue(context.Background(), tenantKey{}, "tenant-1")
+		reqs := []*testdata.CreateItemRequest{{Name: "a"}, {}}
+		g.Expect(BindStreamFields(ctx, c, mcp.CallToolRequest{}, reqs)).To(Succeed())
+		g.Expect(reqs[0].GetName()).To(Equal("tenant-1"))
+		g.Expect(reqs[1].GetName()).To(Equal("tenant-1"))
+	})
+}
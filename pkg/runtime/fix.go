@@ -15,81 +15,169 @@ package runtime
 
 import (
 	"encoding/json"
+	"strconv"
 
+	"github.com/statico/protoc-gen-go-mcp/internal/dialect"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // LLMProvider represents different LLM providers for runtime selection
-type LLMProvider string
+type LLMProvider = dialect.LLMProvider
 
 const (
-	LLMProviderStandard LLMProvider = "standard"
-	LLMProviderOpenAI   LLMProvider = "openai"
+	LLMProviderStandard = dialect.LLMProviderStandard
+	LLMProviderOpenAI   = dialect.LLMProviderOpenAI
+	LLMProviderGemini   = dialect.LLMProviderGemini
+	// LLMProviderAnthropic uses the standard schemas, Anthropic accepts full JSON Schema.
+	LLMProviderAnthropic = dialect.LLMProviderAnthropic
+	// LLMProviderCustom uses the standard schemas rewritten with WithSchemaTransforms.
+	LLMProviderCustom = dialect.LLMProviderCustom
 )
 
+// Fix converts the arguments of a tool call with the schema of the given provider back
+// to standard protobuf-compatible JSON.
+func Fix(provider LLMProvider, descriptor protoreflect.MessageDescriptor, args map[string]any) {
+	switch provider {
+	case LLMProviderOpenAI:
+		FixOpenAI(descriptor, args)
+	case LLMProviderGemini:
+		FixGemini(descriptor, args)
+	case LLMProviderCustom:
+		fixArguments(descriptor, args)
+	}
+}
+
 // FixOpenAI applies all OpenAI compatibility transformations to convert OpenAI-formatted JSON
 // back to standard protobuf-compatible JSON. This includes:
 // - Converting map arrays back to objects
-// - Converting string representations back to proper JSON for google.protobuf.Value/ListValue/Struct
+// - Converting string representations back to proper JSON for google.protobuf.Value/ListValue/Struct/Any
+//
+// Both are applied at any depth, in nested messages, repeated fields and map values.
 func FixOpenAI(descriptor protoreflect.MessageDescriptor, args map[string]any) {
-	var rewrite func(msg protoreflect.MessageDescriptor, path []string, obj map[string]any)
+	fixArguments(descriptor, args)
+}
 
-	rewrite = func(msg protoreflect.MessageDescriptor, path []string, obj map[string]any) {
-		for i := 0; i < msg.Fields().Len(); i++ {
-			field := msg.Fields().Get(i)
-			name := string(field.Name())
-			currentPath := append(path, name)
+// FixGemini applies all Gemini compatibility transformations to convert Gemini-formatted
+// JSON back to standard protobuf-compatible JSON. Gemini schemas use the same encoding
+// of maps and well-known types as OpenAI schemas.
+func FixGemini(descriptor protoreflect.MessageDescriptor, args map[string]any) {
+	fixArguments(descriptor, args)
+}
 
-			if field.IsMap() {
-				// Handle map conversion (from array-of-key-value-pairs to object)
-				if arr, ok := obj[name].([]any); ok {
-					m := make(map[string]any)
-					for _, e := range arr {
-						if pair, ok := e.(map[string]any); ok {
-							k, kOk := pair["key"].(string)
-							v, vOk := pair["value"]
-							if kOk && vOk {
-								m[k] = v
-							}
-						}
-					}
-					obj[name] = m
-				}
-			} else if field.Kind() == protoreflect.MessageKind {
-				fullName := string(field.Message().FullName())
+// fixArguments reverts the MapsToKVArrays and WKTAsString schema transforms. Nested
+// messages are rewritten at any depth, including the elements of repeated fields and
+// the values of maps.
+func fixArguments(descriptor protoreflect.MessageDescriptor, args map[string]any) {
+	fixMessage(descriptor, args)
+}
 
-				// Handle OpenAI string representations of special protobuf types
-				switch fullName {
-				case "google.protobuf.Value":
-					if str, ok := obj[name].(string); ok {
-						var value any
-						if err := json.Unmarshal([]byte(str), &value); err == nil {
-							obj[name] = value
-						}
-					}
-				case "google.protobuf.ListValue":
-					if str, ok := obj[name].(string); ok {
-						var listValue []any
-						if err := json.Unmarshal([]byte(str), &listValue); err == nil {
-							obj[name] = listValue
-						}
-					}
-				case "google.protobuf.Struct":
-					if str, ok := obj[name].(string); ok {
-						var structValue map[string]any
-						if err := json.Unmarshal([]byte(str), &structValue); err == nil {
-							obj[name] = structValue
-						}
-					}
-				default:
-					// Recursively process nested messages
-					if nested, ok := obj[name].(map[string]any); ok {
-						rewrite(field.Message(), currentPath, nested)
-					}
-				}
+func fixMessage(md protoreflect.MessageDescriptor, obj map[string]any) {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		// protojson accepts both the proto name and the JSON name of a field.
+		names := []string{string(fd.Name())}
+		if fd.JSONName() != names[0] {
+			names = append(names, fd.JSONName())
+		}
+		for _, name := range names {
+			if value, ok := obj[name]; ok {
+				obj[name] = fixField(fd, value)
 			}
 		}
 	}
+}
+
+func fixField(fd protoreflect.FieldDescriptor, value any) any {
+	switch {
+	case fd.IsMap():
+		if arr, ok := value.([]any); ok {
+			value = kvArrayToMap(arr)
+		}
+		if m, ok := value.(map[string]any); ok {
+			for k, v := range m {
+				m[k] = fixValue(fd.MapValue(), v)
+			}
+		}
+		return value
+	case fd.IsList():
+		if arr, ok := value.([]any); ok {
+			for i, elem := range arr {
+				arr[i] = fixValue(fd, elem)
+			}
+		}
+		return value
+	default:
+		return fixValue(fd, value)
+	}
+}
+
+// fixValue rewrites a single value of a message field.
+func fixValue(fd protoreflect.FieldDescriptor, value any) any {
+	if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+		return value
+	}
+	md := fd.Message()
+	if !isFreeForm(md.FullName()) {
+		if nested, ok := value.(map[string]any); ok {
+			fixMessage(md, nested)
+		}
+		return value
+	}
+	// Handle the string representations of free-form well-known types. Strings that
+	// do not parse are left unchanged, a string is a valid google.protobuf.Value.
+	str, ok := value.(string)
+	if !ok {
+		return value
+	}
+	var parsed any
+	if err := json.Unmarshal([]byte(str), &parsed); err != nil {
+		return value
+	}
+	switch md.FullName() {
+	case "google.protobuf.ListValue":
+		if _, ok := parsed.([]any); !ok {
+			return value
+		}
+	case "google.protobuf.Struct", "google.protobuf.Any":
+		if _, ok := parsed.(map[string]any); !ok {
+			return value
+		}
+	}
+	return parsed
+}
+
+// isFreeForm reports whether the JSON of a well-known type is free-form, so it is
+// encoded as a string by WKTAsString.
+func isFreeForm(name protoreflect.FullName) bool {
+	switch name {
+	case "google.protobuf.Value", "google.protobuf.ListValue", "google.protobuf.Struct", "google.protobuf.Any":
+		return true
+	}
+	return false
+}
 
-	rewrite(descriptor, nil, args)
+// kvArrayToMap converts an array of key value pairs back to an object. Keys of integer
+// and bool maps may be sent as numbers and booleans.
+func kvArrayToMap(arr []any) map[string]any {
+	m := make(map[string]any, len(arr))
+	for _, e := range arr {
+		pair, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		v, ok := pair["value"]
+		if !ok {
+			continue
+		}
+		switch k := pair["key"].(type) {
+		case string:
+			m[k] = v
+		case float64:
+			m[strconv.FormatFloat(k, 'f', -1, 64)] = v
+		case bool:
+			m[strconv.FormatBool(k)] = v
+		}
+	}
+	return m
 }
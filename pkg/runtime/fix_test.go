@@ -165,3 +165,187 @@ func TestFixOpenAINonMapField(t *testing.T) {
 	err = protojson.Unmarshal(fixedJSON, descriptor)
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestFixOpenAINested(t *testing.T) {
+	kv := func(key, value any) map[string]any {
+		return map[string]any{"key": key, "value": value}
+	}
+
+	tests := []struct {
+		name     string
+		message  proto.Message
+		input    map[string]any
+		expected map[string]any
+	}{
+		{
+			name:    "maps inside repeated messages",
+			message: new(testdata.NestedMapTestMessage),
+			input: map[string]any{
+				"maps": []any{
+					map[string]any{"string_map": []any{kv("a", "1")}},
+					map[string]any{"string_map": []any{}},
+				},
+			},
+			expected: map[string]any{
+				"maps": []any{
+					map[string]any{"string_map": map[string]any{"a": "1"}},
+					map[string]any{"string_map": map[string]any{}},
+				},
+			},
+		},
+		{
+			name:    "maps inside map values",
+			message: new(testdata.NestedMapTestMessage),
+			input: map[string]any{
+				"map_messages": []any{
+					kv("first", map[string]any{"string_map": []any{kv("b", "2")}}),
+				},
+			},
+			expected: map[string]any{
+				"map_messages": map[string]any{
+					"first": map[string]any{"string_map": map[string]any{"b": "2"}},
+				},
+			},
+		},
+		{
+			name:    "well-known types inside repeated messages",
+			message: new(testdata.NestedMapTestMessage),
+			input: map[string]any{
+				"wkts": []any{
+					map[string]any{
+						"struct_field": `{"a": 1}`,
+						"list_value":   `[1, "two"]`,
+						"any":          `{"@type": "type.googleapis.com/google.protobuf.Duration", "value": "1s"}`,
+					},
+				},
+			},
+			expected: map[string]any{
+				"wkts": []any{
+					map[string]any{
+						"struct_field": map[string]any{"a": float64(1)},
+						"list_value":   []any{float64(1), "two"},
+						"any":          map[string]any{"@type": "type.googleapis.com/google.protobuf.Duration", "value": "1s"},
+					},
+				},
+			},
+		},
+		{
+			name:    "well-known types as map values and list elements",
+			message: new(testdata.NestedMapTestMessage),
+			input: map[string]any{
+				"values":  []any{kv("number", "42"), kv("text", "plain text")},
+				"structs": []any{`{"a": true}`, map[string]any{"b": false}},
+			},
+			expected: map[string]any{
+				"values":  map[string]any{"number": float64(42), "text": "plain text"},
+				"structs": []any{map[string]any{"a": true}, map[string]any{"b": false}},
+			},
+		},
+		{
+			name:    "non-string map keys",
+			message: new(testdata.NestedMapTestMessage),
+			input: map[string]any{
+				"int_map": []any{kv(float64(7), "seven"), kv("8", "eight")},
+			},
+			expected: map[string]any{
+				"int_map": map[string]any{"7": "seven", "8": "eight"},
+			},
+		},
+		{
+			name:    "json names",
+			message: new(testdata.NestedMapTestMessage),
+			input: map[string]any{
+				"mapMessages": []any{kv("first", map[string]any{"stringMap": []any{kv("c", "3")}})},
+			},
+			expected: map[string]any{
+				"mapMessages": map[string]any{"first": map[string]any{"stringMap": map[string]any{"c": "3"}}},
+			},
+		},
+		{
+			name:    "recursive messages",
+			message: new(testdata.TreeNode),
+			input: map[string]any{
+				"children": []any{
+					map[string]any{
+						"labeled_children": []any{
+							kv("leaf", map[string]any{"children": []any{map[string]any{"name": "deep", "labeled_children": []any{}}}}),
+						},
+					},
+				},
+			},
+			expected: map[string]any{
+				"children": []any{
+					map[string]any{
+						"labeled_children": map[string]any{
+							"leaf": map[string]any{"children": []any{map[string]any{"name": "deep", "labeled_children": map[string]any{}}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			FixOpenAI(tt.message.ProtoReflect().Descriptor(), tt.input)
+			g.Expect(tt.input).To(Equal(tt.expected))
+
+			fixedJSON, err := json.Marshal(tt.input)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(protojson.Unmarshal(fixedJSON, tt.message)).To(Succeed())
+		})
+	}
+}
+
+func TestFixGemini(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]any
+		expected map[string]any
+	}{
+		{
+			name:     "any from string",
+			input:    map[string]any{"payload": `{"@type":"type.googleapis.com/testdata.Item","id":"item-1"}`},
+			expected: map[string]any{"payload": map[string]any{"@type": "type.googleapis.com/testdata.Item", "id": "item-1"}},
+		},
+		{
+			name:     "any object remains unchanged",
+			input:    map[string]any{"payload": map[string]any{"@type": "type.googleapis.com/testdata.Item"}},
+			expected: map[string]any{"payload": map[string]any{"@type": "type.googleapis.com/testdata.Item"}},
+		},
+		{
+			name:     "struct from string",
+			input:    map[string]any{"metadata": `{"key":"value"}`},
+			expected: map[string]any{"metadata": map[string]any{"key": "value"}},
+		},
+		{
+			name:     "null values remain unchanged",
+			input:    map[string]any{"timestamp": nil},
+			expected: map[string]any{"timestamp": nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			msg := new(testdata.ProcessWellKnownTypesRequest)
+			Fix(LLMProviderGemini, msg.ProtoReflect().Descriptor(), tt.input)
+			g.Expect(tt.input).To(Equal(tt.expected))
+
+			fixedJSON, err := json.Marshal(tt.input)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(protojson.Unmarshal(fixedJSON, msg)).To(Succeed())
+		})
+	}
+
+	t.Run("openai parses any strings", func(t *testing.T) {
+		g := NewWithT(t)
+
+		input := map[string]any{"payload": `{"@type":"type.googleapis.com/testdata.Item"}`}
+		FixOpenAI(new(testdata.ProcessWellKnownTypesRequest).ProtoReflect().Descriptor(), input)
+		g.Expect(input["payload"]).To(Equal(map[string]any{"@type": "type.googleapis.com/testdata.Item"}))
+	})
+}
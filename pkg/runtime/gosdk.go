@@ -0,0 +1,160 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+
+	gosdk "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// The helpers in this file are used by code generated with runtime=gosdk, which
+// registers tools with a *mcp.Server of github.com/modelcontextprotocol/go-sdk.
+
+// ArgumentsGoSDK returns the arguments of a tool call, or nil if they are not a JSON
+// object.
+func ArgumentsGoSDK(request *gosdk.CallToolRequest) map[string]any {
+	if request.Params == nil || len(request.Params.Arguments) == 0 {
+		return nil
+	}
+	var arguments map[string]any
+	if err := json.Unmarshal(request.Params.Arguments, &arguments); err != nil {
+		return nil
+	}
+	return arguments
+}
+
+// BoolPtr returns a pointer to b, for the tool annotation hints of the official Go
+// SDK.
+func BoolPtr(b bool) *bool {
+	return &b
+}
+
+// StructuredResultGoSDK returns a tool result with the marshaled response as text
+// and as structured content.
+func StructuredResultGoSDK(marshaled []byte) *gosdk.CallToolResult {
+	return &gosdk.CallToolResult{
+		Content:           []gosdk.Content{&gosdk.TextContent{Text: string(marshaled)}},
+		StructuredContent: json.RawMessage(marshaled),
+	}
+}
+
+// HandleErrorGoSDK converts a gRPC/Connect error into a structured MCP tool result,
+// like HandleError.
+func HandleErrorGoSDK(err error) (*gosdk.CallToolResult, error) {
+	if err == nil {
+		return nil, nil
+	}
+	return &gosdk.CallToolResult{
+		Content: []gosdk.Content{&gosdk.TextContent{Text: errorText(err)}},
+		IsError: true,
+	}, nil
+}
+
+// AddExtraPropertiesToToolGoSDK returns a copy of the tool with the extra properties
+// added to its input schema, like AddExtraPropertiesToTool.
+func AddExtraPropertiesToToolGoSDK(tool *gosdk.Tool, properties []ExtraProperty, transforms ...SchemaTransform) *gosdk.Tool {
+	if len(properties) == 0 {
+		return tool
+	}
+
+	rawSchema, err := json.Marshal(tool.InputSchema)
+	if err != nil {
+		return tool
+	}
+	modifiedSchema, ok := addExtraProperties(rawSchema, properties, transforms)
+	if !ok {
+		return tool
+	}
+
+	modifiedTool := *tool
+	modifiedTool.InputSchema = modifiedSchema
+	return &modifiedTool
+}
+
+// TransformToolGoSDK returns a copy of the tool with the transforms applied to its
+// input schema.
+func TransformToolGoSDK(tool *gosdk.Tool, transforms []SchemaTransform) *gosdk.Tool {
+	rawSchema, err := json.Marshal(tool.InputSchema)
+	if err != nil {
+		return tool
+	}
+	transformed, ok := transformRawSchema(rawSchema, transforms)
+	if !ok {
+		return tool
+	}
+
+	modifiedTool := *tool
+	modifiedTool.InputSchema = transformed
+	return &modifiedTool
+}
+
+// BindFieldsToToolGoSDK returns a copy of the tool without the bound fields in its
+// input schema, like BindFieldsToTool.
+func BindFieldsToToolGoSDK(tool *gosdk.Tool, bindings []FieldBinding, clientStreaming bool) *gosdk.Tool {
+	rawSchema, err := json.Marshal(tool.InputSchema)
+	if err != nil {
+		return tool
+	}
+	modifiedSchema, ok := removeBoundFields(rawSchema, bindings, clientStreaming)
+	if !ok {
+		return tool
+	}
+
+	modifiedTool := *tool
+	modifiedTool.InputSchema = modifiedSchema
+	return &modifiedTool
+}
+
+// InterceptGoSDK calls handler through the configured interceptors.
+func InterceptGoSDK[Res any](ctx context.Context, c *config, request *gosdk.CallToolRequest, fullMethod string, req any, handler func(ctx context.Context, req any) (Res, error)) (Res, error) {
+	if len(c.Interceptors) == 0 {
+		return handler(ctx, req)
+	}
+	info := &ToolInfo{FullMethod: fullMethod, Arguments: ArgumentsGoSDK(request), Request: request}
+	if request.Params != nil {
+		info.Name = request.Params.Name
+	}
+	return intercept(ctx, c, info, req, handler)
+}
+
+// NewStreamCollectorGoSDK creates a collector for the given tool call.
+func NewStreamCollectorGoSDK(ctx context.Context, request *gosdk.CallToolRequest, c *config) *StreamCollector {
+	collector := &StreamCollector{limit: c.StreamMessageLimit}
+	if request.Params == nil || request.Params.GetProgressToken() == nil || request.Session == nil {
+		return collector
+	}
+
+	progressToken := request.Params.GetProgressToken()
+	collector.progress = func(progress int, message string) {
+		// Progress is best effort, a client that went away must not fail the call.
+		_ = request.Session.NotifyProgress(ctx, &gosdk.ProgressNotificationParams{
+			ProgressToken: progressToken,
+			Progress:      float64(progress),
+			Message:       message,
+		})
+	}
+	return collector
+}
+
+// ResultGoSDK returns the aggregated tool result, like Result.
+func (c *StreamCollector) ResultGoSDK() (*gosdk.CallToolResult, error) {
+	marshaled, err := c.marshalResult()
+	if err != nil {
+		return nil, err
+	}
+	return StructuredResultGoSDK(marshaled), nil
+}
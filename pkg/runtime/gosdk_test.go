@@ -0,0 +1,72 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	gosdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestArgumentsGoSDK(t *testing.T) {
+	g := NewWithT(t)
+
+	request := &gosdk.CallToolRequest{Params: &gosdk.CallToolParamsRaw{Arguments: json.RawMessage(`{"name":"widget"}`)}}
+	g.Expect(ArgumentsGoSDK(request)).To(Equal(map[string]any{"name": "widget"}))
+
+	request.Params.Arguments = json.RawMessage(`[1, 2]`)
+	g.Expect(ArgumentsGoSDK(request)).To(BeNil())
+
+	request.Params.Arguments = nil
+	g.Expect(ArgumentsGoSDK(request)).To(BeNil())
+}
+
+func TestHandleErrorGoSDK(t *testing.T) {
+	g := NewWithT(t)
+
+	result, err := HandleErrorGoSDK(status.Error(codes.NotFound, "item not found"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsError).To(BeTrue())
+	g.Expect(result.Content).To(HaveLen(1))
+	g.Expect(result.Content[0].(*gosdk.TextContent).Text).To(ContainSubstring("item not found"))
+
+	result, err = HandleErrorGoSDK(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(BeNil())
+}
+
+func TestAddExtraPropertiesToToolGoSDK(t *testing.T) {
+	g := NewWithT(t)
+
+	tool := &gosdk.Tool{
+		Name:        "test_tool",
+		InputSchema: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"required":["name"]}`),
+	}
+	modified := AddExtraPropertiesToToolGoSDK(tool, []ExtraProperty{
+		{Name: "api_url", Description: "API base URL", Required: true},
+	})
+	g.Expect(modified).ToNot(BeIdenticalTo(tool), "the original tool must not be modified")
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(modified.InputSchema.(json.RawMessage), &schema)).To(Succeed())
+	g.Expect(schema["properties"]).To(HaveKey("api_url"))
+	g.Expect(schema["required"]).To(ConsistOf("name", "api_url"))
+
+	g.Expect(AddExtraPropertiesToToolGoSDK(tool, nil)).To(BeIdenticalTo(tool))
+}
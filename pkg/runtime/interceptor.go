@@ -0,0 +1,87 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ToolInfo describes an intercepted tool call.
+type ToolInfo struct {
+	// Name is the name of the called tool.
+	Name string
+	// FullMethod is the full RPC method name, such as "/package.Service/Method".
+	FullMethod string
+	// Arguments are the arguments of the tool call.
+	Arguments map[string]any
+	// Request is the tool call as received from the MCP SDK: a mcp.CallToolRequest of
+	// mark3labs/mcp-go, or a *mcp.CallToolRequest of the official Go SDK.
+	Request any
+}
+
+// ToolHandler calls the RPC behind a tool.
+type ToolHandler func(ctx context.Context, req any) (any, error)
+
+// ToolInterceptor intercepts tool calls, like grpc.UnaryServerInterceptor does for
+// RPCs. req is the decoded request message, or a slice of request messages for
+// client-streaming and bidirectional methods. resp is the response message, and nil
+// for server-streaming and bidirectional methods, whose messages are collected into
+// the tool result. The interceptor is responsible for calling handler to complete
+// the call.
+type ToolInterceptor func(ctx context.Context, req any, info *ToolInfo, handler ToolHandler) (resp any, err error)
+
+// WithInterceptors adds interceptors to every tool call, for concerns like
+// authorization, logging or rate limiting. The first interceptor is the outermost
+// one.
+func WithInterceptors(interceptors ...ToolInterceptor) Option {
+	return func(c *config) {
+		c.Interceptors = append(c.Interceptors, interceptors...)
+	}
+}
+
+// Intercept calls handler through the configured interceptors.
+func Intercept[Res any](ctx context.Context, c *config, request mcp.CallToolRequest, fullMethod string, req any, handler func(ctx context.Context, req any) (Res, error)) (Res, error) {
+	if len(c.Interceptors) == 0 {
+		return handler(ctx, req)
+	}
+	info := &ToolInfo{Name: request.Params.Name, FullMethod: fullMethod, Arguments: request.GetArguments(), Request: request}
+	return intercept(ctx, c, info, req, handler)
+}
+
+func intercept[Res any](ctx context.Context, c *config, info *ToolInfo, req any, handler func(ctx context.Context, req any) (Res, error)) (Res, error) {
+	var zero Res
+	chained := ToolHandler(func(ctx context.Context, req any) (any, error) {
+		return handler(ctx, req)
+	})
+	for i := len(c.Interceptors) - 1; i >= 0; i-- {
+		interceptor, next := c.Interceptors[i], chained
+		chained = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	resp, err := chained(ctx, req)
+	if err != nil || resp == nil {
+		return zero, err
+	}
+	typed, ok := resp.(Res)
+	if !ok {
+		return zero, fmt.Errorf("interceptor returned a response of type %T, expected %T", resp, zero)
+	}
+	return typed, nil
+}
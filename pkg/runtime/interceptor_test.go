@@ -0,0 +1,101 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestIntercept(t *testing.T) {
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "get_item"
+	handler := func(ctx context.Context, req any) (*testdata.GetItemResponse, error) {
+		return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.(*testdata.GetItemRequest).GetId()}}, nil
+	}
+
+	t.Run("without interceptors", func(t *testing.T) {
+		g := NewWithT(t)
+		resp, err := Intercept(context.Background(), NewConfig(), request, "/testdata.TestService/GetItem", &testdata.GetItemRequest{Id: "a"}, handler)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.GetItem().GetId()).To(Equal("a"))
+	})
+
+	t.Run("chain order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var calls []string
+		record := func(name string) ToolInterceptor {
+			return func(ctx context.Context, req any, info *ToolInfo, handler ToolHandler) (any, error) {
+				g.Expect(info.Name).To(Equal("get_item"))
+				g.Expect(info.FullMethod).To(Equal("/testdata.TestService/GetItem"))
+				calls = append(calls, name+" before")
+				resp, err := handler(ctx, req)
+				g.Expect(resp).To(BeAssignableToTypeOf(&testdata.GetItemResponse{}))
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+
+		c := NewConfig()
+		WithInterceptors(record("first"), record("second"))(c)
+		_, err := Intercept(context.Background(), c, request, "/testdata.TestService/GetItem", &testdata.GetItemRequest{Id: "a"}, handler)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(calls).To(Equal([]string{"first before", "second before", "second after", "first after"}))
+	})
+
+	t.Run("replace request", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewConfig()
+		WithInterceptors(func(ctx context.Context, req any, info *ToolInfo, handler ToolHandler) (any, error) {
+			return handler(ctx, &testdata.GetItemRequest{Id: "replaced"})
+		})(c)
+		resp, err := Intercept(context.Background(), c, request, "/testdata.TestService/GetItem", &testdata.GetItemRequest{Id: "a"}, handler)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.GetItem().GetId()).To(Equal("replaced"))
+	})
+
+	t.Run("short circuit", func(t *testing.T) {
+		g := NewWithT(t)
+
+		denied := errors.New("permission denied")
+		c := NewConfig()
+		WithInterceptors(func(ctx context.Context, req any, info *ToolInfo, handler ToolHandler) (any, error) {
+			return nil, denied
+		})(c)
+		_, err := Intercept(context.Background(), c, request, "/testdata.TestService/GetItem", &testdata.GetItemRequest{}, func(ctx context.Context, req any) (*testdata.GetItemResponse, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+		g.Expect(err).To(MatchError(denied))
+	})
+
+	t.Run("response of the wrong type", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewConfig()
+		WithInterceptors(func(ctx context.Context, req any, info *ToolInfo, handler ToolHandler) (any, error) {
+			return "not a response", nil
+		})(c)
+		_, err := Intercept(context.Background(), c, request, "/testdata.TestService/GetItem", &testdata.GetItemRequest{}, handler)
+		g.Expect(err).To(MatchError(ContainSubstring("interceptor returned a response of type string")))
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/mark3labs/mcp-go/mcp"
+	gosdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataForwarder selects the parts of a tool call that are forwarded to the backend
+// of the ForwardTo...Client functions, as gRPC metadata or as Connect request headers.
+// Only the listed names are forwarded.
+type MetadataForwarder struct {
+	// Headers are the HTTP headers of the MCP transport to forward under their own
+	// name, such as "Authorization" or "Mcp-Session-Id". Names are case-insensitive.
+	Headers []string
+	// Meta are the keys of the "_meta" field of tool calls to forward.
+	Meta []string
+	// ExtraProperties are the names of extra properties to forward, see
+	// WithExtraProperties. The values extracted by ExtractExtraProperties are
+	// forwarded, with defaults applied and decoded.
+	ExtraProperties []string
+	// Prefix is prepended to the metadata keys of Meta and ExtraProperties, so clients
+	// cannot set metadata that looks like a header, such as "x-mcp-".
+	Prefix string
+}
+
+// WithMetadataForwarder forwards the allowed headers, "_meta" fields and extra
+// properties of tool calls to the backend. Values that are not strings are forwarded
+// as JSON.
+func WithMetadataForwarder(forwarder MetadataForwarder) Option {
+	return func(c *config) {
+		c.MetadataForwarder = &forwarder
+	}
+}
+
+// ForwardMetadata adds the forwarded metadata of a tool call to the outgoing gRPC
+// metadata of ctx. Extra properties are read from ctx, it must be the context returned
+// by ExtractExtraProperties.
+func ForwardMetadata(ctx context.Context, c *config, request mcp.CallToolRequest) context.Context {
+	if c.MetadataForwarder == nil {
+		return ctx
+	}
+	var meta map[string]any
+	if request.Params.Meta != nil {
+		meta = request.Params.Meta.AdditionalFields
+	}
+	return c.MetadataForwarder.forward(ctx, request.Header, meta, c.ExtraProperties)
+}
+
+// ForwardMetadataGoSDK adds the forwarded metadata of a tool call to the outgoing gRPC
+// metadata of ctx, like ForwardMetadata.
+func ForwardMetadataGoSDK(ctx context.Context, c *config, request *gosdk.CallToolRequest) context.Context {
+	if c.MetadataForwarder == nil {
+		return ctx
+	}
+	var header http.Header
+	if request.Extra != nil {
+		header = request.Extra.Header
+	}
+	var meta map[string]any
+	if request.Params != nil {
+		meta = request.Params.GetMeta()
+	}
+	return c.MetadataForwarder.forward(ctx, header, meta, c.ExtraProperties)
+}
+
+// SetConnectHeaders copies the outgoing gRPC metadata of ctx into the headers of a
+// Connect request, so forwarded metadata reaches Connect backends as well.
+func SetConnectHeaders(ctx context.Context, header http.Header) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return
+	}
+	for key, values := range md {
+		for _, value := range values {
+			if strings.HasSuffix(key, "-bin") {
+				value = connect.EncodeBinaryHeader([]byte(value))
+			}
+			header.Add(key, value)
+		}
+	}
+}
+
+func (f *MetadataForwarder) forward(ctx context.Context, header http.Header, meta map[string]any, properties []ExtraProperty) context.Context {
+	var kv []string
+	for _, name := range f.Headers {
+		for _, value := range header.Values(name) {
+			kv = append(kv, metadataKey(name), value)
+		}
+	}
+	for _, key := range f.Meta {
+		if value, ok := meta[key]; ok {
+			kv = append(kv, metadataKey(f.Prefix+key), metadataValue(value))
+		}
+	}
+	for _, prop := range properties {
+		if !slices.Contains(f.ExtraProperties, prop.Name) {
+			continue
+		}
+		if value := ctx.Value(prop.ContextKey); value != nil {
+			kv = append(kv, metadataKey(f.Prefix+prop.Name), metadataValue(value))
+		}
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// metadataKey turns a name into a valid metadata key: lower case, with characters
+// other than letters, digits, "-", "_" and "." replaced by "-".
+func metadataKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		}
+		return '-'
+	}, name)
+}
+
+func metadataValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	marshaled, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(marshaled)
+}
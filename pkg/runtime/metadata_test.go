@@ -0,0 +1,130 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/mark3labs/mcp-go/mcp"
+	gosdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestForwardMetadata(t *testing.T) {
+	request := mcp.CallToolRequest{Header: http.Header{}}
+	request.Header.Set("Authorization", "Bearer token")
+	request.Header.Set("Mcp-Session-Id", "session-1")
+	request.Header.Set("Cookie", "secret")
+	request.Params.Meta = &mcp.Meta{AdditionalFields: map[string]any{
+		"tenant":            "acme",
+		"example.com/trace": map[string]any{"id": 1},
+		"other":             "ignored",
+	}}
+	arguments := map[string]any{"user_id": "u1", "name": "widget"}
+
+	t.Run("without forwarder", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := ForwardMetadata(context.Background(), NewConfig(), request)
+		_, ok := metadata.FromOutgoingContext(ctx)
+		g.Expect(ok).To(BeFalse())
+	})
+
+	t.Run("allowlist", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewConfig()
+		WithExtraProperties(
+			ExtraProperty{Name: "user_id", ContextKey: "user_id"},
+			ExtraProperty{Name: "name", ContextKey: "name"},
+		)(c)
+		WithMetadataForwarder(MetadataForwarder{
+			Headers:         []string{"authorization", "Mcp-Session-Id", "X-Missing"},
+			Meta:            []string{"tenant", "example.com/trace"},
+			ExtraProperties: []string{"user_id"},
+			Prefix:          "x-mcp-",
+		})(c)
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "existing", "kept")
+		ctx, err := ExtractExtraProperties(ctx, c, arguments)
+		g.Expect(err).ToNot(HaveOccurred())
+		md, ok := metadata.FromOutgoingContext(ForwardMetadata(ctx, c, request))
+		g.Expect(ok).To(BeTrue())
+		g.Expect(md).To(Equal(metadata.MD{
+			"existing":                {"kept"},
+			"authorization":           {"Bearer token"},
+			"mcp-session-id":          {"session-1"},
+			"x-mcp-tenant":            {"acme"},
+			"x-mcp-example.com-trace": {`{"id":1}`},
+			"x-mcp-user_id":           {"u1"},
+		}))
+	})
+
+	t.Run("decoded extra properties", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewConfig()
+		WithExtraProperties(
+			ExtraProperty{Name: "limit", ContextKey: "limit", Schema: map[string]any{"type": "integer"}, Default: 50},
+			ExtraProperty{Name: "region", ContextKey: "region", Decode: func(value any) (any, error) {
+				return strings.ToUpper(value.(string)), nil
+			}},
+		)(c)
+		WithMetadataForwarder(MetadataForwarder{ExtraProperties: []string{"limit", "region"}})(c)
+
+		ctx, err := ExtractExtraProperties(context.Background(), c, map[string]any{"region": "eu"})
+		g.Expect(err).ToNot(HaveOccurred())
+		md, _ := metadata.FromOutgoingContext(ForwardMetadata(ctx, c, request))
+		g.Expect(md).To(Equal(metadata.MD{"limit": {"50"}, "region": {"EU"}}))
+	})
+}
+
+func TestForwardMetadataGoSDK(t *testing.T) {
+	g := NewWithT(t)
+
+	c := NewConfig()
+	WithMetadataForwarder(MetadataForwarder{Headers: []string{"Authorization"}, Meta: []string{"tenant"}})(c)
+	request := &gosdk.CallToolRequest{
+		Params: &gosdk.CallToolParamsRaw{Meta: gosdk.Meta{"tenant": "acme"}},
+		Extra:  &gosdk.RequestExtra{Header: http.Header{"Authorization": {"Bearer token"}}},
+	}
+
+	md, _ := metadata.FromOutgoingContext(ForwardMetadataGoSDK(context.Background(), c, request))
+	g.Expect(md).To(Equal(metadata.MD{"authorization": {"Bearer token"}, "tenant": {"acme"}}))
+
+	// Calls without transport are forwarded without headers
+	md, _ = metadata.FromOutgoingContext(ForwardMetadataGoSDK(context.Background(), c, &gosdk.CallToolRequest{Params: &gosdk.CallToolParamsRaw{}}))
+	g.Expect(md).To(BeEmpty())
+}
+
+func TestSetConnectHeaders(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token", "trace-bin", "\x01\x02")
+	header := http.Header{}
+	SetConnectHeaders(ctx, header)
+	g.Expect(header.Get("Authorization")).To(Equal("Bearer token"))
+
+	decoded, err := connect.DecodeBinaryHeader(header.Get("Trace-Bin"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(decoded).To(Equal([]byte{1, 2}))
+
+	// Without outgoing metadata, nothing is set
+	header = http.Header{}
+	SetConnectHeaders(context.Background(), header)
+	g.Expect(header).To(BeEmpty())
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ListAllBudget limits the merged list returned by the tools that follow the pages of
+// AIP-158 list methods, see the list_all_tools option of protoc-gen-go-mcp. Pages are
+// never split, as page tokens only continue after a complete page: MaxItems bounds
+// the page size of every request, but the first page is always returned in full, even
+// if it exceeds MaxBytes.
+type ListAllBudget struct {
+	// MaxItems is the maximum number of items. Zero means the default.
+	MaxItems int
+	// MaxBytes is the maximum size of the items marshaled to JSON. Zero means the
+	// default.
+	MaxBytes int
+}
+
+// DefaultListAllBudget is the budget of list-all tools unless configured otherwise.
+var DefaultListAllBudget = ListAllBudget{MaxItems: 1000, MaxBytes: 256 << 10}
+
+// WithListAllBudget sets the budget of list-all tools.
+func WithListAllBudget(budget ListAllBudget) Option {
+	return func(c *config) {
+		c.ListAllBudget = budget
+	}
+}
+
+// ListAll calls an AIP-158 list method until there are no more pages, or the next page
+// would exceed the budget. A copy of the first response is returned with the items of
+// all pages in itemsField, and the page token to continue with as next_page_token,
+// which is empty if all items were listed. The first page is always returned in full,
+// even if it exceeds the byte budget, see ListAllBudget.
+func ListAll[Req, Res proto.Message](ctx context.Context, c *config, req Req, itemsField protoreflect.Name, list func(ctx context.Context, req Req) (Res, error)) (Res, error) {
+	var zero Res
+	budget := c.ListAllBudget
+	if budget.MaxItems <= 0 {
+		budget.MaxItems = DefaultListAllBudget.MaxItems
+	}
+	if budget.MaxBytes <= 0 {
+		budget.MaxBytes = DefaultListAllBudget.MaxBytes
+	}
+
+	reqFields := req.ProtoReflect().Descriptor().Fields()
+	pageSizeFd, pageTokenFd := reqFields.ByName("page_size"), reqFields.ByName("page_token")
+	pageSize := req.ProtoReflect().Get(pageSizeFd).Int()
+	token := req.ProtoReflect().Get(pageTokenFd).String()
+
+	var merged Res
+	var items protoreflect.List
+	var nextFd protoreflect.FieldDescriptor
+	count, size := 0, 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		// Pages are no larger than the remaining items of the budget
+		page := proto.Clone(req).(Req)
+		page.ProtoReflect().Set(pageTokenFd, protoreflect.ValueOfString(token))
+		if remaining := int64(budget.MaxItems - count); pageSize <= 0 || pageSize > remaining {
+			page.ProtoReflect().Set(pageSizeFd, protoreflect.ValueOfInt32(int32(min(remaining, math.MaxInt32))))
+		}
+		resp, err := list(ctx, page)
+		if err != nil {
+			return zero, err
+		}
+
+		msg := resp.ProtoReflect()
+		pageItems := msg.Get(msg.Descriptor().Fields().ByName(itemsField)).List()
+		pageBytes, err := listSize(pageItems)
+		if err != nil {
+			return zero, err
+		}
+		if items == nil {
+			// The response might be kept by the caller of the list method, such as a cache.
+			merged = proto.Clone(resp).(Res)
+			items = merged.ProtoReflect().Mutable(msg.Descriptor().Fields().ByName(itemsField)).List()
+			nextFd = msg.Descriptor().Fields().ByName("next_page_token")
+		} else {
+			if count+pageItems.Len() > budget.MaxItems || size+pageBytes > budget.MaxBytes {
+				// The page is left for the next call, which starts with its token.
+				merged.ProtoReflect().Set(nextFd, protoreflect.ValueOfString(token))
+				return merged, nil
+			}
+			for i := 0; i < pageItems.Len(); i++ {
+				items.Append(pageItems.Get(i))
+			}
+		}
+		count += pageItems.Len()
+		size += pageBytes
+
+		next := msg.Get(nextFd).String()
+		merged.ProtoReflect().Set(nextFd, protoreflect.ValueOfString(next))
+		// A server returning the token it was called with would be followed forever.
+		if next == "" || next == token || count >= budget.MaxItems || size >= budget.MaxBytes {
+			return merged, nil
+		}
+		token = next
+	}
+}
+
+// listSize returns the size of the items of a list marshaled to JSON.
+func listSize(list protoreflect.List) (int, error) {
+	size := 0
+	for i := 0; i < list.Len(); i++ {
+		var marshaled []byte
+		var err error
+		if msg, ok := list.Get(i).Interface().(protoreflect.Message); ok {
+			marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(msg.Interface())
+		} else {
+			marshaled, err = json.Marshal(list.Get(i).Interface())
+		}
+		if err != nil {
+			return 0, err
+		}
+		size += len(marshaled)
+	}
+	return size, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// shelfPages serves shelves 0 to total-1 in pages, with the offset as page token.
+type shelfPages struct {
+	total     int
+	pageSizes []int32
+}
+
+func (p *shelfPages) list(ctx context.Context, req *testdata.ListShelvesRequest) (*testdata.ListShelvesResponse, error) {
+	p.pageSizes = append(p.pageSizes, req.GetPageSize())
+	offset := 0
+	if req.GetPageToken() != "" {
+		var err error
+		if offset, err = strconv.Atoi(req.GetPageToken()); err != nil {
+			return nil, err
+		}
+	}
+	// The server returns at most 10 shelves per page
+	end := min(offset+int(min(req.GetPageSize(), 10)), p.total)
+	resp := &testdata.ListShelvesResponse{TotalSize: int32(p.total)}
+	for i := offset; i < end; i++ {
+		resp.Shelves = append(resp.Shelves, &testdata.Shelf{Name: fmt.Sprintf("shelves/%d", i)})
+	}
+	if end < p.total {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func shelfNames(resp *testdata.ListShelvesResponse) []string {
+	var names []string
+	for _, shelf := range resp.GetShelves() {
+		names = append(names, shelf.GetName())
+	}
+	return names
+}
+
+func TestListAll(t *testing.T) {
+	// Every shelf is about 30 bytes of JSON, {"name":"shelves/N","theme":""}.
+	t.Run("all pages", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pages := &shelfPages{total: 25}
+		resp, err := ListAll(context.Background(), NewConfig(), &testdata.ListShelvesRequest{}, "shelves", pages.list)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.GetShelves()).To(HaveLen(25))
+		g.Expect(resp.GetShelves()[24].GetName()).To(Equal("shelves/24"))
+		g.Expect(resp.GetNextPageToken()).To(BeEmpty())
+		g.Expect(resp.GetTotalSize()).To(Equal(int32(25)))
+		g.Expect(pages.pageSizes).To(HaveLen(3))
+	})
+
+	t.Run("item budget", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pages := &shelfPages{total: 25}
+		c := NewConfig()
+		WithListAllBudget(ListAllBudget{MaxItems: 14})(c)
+		resp, err := ListAll(context.Background(), c, &testdata.ListShelvesRequest{PageSize: 10}, "shelves", pages.list)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.GetShelves()).To(HaveLen(14))
+		g.Expect(resp.GetNextPageToken()).To(Equal("14"))
+		// The last page only asks for the remaining items
+		g.Expect(pages.pageSizes).To(Equal([]int32{10, 4}))
+	})
+
+	t.Run("byte budget", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pages := &shelfPages{total: 25}
+		c := NewConfig()
+		WithListAllBudget(ListAllBudget{MaxBytes: 400})(c)
+		resp, err := ListAll(context.Background(), c, &testdata.ListShelvesRequest{}, "shelves", pages.list)
+		g.Expect(err).ToNot(HaveOccurred())
+		// The second page does not fit, it is listed by the next call
+		g.Expect(shelfNames(resp)).To(HaveLen(10))
+		g.Expect(resp.GetNextPageToken()).To(Equal("10"))
+	})
+
+	t.Run("first page exceeding the budget", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pages := &shelfPages{total: 25}
+		c := NewConfig()
+		WithListAllBudget(ListAllBudget{MaxBytes: 10})(c)
+		resp, err := ListAll(context.Background(), c, &testdata.ListShelvesRequest{}, "shelves", pages.list)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.GetShelves()).To(HaveLen(10))
+		g.Expect(resp.GetNextPageToken()).To(Equal("10"))
+	})
+
+	t.Run("responses are not modified", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pages := &shelfPages{total: 25}
+		var first *testdata.ListShelvesResponse
+		resp, err := ListAll(context.Background(), NewConfig(), &testdata.ListShelvesRequest{}, "shelves", func(ctx context.Context, req *testdata.ListShelvesRequest) (*testdata.ListShelvesResponse, error) {
+			resp, err := pages.list(ctx, req)
+			if first == nil {
+				first = resp
+			}
+			return resp, err
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.GetShelves()).To(HaveLen(25))
+		g.Expect(first.GetShelves()).To(HaveLen(10))
+		g.Expect(first.GetNextPageToken()).To(Equal("10"))
+	})
+
+	t.Run("continuation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pages := &shelfPages{total: 25}
+		resp, err := ListAll(context.Background(), NewConfig(), &testdata.ListShelvesRequest{PageToken: "20"}, "shelves", pages.list)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(shelfNames(resp)).To(Equal([]string{"shelves/20", "shelves/21", "shelves/22", "shelves/23", "shelves/24"}))
+		g.Expect(resp.GetNextPageToken()).To(BeEmpty())
+	})
+
+	t.Run("repeated page token", func(t *testing.T) {
+		g := NewWithT(t)
+
+		calls := 0
+		resp, err := ListAll(context.Background(), NewConfig(), &testdata.ListShelvesRequest{PageToken: "a"}, "shelves", func(ctx context.Context, req *testdata.ListShelvesRequest) (*testdata.ListShelvesResponse, error) {
+			calls++
+			return &testdata.ListShelvesResponse{NextPageToken: "a"}, nil
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(calls).To(Equal(1))
+		g.Expect(resp.GetNextPageToken()).To(Equal("a"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		failure := errors.New("unavailable")
+		pages := &shelfPages{total: 25}
+		_, err := ListAll(context.Background(), NewConfig(), &testdata.ListShelvesRequest{}, "shelves", func(ctx context.Context, req *testdata.ListShelvesRequest) (*testdata.ListShelvesResponse, error) {
+			if req.GetPageToken() != "" {
+				return nil, failure
+			}
+			return pages.list(ctx, req)
+		})
+		g.Expect(err).To(MatchError(failure))
+	})
+}
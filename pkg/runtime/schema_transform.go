@@ -0,0 +1,110 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/statico/protoc-gen-go-mcp/internal/dialect"
+)
+
+// SchemaTransform is a named rewrite of a JSON schema. The generator emits one
+// standard schema per tool and derives the schemas of the provider variants with
+// transforms, see SchemaTransforms. The same transforms can be combined to build a
+// custom dialect at runtime, see WithSchemaTransforms.
+type SchemaTransform = dialect.SchemaTransform
+
+// SchemaDialect describes the subset of JSON Schema an LLM provider accepts.
+type SchemaDialect = dialect.SchemaDialect
+
+var (
+	// InlineRefs replaces "$ref" references with copies of the referenced schemas.
+	// Recursive schemas are inlined into each other at most twice along any path,
+	// whichever messages recurse, deeper levels can only be null.
+	InlineRefs = dialect.InlineRefs
+	// FlattenOneofs turns the oneOf groups of protobuf oneofs into nullable properties.
+	FlattenOneofs = dialect.FlattenOneofs
+	// MapsToKVArrays turns maps, objects with additionalProperties, into arrays of key
+	// value pairs.
+	MapsToKVArrays = dialect.MapsToKVArrays
+	// WKTAsString encodes free-form JSON values as JSON strings. These are the schemas
+	// of google.protobuf.Struct, Value, ListValue and Any.
+	WKTAsString = dialect.WKTAsString
+	// AllRequired marks every property as required and forbids additional properties.
+	// Optional object properties become nullable, so they can still be left unset.
+	AllRequired = dialect.AllRequired
+)
+
+var (
+	// OpenAIDialect is the JSON Schema subset of OpenAI structured outputs.
+	OpenAIDialect = dialect.OpenAIDialect
+	// GeminiDialect is the OpenAPI 3.0 subset of Gemini function declarations.
+	GeminiDialect = dialect.GeminiDialect
+)
+
+// StripUnsupportedKeywords removes the keywords a dialect does not support. Removed
+// constraints are added to the description instead.
+func StripUnsupportedKeywords(d SchemaDialect) SchemaTransform {
+	return dialect.StripUnsupportedKeywords(d)
+}
+
+// SchemaTransforms returns the transforms turning a standard schema into the schema
+// of the given provider.
+func SchemaTransforms(provider LLMProvider) []SchemaTransform {
+	return dialect.SchemaTransforms(provider)
+}
+
+// TransformSchema applies the transforms to the schema in order.
+func TransformSchema(schema map[string]any, transforms ...SchemaTransform) map[string]any {
+	return dialect.TransformSchema(schema, transforms...)
+}
+
+// WithSchemaTransforms rewrites the input schemas of the standard tools with the given
+// transforms. Arguments are converted back before they are decoded, like for the
+// provider variants.
+func WithSchemaTransforms(transforms ...SchemaTransform) Option {
+	return func(c *config) {
+		c.SchemaTransforms = append(c.SchemaTransforms, transforms...)
+	}
+}
+
+// TransformTool applies the transforms to the input schema of a tool.
+func TransformTool(tool mcp.Tool, transforms []SchemaTransform) mcp.Tool {
+	transformed, ok := transformRawSchema(tool.RawInputSchema, transforms)
+	if !ok {
+		return tool
+	}
+	modifiedTool := tool
+	modifiedTool.RawInputSchema = transformed
+	return modifiedTool
+}
+
+// transformRawSchema applies the transforms to a JSON schema. It reports false if the
+// schema could not be modified.
+func transformRawSchema(raw json.RawMessage, transforms []SchemaTransform) (json.RawMessage, bool) {
+	if len(transforms) == 0 {
+		return nil, false
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, false
+	}
+	marshaled, err := json.Marshal(TransformSchema(schema, transforms...))
+	if err != nil {
+		return nil, false
+	}
+	return json.RawMessage(marshaled), true
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+)
+
+func TestTransformTool(t *testing.T) {
+	g := NewWithT(t)
+
+	tool := mcp.Tool{
+		Name:           "test_tool",
+		RawInputSchema: json.RawMessage(`{"type": "object", "properties": {"labels": {"type": "object", "additionalProperties": {"type": "string"}}}}`),
+	}
+
+	transformed := TransformTool(tool, SchemaTransforms(LLMProviderGemini))
+	g.Expect(transformed.Name).To(Equal("test_tool"))
+	g.Expect(transformed.RawInputSchema).To(MatchJSON(`{"type": "object", "properties": {"labels": {
+		"type": "array", "description": "List of key value pairs",
+		"items": {"type": "object", "required": ["key", "value"], "properties": {"key": {"type": "string"}, "value": {"type": "string"}}}
+	}}}`))
+
+	// The original tool is not modified.
+	g.Expect(tool.RawInputSchema).To(ContainSubstring("additionalProperties"))
+	g.Expect(TransformTool(tool, nil)).To(Equal(tool))
+}
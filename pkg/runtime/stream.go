@@ -0,0 +1,339 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamCollector gathers the messages of a streaming RPC for a single tool call.
+// Every message is forwarded to the MCP client as a progress notification (if the
+// client asked for progress), and the collected messages become the tool result.
+type StreamCollector struct {
+	progress func(progress int, message string)
+	limit    int
+
+	messages []json.RawMessage
+	received int
+}
+
+// NewStreamCollector creates a collector for the given tool call.
+func NewStreamCollector(ctx context.Context, request mcp.CallToolRequest, c *config) *StreamCollector {
+	collector := &StreamCollector{limit: c.StreamMessageLimit}
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return collector
+	}
+
+	progressToken := request.Params.Meta.ProgressToken
+	collector.progress = func(progress int, message string) {
+		if srv := mcpserver.ServerFromContext(ctx); srv != nil {
+			// Progress is best effort, a client that went away must not fail the call.
+			_ = srv.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+				"progressToken": progressToken,
+				"progress":      progress,
+				"message":       message,
+			})
+		}
+	}
+	return collector
+}
+
+// Add records a streamed message and emits a progress notification for it.
+func (c *StreamCollector) Add(msg proto.Message) error {
+	marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	c.received++
+	c.messages = append(c.messages, marshaled)
+	if c.limit > 0 && len(c.messages) > c.limit {
+		c.messages = c.messages[len(c.messages)-c.limit:]
+	}
+
+	if c.progress != nil {
+		c.progress(c.received, string(marshaled))
+	}
+	return nil
+}
+
+// Result returns the aggregated tool result. If a message limit is configured, only
+// the last messages are included and the number of dropped messages is reported.
+func (c *StreamCollector) Result() (*mcp.CallToolResult, error) {
+	marshaled, err := c.marshalResult()
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+}
+
+func (c *StreamCollector) marshalResult() ([]byte, error) {
+	result := map[string]any{
+		"messages": c.messages,
+	}
+	if dropped := c.received - len(c.messages); dropped > 0 {
+		result["dropped_messages"] = dropped
+	}
+	if c.messages == nil {
+		result["messages"] = []json.RawMessage{}
+	}
+	return json.Marshal(result)
+}
+
+// ReceiveAll reads from recv until the stream ends, adding every message to the collector.
+func ReceiveAll[T proto.Message](c *StreamCollector, recv func() (T, error)) error {
+	for {
+		msg, err := recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := c.Add(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// SendAll sends all requests in order. A stream closed by the server (io.EOF) is not
+// treated as an error here, the actual status is returned when receiving.
+func SendAll[T any](requests []T, send func(T) error) error {
+	for _, req := range requests {
+		if err := send(req); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Exchange drives a bidirectional stream: it sends all requests and closes the send
+// side, while concurrently receiving responses into the collector. cancel must cancel
+// the context of the stream, it is called if sending fails so that recv returns
+// instead of waiting for responses the server might never send. The send error is
+// returned in that case.
+func Exchange[Req any, Res proto.Message](c *StreamCollector, requests []Req, send func(Req) error, closeSend func() error, recv func() (Res, error), cancel func()) error {
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- ReceiveAll(c, recv)
+	}()
+
+	sendErr := SendAll(requests, send)
+	if sendErr == nil {
+		sendErr = closeSend()
+	}
+	if sendErr != nil {
+		cancel()
+		<-recvErr
+		return sendErr
+	}
+	return <-recvErr
+}
+
+// UnmarshalStreamRequests decodes the "messages" argument of a client-streaming or
+// bidirectional streaming tool call into request messages, converting them from the
+// schema of the given provider. Failures are returned as *DecodeError.
+func UnmarshalStreamRequests[T any, PT interface {
+	*T
+	proto.Message
+}](arguments map[string]any, provider LLMProvider) ([]*T, error) {
+	messages, err := UnmarshalStreamMessages(arguments, provider, func() proto.Message { return PT(new(T)) })
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]*T, 0, len(messages))
+	for _, msg := range messages {
+		requests = append(requests, (*T)(msg.(PT)))
+	}
+	return requests, nil
+}
+
+// UnmarshalStreamMessages is UnmarshalStreamRequests for request messages created by
+// newMessage, such as dynamicpb messages.
+func UnmarshalStreamMessages(arguments map[string]any, provider LLMProvider, newMessage func() proto.Message) ([]proto.Message, error) {
+	raw, ok := arguments["messages"]
+	if !ok {
+		return nil, &DecodeError{
+			Field:    "messages",
+			Expected: "array of objects",
+			Message:  "missing required argument \"messages\"",
+		}
+	}
+	elems, ok := raw.([]any)
+	if !ok {
+		return nil, &DecodeError{
+			Field:    "messages",
+			Expected: "array of objects",
+			Received: raw,
+			Message:  fmt.Sprintf("argument \"messages\" must be an array, got %T", raw),
+		}
+	}
+
+	requests := make([]proto.Message, 0, len(elems))
+	for i, elem := range elems {
+		message, ok := elem.(map[string]any)
+		if !ok {
+			return nil, &DecodeError{
+				Field:    fmt.Sprintf("messages[%d]", i),
+				Expected: "object",
+				Received: elem,
+				Message:  fmt.Sprintf("messages[%d] must be an object, got %T", i, elem),
+			}
+		}
+
+		req := newMessage()
+		Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := UnmarshalArguments(message, req); err != nil {
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				return nil, err
+			}
+			if decodeErr.Field != "" {
+				return nil, newDecodeError(fmt.Sprintf("messages[%d].%s", i, decodeErr.Field), decodeErr.Expected, decodeErr.Received)
+			}
+			return nil, &DecodeError{
+				Field:   fmt.Sprintf("messages[%d]", i),
+				Message: fmt.Sprintf("messages[%d]: %s", i, decodeErr.Message),
+			}
+		}
+		requests = append(requests, req)
+	}
+	return requests, nil
+}
+
+// ServerStream adapts a StreamCollector to grpc.ServerStreamingServer, so in-process
+// server-streaming implementations can be called directly from a tool handler.
+type ServerStream[T any] struct {
+	ctx       context.Context
+	collector *StreamCollector
+}
+
+// NewServerStream creates a ServerStream that sends into the collector.
+func NewServerStream[T any](ctx context.Context, collector *StreamCollector) *ServerStream[T] {
+	return &ServerStream[T]{ctx: ctx, collector: collector}
+}
+
+// Send adds a message to the collector.
+func (s *ServerStream[T]) Send(msg *T) error {
+	return s.SendMsg(msg)
+}
+
+// SendMsg adds a message to the collector.
+func (s *ServerStream[T]) SendMsg(m any) error {
+	if s.collector == nil {
+		return errors.New("stream does not accept multiple responses")
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return errors.New("stream message is not a proto.Message")
+	}
+	return s.collector.Add(msg)
+}
+
+// RecvMsg is not supported on a server stream.
+func (s *ServerStream[T]) RecvMsg(any) error {
+	return io.EOF
+}
+
+// Context returns the tool call context.
+func (s *ServerStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+// SetHeader is a no-op, there are no headers in MCP.
+func (s *ServerStream[T]) SetHeader(metadata.MD) error {
+	return nil
+}
+
+// SendHeader is a no-op, there are no headers in MCP.
+func (s *ServerStream[T]) SendHeader(metadata.MD) error {
+	return nil
+}
+
+// SetTrailer is a no-op, there are no trailers in MCP.
+func (s *ServerStream[T]) SetTrailer(metadata.MD) {}
+
+// ClientStream adapts a list of requests to grpc.ClientStreamingServer and
+// grpc.BidiStreamingServer, so in-process client-streaming and bidirectional
+// implementations can be called directly from a tool handler. Responses sent on a
+// bidirectional stream go to the collector.
+type ClientStream[Req, Res any] struct {
+	ServerStream[Res]
+	requests []*Req
+	response *Res
+}
+
+// NewClientStream creates a ClientStream that receives the given requests in order.
+// The collector may be nil for client-streaming methods.
+func NewClientStream[Req, Res any](ctx context.Context, requests []*Req, collector *StreamCollector) *ClientStream[Req, Res] {
+	return &ClientStream[Req, Res]{
+		ServerStream: ServerStream[Res]{ctx: ctx, collector: collector},
+		requests:     requests,
+	}
+}
+
+// Recv returns the next request, or io.EOF once all requests have been received.
+func (s *ClientStream[Req, Res]) Recv() (*Req, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+// RecvMsg receives the next request into m.
+func (s *ClientStream[Req, Res]) RecvMsg(m any) error {
+	dst, ok := m.(proto.Message)
+	if !ok {
+		return errors.New("stream message is not a proto.Message")
+	}
+	req, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	src, ok := any(req).(proto.Message)
+	if !ok {
+		return errors.New("stream message is not a proto.Message")
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, src)
+	return nil
+}
+
+// SendAndClose records the single response of a client-streaming call.
+func (s *ClientStream[Req, Res]) SendAndClose(msg *Res) error {
+	s.response = msg
+	return nil
+}
+
+// Response returns the response passed to SendAndClose.
+func (s *ClientStream[Req, Res]) Response() *Res {
+	return s.response
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func streamResult(g *WithT, c *StreamCollector) map[string]any {
+	result, err := c.Result()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Content).To(HaveLen(1))
+
+	var parsed map[string]any
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &parsed)).To(Succeed())
+	return parsed
+}
+
+func TestStreamCollector(t *testing.T) {
+	tests := []struct {
+		name             string
+		limit            int
+		count            int
+		expectedIDs      []any
+		expectedDropped  any
+		expectDroppedKey bool
+	}{
+		{
+			name:        "empty stream",
+			count:       0,
+			expectedIDs: []any{},
+		},
+		{
+			name:        "no limit keeps all messages",
+			count:       3,
+			expectedIDs: []any{"0", "1", "2"},
+		},
+		{
+			name:        "limit above message count",
+			limit:       5,
+			count:       3,
+			expectedIDs: []any{"0", "1", "2"},
+		},
+		{
+			name:             "limit keeps the last messages",
+			limit:            2,
+			count:            4,
+			expectedIDs:      []any{"2", "3"},
+			expectedDropped:  float64(2),
+			expectDroppedKey: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := NewStreamCollector(context.Background(), mcp.CallToolRequest{}, &config{StreamMessageLimit: tt.limit})
+			stream := NewServerStream[testdata.GetItemResponse](context.Background(), c)
+			for i := range tt.count {
+				id := string(rune('0' + i))
+				g.Expect(stream.Send(&testdata.GetItemResponse{Item: &testdata.Item{Id: id}})).To(Succeed())
+			}
+
+			result := streamResult(g, c)
+			ids := []any{}
+			for _, msg := range result["messages"].([]any) {
+				ids = append(ids, msg.(map[string]any)["item"].(map[string]any)["id"])
+			}
+			g.Expect(ids).To(Equal(tt.expectedIDs))
+			if tt.expectDroppedKey {
+				g.Expect(result["dropped_messages"]).To(Equal(tt.expectedDropped))
+			} else {
+				g.Expect(result).ToNot(HaveKey("dropped_messages"))
+			}
+		})
+	}
+}
+
+func TestReceiveAll(t *testing.T) {
+	g := NewWithT(t)
+
+	recvFrom := func(msgs []*testdata.GetItemResponse, final error) func() (*testdata.GetItemResponse, error) {
+		return func() (*testdata.GetItemResponse, error) {
+			if len(msgs) == 0 {
+				return nil, final
+			}
+			msg := msgs[0]
+			msgs = msgs[1:]
+			return msg, nil
+		}
+	}
+	msgs := []*testdata.GetItemResponse{
+		{Item: &testdata.Item{Id: "a"}},
+		{Item: &testdata.Item{Id: "b"}},
+	}
+
+	// io.EOF ends the stream without an error
+	c := NewStreamCollector(context.Background(), mcp.CallToolRequest{}, NewConfig())
+	g.Expect(ReceiveAll(c, recvFrom(msgs, io.EOF))).To(Succeed())
+	g.Expect(streamResult(g, c)["messages"]).To(HaveLen(2))
+
+	// Any other error is returned to the caller
+	streamErr := errors.New("connection reset")
+	c = NewStreamCollector(context.Background(), mcp.CallToolRequest{}, NewConfig())
+	g.Expect(ReceiveAll(c, recvFrom(msgs, streamErr))).To(MatchError(streamErr))
+	g.Expect(streamResult(g, c)["messages"]).To(HaveLen(2))
+}
+
+func TestUnmarshalStreamRequests(t *testing.T) {
+	tests := []struct {
+		name        string
+		arguments   map[string]any
+		expectedIDs []string
+		expectedErr string
+	}{
+		{
+			name: "messages are decoded in order",
+			arguments: map[string]any{"messages": []any{
+				map[string]any{"id": "a"},
+				map[string]any{"id": "b"},
+			}},
+			expectedIDs: []string{"a", "b"},
+		},
+		{
+			name:        "empty messages",
+			arguments:   map[string]any{"messages": []any{}},
+			expectedIDs: []string{},
+		},
+		{
+			name:        "missing messages",
+			arguments:   map[string]any{},
+			expectedErr: `missing required argument "messages"`,
+		},
+		{
+			name:        "messages is not an array",
+			arguments:   map[string]any{"messages": "a"},
+			expectedErr: `argument "messages" must be an array, got string`,
+		},
+		{
+			name:        "element is not an object",
+			arguments:   map[string]any{"messages": []any{"a"}},
+			expectedErr: "messages[0] must be an object, got string",
+		},
+		{
+			name:        "element does not match the request type",
+			arguments:   map[string]any{"messages": []any{map[string]any{"id": 1}}},
+			expectedErr: "invalid value for messages[0].id: expected string, got 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			reqs, err := UnmarshalStreamRequests[testdata.GetItemRequest](tt.arguments, LLMProviderStandard)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			ids := []string{}
+			for _, req := range reqs {
+				ids = append(ids, req.GetId())
+			}
+			g.Expect(ids).To(Equal(tt.expectedIDs))
+		})
+	}
+}
+
+func TestExchange(t *testing.T) {
+	g := NewWithT(t)
+
+	// An unbuffered echo stream only works if sending and receiving run concurrently
+	echo := make(chan *testdata.GetItemRequest)
+	send := func(req *testdata.GetItemRequest) error {
+		echo <- req
+		return nil
+	}
+	closeSend := func() error {
+		close(echo)
+		return nil
+	}
+	recv := func() (*testdata.GetItemResponse, error) {
+		req, ok := <-echo
+		if !ok {
+			return nil, io.EOF
+		}
+		return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.GetId()}}, nil
+	}
+
+	c := NewStreamCollector(context.Background(), mcp.CallToolRequest{}, NewConfig())
+	reqs := []*testdata.GetItemRequest{{Id: "a"}, {Id: "b"}, {Id: "c"}}
+	g.Expect(Exchange(c, reqs, send, closeSend, recv, func() {})).To(Succeed())
+	g.Expect(streamResult(g, c)["messages"]).To(HaveLen(3))
+}
+
+func TestExchangeSendError(t *testing.T) {
+	g := NewWithT(t)
+
+	// The server never responds, recv only returns once the stream is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	send := func(*testdata.GetItemRequest) error {
+		return errors.New("connection reset")
+	}
+	closeSend := func() error {
+		return nil
+	}
+	recv := func() (*testdata.GetItemResponse, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	c := NewStreamCollector(context.Background(), mcp.CallToolRequest{}, NewConfig())
+	reqs := []*testdata.GetItemRequest{{Id: "a"}}
+	done := make(chan error, 1)
+	go func() {
+		done <- Exchange(c, reqs, send, closeSend, recv, cancel)
+	}()
+	g.Eventually(done).Should(Receive(MatchError("connection reset")))
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// WithValidation validates requests with protovalidate before the handler is called.
+// Requests violating their buf.validate rules never reach the backend, instead the
+// tool returns an INVALID_ARGUMENT error with a google.rpc.BadRequest detail listing
+// every violation.
+func WithValidation() Option {
+	return func(c *config) {
+		c.Validate = true
+	}
+}
+
+// Validate validates a request, if validation is enabled. Violations are returned as
+// a gRPC status error, to be converted by HandleError.
+func Validate(c *config, msg proto.Message) error {
+	if !c.Validate {
+		return nil
+	}
+	violations, err := fieldViolations(msg, "")
+	if err != nil {
+		return err
+	}
+	return badRequest(violations)
+}
+
+// ValidateStream validates the requests of a client-streaming or bidirectional call,
+// if validation is enabled. Field paths start with the position of the request in
+// the "messages" argument.
+func ValidateStream[T any, PT interface {
+	*T
+	proto.Message
+}](c *config, requests []*T) error {
+	if !c.Validate {
+		return nil
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, req := range requests {
+		v, err := fieldViolations(PT(req), fmt.Sprintf("messages[%d]", i))
+		if err != nil {
+			return err
+		}
+		violations = append(violations, v...)
+	}
+	return badRequest(violations)
+}
+
+// fieldViolations runs protovalidate and converts its violations. Errors other than
+// violations, such as rules that do not compile, are returned as is.
+func fieldViolations(msg proto.Message, prefix string) ([]*errdetails.BadRequest_FieldViolation, error) {
+	err := protovalidate.Validate(msg)
+	if err == nil {
+		return nil, nil
+	}
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErr.Violations))
+	for _, violation := range validationErr.Violations {
+		field := protovalidate.FieldPathString(violation.Proto.GetField())
+		if prefix != "" {
+			field = joinFieldPath(prefix, field)
+		}
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: violation.Proto.GetMessage(),
+			Reason:      violation.Proto.GetRuleId(),
+		})
+	}
+	return violations, nil
+}
+
+func joinFieldPath(prefix, field string) string {
+	if field == "" {
+		return prefix
+	}
+	return prefix + "." + field
+}
+
+func badRequest(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	st, err := status.New(codes.InvalidArgument, "request validation failed").
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return err
+	}
+	return st.Err()
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"google.golang.org/protobuf/proto"
+)
+
+func validateTestConfig(opts ...Option) *config {
+	c := NewConfig()
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// fieldViolationsOf returns field => reason of the BadRequest detail in a tool error.
+func fieldViolationsOf(g *WithT, result *mcp.CallToolResult) map[string]string {
+	g.Expect(result.IsError).To(BeTrue())
+	var body struct {
+		Code    string `json:"code"`
+		Details []struct {
+			FieldViolations []struct {
+				Field       string `json:"field"`
+				Description string `json:"description"`
+				Reason      string `json:"reason"`
+			} `json:"fieldViolations"`
+		} `json:"details"`
+	}
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &body)).To(Succeed())
+	g.Expect(body.Code).To(Equal("INVALID_ARGUMENT"))
+	g.Expect(body.Details).To(HaveLen(1))
+
+	violations := map[string]string{}
+	for _, v := range body.Details[0].FieldViolations {
+		g.Expect(v.Description).ToNot(BeEmpty())
+		violations[v.Field] = v.Reason
+	}
+	return violations
+}
+
+func TestValidate(t *testing.T) {
+	valid := &testdata.CreateUserRequest{
+		Name:        "Jane",
+		Email:       "jane@example.com",
+		Id:          "9b2f3c1e-8c1a-4c55-9d43-2f1b6c1d2e3f",
+		Website:     "https://example.com",
+		Handle:      "jane",
+		Age:         42,
+		Score:       0.5,
+		Quota:       10,
+		Tags:        []string{"a"},
+		Role:        testdata.Role_ROLE_ADMIN,
+		DefaultRole: testdata.Role_ROLE_VIEWER,
+	}
+	invalid := proto.CloneOf(valid)
+	invalid.Name = ""
+	invalid.Email = "not-an-email"
+	invalid.Age = 200
+	invalid.Tags = []string{"a", "a"}
+
+	t.Run("disabled", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(Validate(validateTestConfig(), invalid)).To(Succeed())
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(Validate(validateTestConfig(WithValidation()), valid)).To(Succeed())
+	})
+
+	t.Run("violations", func(t *testing.T) {
+		g := NewWithT(t)
+		err := Validate(validateTestConfig(WithValidation()), invalid)
+		g.Expect(err).To(HaveOccurred())
+
+		result, err := HandleError(err)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(fieldViolationsOf(g, result)).To(Equal(map[string]string{
+			"name":  "string.min_len",
+			"email": "string.email",
+			"age":   "int32.gte_lt",
+			"tags":  "repeated.unique",
+		}))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		g := NewWithT(t)
+		err := ValidateStream(validateTestConfig(WithValidation()), []*testdata.CreateUserRequest{valid, invalid})
+		g.Expect(err).To(HaveOccurred())
+
+		result, err := HandleError(err)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(fieldViolationsOf(g, result)).To(HaveKeyWithValue("messages[1].email", "string.email"))
+	})
+}
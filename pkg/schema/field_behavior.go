@@ -0,0 +1,66 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"slices"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldBehaviors returns the google.api.field_behavior annotations of a field.
+func fieldBehaviors(fd protoreflect.FieldDescriptor) []annotations.FieldBehavior {
+	if !proto.HasExtension(fd.Options(), annotations.E_FieldBehavior) {
+		return nil
+	}
+	behaviors, _ := proto.GetExtension(fd.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+	return behaviors
+}
+
+// isFieldRequired reports whether a field is required by buf.validate or marked as
+// REQUIRED. OPTIONAL only cancels a REQUIRED field behavior, a buf.validate required
+// rule always wins since the request would be rejected without the field.
+func isFieldRequired(fd protoreflect.FieldDescriptor) bool {
+	if fieldRules(fd).GetRequired() {
+		return true
+	}
+	behaviors := fieldBehaviors(fd)
+	return slices.Contains(behaviors, annotations.FieldBehavior_REQUIRED) &&
+		!slices.Contains(behaviors, annotations.FieldBehavior_OPTIONAL)
+}
+
+// isOutputOnly reports whether a field is set by the server, and left out of input
+// schemas.
+func isOutputOnly(fd protoreflect.FieldDescriptor) bool {
+	return slices.Contains(fieldBehaviors(fd), annotations.FieldBehavior_OUTPUT_ONLY)
+}
+
+// applyBehaviors describes the field behaviors that restrict how the model may set a
+// field of an input schema.
+func applyBehaviors(schema map[string]any, fd protoreflect.FieldDescriptor) {
+	c := &constraints{schema: schema}
+	defer c.done()
+
+	for _, behavior := range fieldBehaviors(fd) {
+		switch behavior {
+		case annotations.FieldBehavior_IDENTIFIER:
+			c.note("Identifies the resource, it is not changed by this call.")
+		case annotations.FieldBehavior_IMMUTABLE:
+			c.note("Immutable, it can only be set when the resource is created.")
+		}
+	}
+}
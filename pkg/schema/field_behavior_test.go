@@ -0,0 +1,81 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/internal/dialect"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// roundTrip returns a schema as decoded from JSON.
+func roundTrip(g *WithT, schema map[string]any) map[string]any {
+	marshaled, err := json.Marshal(schema)
+	g.Expect(err).ToNot(HaveOccurred())
+	var result map[string]any
+	g.Expect(json.Unmarshal(marshaled, &result)).To(Succeed())
+	return result
+}
+
+func TestFieldBehavior(t *testing.T) {
+	meth := testdata.File_testdata_field_behavior_test_proto.Services().Get(0).Methods().ByName("UpdateBook")
+
+	t.Run("input", func(t *testing.T) {
+		g := NewWithT(t)
+
+		input := roundTrip(g, Input(meth))
+		book := input["properties"].(map[string]any)["book"].(map[string]any)
+		properties := book["properties"].(map[string]any)
+
+		// Output-only fields are left out, even if they are also required
+		g.Expect(properties).ToNot(HaveKey("create_time"))
+		g.Expect(properties).ToNot(HaveKey("etag"))
+		author := properties["authors"].(map[string]any)["items"].(map[string]any)
+		g.Expect(author["properties"]).ToNot(HaveKey("uid"))
+		g.Expect(author["properties"]).To(HaveKey("display_name"))
+
+		// buf.validate required wins over an OPTIONAL field behavior
+		g.Expect(book["required"]).To(Equal([]any{"title", "publisher"}))
+		g.Expect(input["required"]).To(Equal([]any{"book"}))
+
+		// Compiled descriptors have no comments, the descriptions are the notes only
+		g.Expect(properties["name"]).To(HaveKeyWithValue("description", "Identifies the resource, it is not changed by this call."))
+		g.Expect(properties["isbn"]).To(HaveKeyWithValue("description", "Immutable, it can only be set when the resource is created."))
+		g.Expect(properties["subtitle"]).ToNot(HaveKey("description"))
+	})
+
+	t.Run("openai input", func(t *testing.T) {
+		g := NewWithT(t)
+
+		input := roundTrip(g, Input(meth, WithProvider(dialect.LLMProviderOpenAI)))
+		book := input["properties"].(map[string]any)["book"].(map[string]any)
+		g.Expect(book["properties"]).ToNot(HaveKey("create_time"))
+		g.Expect(book["required"]).ToNot(ContainElement("create_time"))
+		g.Expect(book["required"]).To(ContainElement("subtitle"))
+	})
+
+	t.Run("output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		output := roundTrip(g, Output(meth))
+		properties := output["properties"].(map[string]any)
+		g.Expect(properties).To(HaveKey("create_time"))
+		g.Expect(properties).To(HaveKey("etag"))
+		g.Expect(properties["isbn"]).ToNot(HaveKey("description"))
+	})
+}
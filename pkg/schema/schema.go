@@ -0,0 +1,576 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema builds the JSON schemas of protobuf messages, as used for the input
+// and output schemas of the generated tools. It works on protoreflect descriptors
+// only, so the generator, the runtime and other tools produce the same schemas.
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/statico/protoc-gen-go-mcp/internal/dialect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Option configures the schemas.
+type Option func(*config)
+
+type config struct {
+	omitDescriptions bool
+	transforms       []dialect.SchemaTransform
+}
+
+// WithoutDescriptions stops proto comments from being emitted as descriptions, which
+// keeps the schemas small.
+func WithoutDescriptions() Option {
+	return func(c *config) {
+		c.omitDescriptions = true
+	}
+}
+
+// WithProvider returns the schemas in the dialect of an LLM provider, by applying the
+// transforms of runtime.SchemaTransforms.
+func WithProvider(provider dialect.LLMProvider) Option {
+	return WithTransforms(dialect.SchemaTransforms(provider)...)
+}
+
+// WithTransforms applies the transforms to the standard schemas, see
+// runtime.WithSchemaTransforms.
+func WithTransforms(transforms ...dialect.SchemaTransform) Option {
+	return func(c *config) {
+		c.transforms = append(c.transforms, transforms...)
+	}
+}
+
+func newBuilder(opts []Option) (*builder, []dialect.SchemaTransform) {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return &builder{omitDescriptions: c.omitDescriptions}, c.transforms
+}
+
+// Message returns the schema of a message. Shared and recursive messages are emitted
+// into "$defs" of the schema.
+func Message(md protoreflect.MessageDescriptor, opts ...Option) map[string]any {
+	b, transforms := newBuilder(opts)
+	return dialect.TransformSchema(b.messageSchema(md), transforms...)
+}
+
+// Field returns the schema of the value of a field.
+func Field(fd protoreflect.FieldDescriptor, opts ...Option) map[string]any {
+	b, transforms := newBuilder(opts)
+	return dialect.TransformSchema(b.getType(fd), transforms...)
+}
+
+// Input returns the input schema of the tool of a method. Client-streaming methods take
+// the request messages as an array. Fields marked as OUTPUT_ONLY with
+// google.api.field_behavior are left out, IDENTIFIER and IMMUTABLE ones are described.
+func Input(meth protoreflect.MethodDescriptor, opts ...Option) map[string]any {
+	b, transforms := newBuilder(opts)
+	b.input = true
+	schema := b.messageSchema(meth.Input())
+	if meth.IsStreamingClient() {
+		schema = streamRequestSchema(schema)
+	}
+	return dialect.TransformSchema(schema, transforms...)
+}
+
+// Output returns the output schema of the tool of a method. Server-streaming methods
+// return the response messages as an array. Responses are always marshaled with
+// protojson, so provider dialects do not apply.
+func Output(meth protoreflect.MethodDescriptor, opts ...Option) map[string]any {
+	b, _ := newBuilder(opts)
+	schema := b.messageSchema(meth.Output())
+	if meth.IsStreamingServer() {
+		schema = streamResponseSchema(schema)
+	}
+	return schema
+}
+
+// builder builds the schemas of a single call.
+type builder struct {
+	omitDescriptions bool
+	// input is set for input schemas, which leave out output-only fields.
+	input bool
+
+	// schema holds the state of the schema currently being generated.
+	schema *schemaState
+}
+
+func kindToType(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return "boolean"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "integer"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "string" // safely encode as string
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "number"
+	case protoreflect.BytesKind:
+		return "string"
+	case protoreflect.EnumKind:
+		return "string" // optionally add enum values here
+	default:
+		return "string"
+	}
+}
+
+// schemaState tracks the messages referenced from a single root schema. Messages that
+// are recursive or referenced more than once are emitted into "$defs" once and
+// referenced with "$ref".
+type schemaState struct {
+	root      protoreflect.FullName
+	refCounts map[protoreflect.FullName]int
+	recursive map[protoreflect.FullName]bool
+	defs      map[string]any
+}
+
+func newSchemaState(root protoreflect.MessageDescriptor, input bool) *schemaState {
+	s := &schemaState{
+		root:      root.FullName(),
+		refCounts: map[protoreflect.FullName]int{},
+		recursive: map[protoreflect.FullName]bool{},
+		defs:      map[string]any{},
+	}
+
+	visited := map[protoreflect.FullName]bool{}
+	onStack := map[protoreflect.FullName]bool{}
+	var visit func(md protoreflect.MessageDescriptor)
+	visit = func(md protoreflect.MessageDescriptor) {
+		visited[md.FullName()] = true
+		onStack[md.FullName()] = true
+		for i := 0; i < md.Fields().Len(); i++ {
+			fd := md.Fields().Get(i)
+			nested := fieldMessage(fd)
+			if nested == nil || input && isOutputOnly(fd) {
+				continue
+			}
+			name := nested.FullName()
+			s.refCounts[name]++
+			switch {
+			case onStack[name]:
+				// Referencing a message that is being visited closes a cycle, this message
+				// has to be referenced to terminate the schema.
+				s.recursive[name] = true
+			case !visited[name]:
+				visit(nested)
+			}
+		}
+		onStack[md.FullName()] = false
+	}
+	visit(root)
+	return s
+}
+
+// fieldMessage returns the message a field (or map value) holds, or nil for scalars and
+// well-known types, which have dedicated schemas.
+func fieldMessage(fd protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if fd.IsMap() {
+		fd = fd.MapValue()
+	}
+	if fd.Kind() != protoreflect.MessageKind || wellKnownTypes[fd.Message().FullName()] {
+		return nil
+	}
+	return fd.Message()
+}
+
+// wellKnownTypes are the messages with a dedicated schema in getType.
+var wellKnownTypes = map[protoreflect.FullName]bool{
+	"google.protobuf.Timestamp":   true,
+	"google.protobuf.Duration":    true,
+	"google.protobuf.Struct":      true,
+	"google.protobuf.Value":       true,
+	"google.protobuf.ListValue":   true,
+	"google.protobuf.FieldMask":   true,
+	"google.protobuf.Any":         true,
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.BytesValue":  true,
+}
+
+// messageSchema returns the schema of a message. Shared and recursive messages are
+// emitted into "$defs" of the outermost schema.
+func (b *builder) messageSchema(md protoreflect.MessageDescriptor) map[string]any {
+	if b.schema != nil {
+		return b.objectSchema(md)
+	}
+
+	b.schema = newSchemaState(md, b.input)
+	defer func() { b.schema = nil }()
+	result := b.objectSchema(md)
+	if len(b.schema.defs) > 0 {
+		result["$defs"] = b.schema.defs
+	}
+	return result
+}
+
+// messageRef returns the schema of a message referenced by a field.
+func (b *builder) messageRef(md protoreflect.MessageDescriptor) map[string]any {
+	s := b.schema
+	if s == nil {
+		return b.messageSchema(md)
+	}
+	name := md.FullName()
+
+	switch {
+	case name == s.root && s.recursive[name]:
+		return map[string]any{"$ref": "#"}
+	case !s.recursive[name] && s.refCounts[name] < 2:
+		return b.objectSchema(md)
+	}
+	key := string(name)
+	if _, ok := s.defs[key]; !ok {
+		// Reserve the entry first, the message may reference itself.
+		s.defs[key] = map[string]any{}
+		s.defs[key] = b.objectSchema(md)
+	}
+	return map[string]any{"$ref": "#/$defs/" + key}
+}
+
+// objectSchema returns the object schema of a message, without "$defs".
+func (b *builder) objectSchema(md protoreflect.MessageDescriptor) map[string]any {
+	required := []string{}
+	// Fields that are not oneOf
+	normalFields := map[string]any{}
+	// One entry per oneOf block in the message.
+	oneOf := map[string][]map[string]any{}
+
+	// Process all fields in the message descriptor
+	for i := 0; i < md.Fields().Len(); i++ {
+		nestedFd := md.Fields().Get(i)
+		name := string(nestedFd.Name())
+		if b.input && isOutputOnly(nestedFd) {
+			continue
+		}
+
+		// OneOf handling
+		if oneof := nestedFd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			fieldSchema := b.getType(nestedFd)
+			if _, ok := oneOf[string(oneof.Name())]; !ok {
+				oneOf[string(oneof.Name())] = []map[string]any{}
+			}
+			oneOf[string(oneof.Name())] = append(oneOf[string(oneof.Name())], map[string]any{
+				"properties": map[string]any{
+					name: b.fieldSchema(fieldSchema, nestedFd),
+				},
+				"required": []string{name},
+			})
+		} else {
+			// If not part of a oneof, handle as a normal field
+			normalFields[name] = b.fieldSchema(b.getType(nestedFd), nestedFd)
+			if isFieldRequired(nestedFd) {
+				required = append(required, name)
+			}
+		}
+	}
+
+	// OpenAPI works differently than protobuf, when it comes to oneOf.
+	// In proto, not the oneOf name's field name is used, but the actual field name of the oneOf ENTRY.
+	// Therefore, we use an anyOf, and add one oneOf entry per oneOf protobuf block.
+	var anyOf []map[string]any
+	for _, protoOneOf := range oneOf {
+		anyOf = append(anyOf, map[string]any{
+			"oneOf":    protoOneOf,
+			"$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+		})
+	}
+
+	// Final schema includes both properties and anyOf for flexibility
+	result := map[string]any{
+		"type":       "object",
+		"properties": normalFields, // Regular properties defined
+		"required":   required,
+	}
+	if anyOf != nil {
+		result["anyOf"] = anyOf // Fields in properties are already allowed. anyOf is in addition - which covers all oneOf groups
+	}
+	b.addDescription(result, md)
+
+	return result
+}
+
+// descriptorComment returns the cleaned leading and trailing comments of a descriptor.
+// Enums also list the comments of their values.
+func descriptorComment(d protoreflect.Descriptor) string {
+	loc := d.ParentFile().SourceLocations().ByDescriptor(d)
+	var parts []string
+	for _, comment := range []string{loc.LeadingComments, loc.TrailingComments} {
+		if cleaned := strings.TrimSpace(CleanComment(comment)); cleaned != "" {
+			parts = append(parts, cleaned)
+		}
+	}
+
+	if ed, ok := d.(protoreflect.EnumDescriptor); ok {
+		var values []string
+		for i := 0; i < ed.Values().Len(); i++ {
+			vd := ed.Values().Get(i)
+			if comment := descriptorComment(vd); comment != "" {
+				values = append(values, fmt.Sprintf("- %s: %s", vd.Name(), strings.ReplaceAll(comment, "\n", " ")))
+			}
+		}
+		if len(values) > 0 {
+			parts = append(parts, "Values:\n"+strings.Join(values, "\n"))
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// fieldSchema adds the description of a field to the schema of its value.
+func (b *builder) fieldSchema(schema map[string]any, fd protoreflect.FieldDescriptor) map[string]any {
+	b.addDescription(schema, fd)
+	if b.input {
+		applyBehaviors(schema, fd)
+	}
+	return schema
+}
+
+// addDescription adds the comments of a descriptor to the description of a schema,
+// in front of any description the schema already has.
+func (b *builder) addDescription(schema map[string]any, d protoreflect.Descriptor) map[string]any {
+	if b.omitDescriptions {
+		return schema
+	}
+	comment := descriptorComment(d)
+	if comment == "" {
+		return schema
+	}
+	if existing, ok := schema["description"].(string); ok && existing != "" && existing != comment {
+		comment += "\n\n" + existing
+	}
+	schema["description"] = comment
+	return schema
+}
+
+func (b *builder) getType(fd protoreflect.FieldDescriptor) map[string]any {
+	if fd.IsMap() {
+		keyType := fd.MapKey().Kind()
+		keyConstraints := map[string]any{"type": "string"}
+
+		switch keyType {
+		case protoreflect.BoolKind:
+			keyConstraints["enum"] = []string{"true", "false"}
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			keyConstraints["pattern"] = "^(0|[1-9]\\d*)$"
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			keyConstraints["pattern"] = "^-?(0|[1-9]\\d*)$"
+		}
+
+		return map[string]any{
+			"type":                 "object",
+			"propertyNames":        keyConstraints,
+			"additionalProperties": b.getType(fd.MapValue()),
+		}
+	}
+
+	var schema map[string]any
+
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		fullName := string(fd.Message().FullName())
+		switch fullName {
+		case "google.protobuf.Timestamp":
+			schema = map[string]any{"type": []string{"string", "null"}, "format": "date-time"}
+		case "google.protobuf.Duration":
+			schema = map[string]any{"type": []string{"string", "null"}, "pattern": `^-?[0-9]+(\.[0-9]+)?s$`}
+		case "google.protobuf.Struct":
+			schema = map[string]any{
+				"type":                 "object",
+				"description":          "represents a google.protobuf.Struct, a dynamic JSON object.",
+				"additionalProperties": true,
+			}
+		case "google.protobuf.Value":
+			schema = map[string]any{
+				"description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).",
+			}
+		case "google.protobuf.ListValue":
+			schema = map[string]any{
+				"type":        "array",
+				"description": "represents a google.protobuf.ListValue, a JSON array of values.",
+				"items":       map[string]any{},
+			}
+		case "google.protobuf.FieldMask":
+			schema = map[string]any{"type": "string"}
+		case "google.protobuf.Any":
+			schema = map[string]any{
+				"type": []string{"object", "null"},
+				"properties": map[string]any{
+					"@type": map[string]any{"type": "string"},
+					"value": map[string]any{},
+				},
+				"required": []string{"@type"},
+			}
+		case "google.protobuf.DoubleValue", "google.protobuf.FloatValue",
+			"google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+			schema = map[string]any{"type": "number", "nullable": true}
+		case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+			schema = map[string]any{"type": "string", "nullable": true}
+		case "google.protobuf.StringValue":
+			schema = map[string]any{"type": "string", "nullable": true}
+		case "google.protobuf.BoolValue":
+			schema = map[string]any{"type": "boolean", "nullable": true}
+		case "google.protobuf.BytesValue":
+			schema = map[string]any{"type": "string", "format": "byte", "nullable": true}
+		default:
+			schema = b.messageRef(fd.Message())
+		}
+
+	case protoreflect.EnumKind:
+		var values []string
+		for i := 0; i < fd.Enum().Values().Len(); i++ {
+			values = append(values, string(fd.Enum().Values().Get(i).Name()))
+		}
+		schema = map[string]any{
+			"type": "string",
+			"enum": values,
+		}
+		b.addDescription(schema, fd.Enum())
+
+	default:
+		schema = map[string]any{
+			"type": kindToType(fd.Kind()),
+		}
+		if fd.Kind() == protoreflect.BytesKind {
+			schema["contentEncoding"] = "base64"
+			schema["format"] = "byte"
+		}
+	}
+
+	rules := fieldRules(fd)
+
+	// Handle repeated fields here, wrapping the actual schema in an array.
+	if fd.IsList() {
+		applyRules(schema, fd, rules.GetRepeated().GetItems())
+		array := map[string]any{
+			"type":  "array",
+			"items": schema,
+		}
+		applyRepeatedRules(array, rules.GetRepeated())
+		return array
+	}
+	applyRules(schema, fd, rules)
+	return schema
+}
+
+var strippedCommentPrefixes = []string{"buf:lint:", "@ignore-comment"}
+
+// CleanComment trims the lines of a proto comment and drops lint directives.
+func CleanComment(comment string) string {
+	var cleanedLines []string
+outer:
+	for _, line := range strings.Split(comment, "\n") {
+		trimmed := strings.TrimSpace(line)
+		for _, strip := range strippedCommentPrefixes {
+			if strings.HasPrefix(trimmed, strip) {
+				continue outer
+			}
+		}
+		cleanedLines = append(cleanedLines, trimmed)
+	}
+	return strings.Join(cleanedLines, "\n")
+}
+
+// embedSchema prepares a root schema to be placed at the given JSON pointer of another
+// schema: references to its root are rewritten and its "$defs" are returned, so they
+// can be moved to the root of the outer schema.
+func embedSchema(schema map[string]any, pointer string) any {
+	defs, ok := schema["$defs"]
+	delete(schema, "$defs")
+
+	var rewrite func(v any)
+	rewrite = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if v["$ref"] == "#" {
+				v["$ref"] = pointer
+			}
+			for _, nested := range v {
+				rewrite(nested)
+			}
+		case []map[string]any:
+			for _, nested := range v {
+				rewrite(nested)
+			}
+		case []any:
+			for _, nested := range v {
+				rewrite(nested)
+			}
+		}
+	}
+	rewrite(schema)
+	if !ok {
+		return nil
+	}
+	rewrite(defs)
+	return defs
+}
+
+// streamRequestSchema wraps the schema of a streamed request message, so that a tool
+// call can carry all messages to send on the stream.
+func streamRequestSchema(schema map[string]any) map[string]any {
+	defs := embedSchema(schema, "#/properties/messages/items")
+	result := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"messages": map[string]any{
+				"type":        "array",
+				"description": "Messages to send on the stream, in order.",
+				"items":       schema,
+			},
+		},
+		"required": []string{"messages"},
+	}
+	if defs != nil {
+		result["$defs"] = defs
+	}
+	return result
+}
+
+// streamResponseSchema wraps the schema of a streamed response message, matching the
+// result of streaming tools.
+func streamResponseSchema(schema map[string]any) map[string]any {
+	defs := embedSchema(schema, "#/properties/messages/items")
+	result := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"messages": map[string]any{
+				"type":        "array",
+				"description": "Messages received on the stream, in order.",
+				"items":       schema,
+			},
+			"dropped_messages": map[string]any{
+				"type":        "integer",
+				"description": "Number of earlier messages that were omitted from the result.",
+			},
+		},
+		"required": []string{"messages"},
+	}
+	if defs != nil {
+		result["$defs"] = defs
+	}
+	return result
+}
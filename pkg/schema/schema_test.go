@@ -0,0 +1,340 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	"github.com/statico/protoc-gen-go-mcp/internal/dialect"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdatamcp "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestGetTypeStandard(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupField func() protoreflect.FieldDescriptor
+		wantSchema func(*WithT, map[string]any)
+	}{
+		// Map field tests
+		{
+			name: "map field in standard mode",
+			setupField: func() protoreflect.FieldDescriptor {
+				// Use the test proto's map field
+				msg := &testdata.MapTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("string_map")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["type"]).To(Equal("object"))
+				g.Expect(schema).To(HaveKey("additionalProperties"))
+				g.Expect(schema).To(HaveKey("propertyNames"))
+			},
+		},
+		// Well-known types
+		{
+			name: "google.protobuf.Struct in standard mode",
+			setupField: func() protoreflect.FieldDescriptor {
+				msg := &testdata.WktTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("struct_field")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["type"]).To(Equal("object"))
+				g.Expect(schema["additionalProperties"]).To(Equal(true))
+			},
+		},
+		{
+			name: "google.protobuf.Value in standard mode",
+			setupField: func() protoreflect.FieldDescriptor {
+				msg := &testdata.WktTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("value_field")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["description"]).To(ContainSubstring("dynamic JSON value"))
+				g.Expect(schema).ToNot(HaveKey("type")) // Any type
+			},
+		},
+		{
+			name: "google.protobuf.ListValue in standard mode",
+			setupField: func() protoreflect.FieldDescriptor {
+				msg := &testdata.WktTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("list_value")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["type"]).To(Equal("array"))
+				g.Expect(schema).To(HaveKey("items"))
+				g.Expect(schema["description"]).To(ContainSubstring("JSON array"))
+			},
+		},
+		// Timestamp field
+		{
+			name: "timestamp field",
+			setupField: func() protoreflect.FieldDescriptor {
+				msg := &testdata.WktTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("timestamp")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["type"]).To(Equal([]string{"string", "null"}))
+				g.Expect(schema["format"]).To(Equal("date-time"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			field := tt.setupField()
+			schema := Field(field)
+
+			tt.wantSchema(g, schema)
+		})
+	}
+}
+
+func TestGetTypeOpenAI(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupField func() protoreflect.FieldDescriptor
+		wantSchema func(*WithT, map[string]any)
+	}{
+		{
+			name: "map field in OpenAI mode",
+			setupField: func() protoreflect.FieldDescriptor {
+				msg := &testdata.MapTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("string_map")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["type"]).To(Equal("array"))
+				g.Expect(schema["description"]).To(Equal("List of key value pairs"))
+				items := schema["items"].(map[string]any)
+				g.Expect(items["type"]).To(Equal("object"))
+				props := items["properties"].(map[string]any)
+				g.Expect(props).To(HaveKey("key"))
+				g.Expect(props).To(HaveKey("value"))
+				g.Expect(items["required"]).To(Equal([]string{"key", "value"}))
+				g.Expect(items["additionalProperties"]).To(Equal(false))
+			},
+		},
+		{
+			name: "google.protobuf.Struct in OpenAI mode",
+			setupField: func() protoreflect.FieldDescriptor {
+				msg := &testdata.WktTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("struct_field")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["type"]).To(Equal("string"))
+				g.Expect(schema["description"]).To(ContainSubstring("string representation of any JSON object"))
+			},
+		},
+		{
+			name: "google.protobuf.Value in OpenAI mode",
+			setupField: func() protoreflect.FieldDescriptor {
+				msg := &testdata.WktTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("value_field")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["type"]).To(Equal("string"))
+				g.Expect(schema["description"]).To(ContainSubstring("string representation of any JSON value"))
+			},
+		},
+		{
+			name: "google.protobuf.ListValue in OpenAI mode",
+			setupField: func() protoreflect.FieldDescriptor {
+				msg := &testdata.WktTestMessage{}
+				return msg.ProtoReflect().Descriptor().Fields().ByName("list_value")
+			},
+			wantSchema: func(g *WithT, schema map[string]any) {
+				g.Expect(schema["type"]).To(Equal("string"))
+				g.Expect(schema["description"]).To(ContainSubstring("string representation of a JSON array"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			field := tt.setupField()
+			schema := Field(field, WithProvider(dialect.LLMProviderOpenAI))
+
+			tt.wantSchema(g, schema)
+		})
+	}
+}
+
+func TestMapSchemasOpenAI(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := Message((&testdata.NestedMapTestMessage{}).ProtoReflect().Descriptor(), WithProvider(dialect.LLMProviderOpenAI))
+	properties := schema["properties"].(map[string]any)
+
+	pair := func(name string) (key, value map[string]any) {
+		items := properties[name].(map[string]any)["items"].(map[string]any)
+		pairProperties := items["properties"].(map[string]any)
+		return pairProperties["key"].(map[string]any), pairProperties["value"].(map[string]any)
+	}
+
+	// Message values reference their complete schema, nested maps included
+	_, value := pair("map_messages")
+	g.Expect(value).To(HaveKeyWithValue("$ref", "#/$defs/testdata.MapTestMessage"))
+	value = schema["$defs"].(map[string]any)["testdata.MapTestMessage"].(map[string]any)
+	g.Expect(value["type"]).To(Equal("object"))
+	g.Expect(value["required"]).To(Equal([]string{"string_map"}))
+	g.Expect(value["additionalProperties"]).To(Equal(false))
+	nested := value["properties"].(map[string]any)["string_map"].(map[string]any)
+	g.Expect(nested["type"]).To(Equal("array"))
+	g.Expect(nested["items"]).To(HaveKeyWithValue("required", []string{"key", "value"}))
+
+	// Integer keys are strings with a pattern
+	key, value := pair("int_map")
+	g.Expect(key).To(Equal(map[string]any{"type": "string", "pattern": "^-?(0|[1-9]\\d*)$"}))
+	g.Expect(value).To(Equal(map[string]any{"type": "string"}))
+
+	// Bool keys are enumerated, enum values keep their values
+	key, value = pair("priorities")
+	g.Expect(key).To(Equal(map[string]any{"type": "string", "enum": []string{"true", "false"}}))
+	g.Expect(value["type"]).To(Equal("string"))
+	g.Expect(value["enum"]).To(Equal([]string{"PRIORITY_UNSPECIFIED", "PRIORITY_LOW", "PRIORITY_HIGH"}))
+}
+
+func TestMessageSchemaStandard(t *testing.T) {
+	g := NewWithT(t)
+
+	msgDesc := (&testdata.WktTestMessage{}).ProtoReflect().Descriptor()
+	schema := Message(msgDesc)
+
+	g.Expect(schema["type"]).To(Equal("object"))
+	g.Expect(schema).To(HaveKey("properties"))
+	g.Expect(schema).To(HaveKey("required"))
+	// Standard mode should not have additionalProperties: false
+	g.Expect(schema).ToNot(HaveKey("additionalProperties"))
+}
+
+func TestMessageSchemaOpenAI(t *testing.T) {
+	g := NewWithT(t)
+
+	msgDesc := (&testdata.WktTestMessage{}).ProtoReflect().Descriptor()
+	schema := Message(msgDesc, WithProvider(dialect.LLMProviderOpenAI))
+
+	// The root object is never nullable
+	g.Expect(schema["type"]).To(Equal("object"))
+	g.Expect(schema).To(HaveKey("properties"))
+	g.Expect(schema).To(HaveKey("required"))
+	// OpenAI mode should have additionalProperties: false
+	g.Expect(schema["additionalProperties"]).To(Equal(false))
+
+	// In OpenAI mode, all fields should be required
+	required := schema["required"].([]string)
+	props := schema["properties"].(map[string]any)
+	g.Expect(len(required)).To(Equal(len(props)))
+}
+
+func TestKindToType(t *testing.T) {
+	tests := []struct {
+		kind protoreflect.Kind
+		want string
+	}{
+		{protoreflect.BoolKind, "boolean"},
+		{protoreflect.StringKind, "string"},
+		{protoreflect.Int32Kind, "integer"},
+		{protoreflect.Int64Kind, "string"}, // encoded as string for safety
+		{protoreflect.FloatKind, "number"},
+		{protoreflect.DoubleKind, "number"},
+		{protoreflect.BytesKind, "string"},
+		{protoreflect.EnumKind, "string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(kindToType(tt.kind)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestSchemaMarshaling(t *testing.T) {
+	g := NewWithT(t)
+
+	// Test that generated schemas can be marshaled to JSON
+	msg := &testdata.WktTestMessage{}
+	schema := Message(msg.ProtoReflect().Descriptor())
+
+	marshaled, err := json.Marshal(schema)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(marshaled).ToNot(BeEmpty())
+
+	// Verify it's valid JSON
+	var unmarshaled map[string]any
+	err = json.Unmarshal(marshaled, &unmarshaled)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+// withoutDescriptions removes the descriptions of a schema, the compiled-in descriptors
+// of the test data have no comments.
+func withoutDescriptions(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if _, ok := nested.(string); ok && key == "description" {
+				delete(v, key)
+				continue
+			}
+			v[key] = withoutDescriptions(nested)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = withoutDescriptions(nested)
+		}
+	}
+	return v
+}
+
+func TestMatchesGeneratedTools(t *testing.T) {
+	normalize := func(g *WithT, raw []byte) any {
+		var schema any
+		g.Expect(json.Unmarshal(raw, &schema)).To(Succeed())
+		return withoutDescriptions(schema)
+	}
+
+	tests := []struct {
+		method   protoreflect.MethodDescriptor
+		provider dialect.LLMProvider
+		tool     mcp.Tool
+	}{
+		{testdata.File_testdata_test_service_proto.Services().Get(0).Methods().ByName("CreateItem"), dialect.LLMProviderStandard, testdatamcp.TestService_CreateItemTool},
+		{testdata.File_testdata_test_service_proto.Services().Get(0).Methods().ByName("CreateItem"), dialect.LLMProviderOpenAI, testdatamcp.TestService_CreateItemToolOpenAI},
+		{testdata.File_testdata_test_service_proto.Services().Get(0).Methods().ByName("ProcessWellKnownTypes"), dialect.LLMProviderGemini, testdatamcp.TestService_ProcessWellKnownTypesToolGemini},
+		{testdata.File_testdata_streaming_test_proto.Services().Get(0).Methods().ByName("SyncItems"), dialect.LLMProviderStandard, testdatamcp.StreamingTestService_SyncItemsTool},
+		{testdata.File_testdata_streaming_test_proto.Services().Get(0).Methods().ByName("UploadItems"), dialect.LLMProviderOpenAI, testdatamcp.StreamingTestService_UploadItemsToolOpenAI},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tool.Name+"/"+string(tt.provider), func(t *testing.T) {
+			g := NewWithT(t)
+
+			input, err := json.Marshal(Input(tt.method, WithProvider(tt.provider)))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(normalize(g, input)).To(Equal(normalize(g, tt.tool.RawInputSchema)))
+
+			output, err := json.Marshal(Output(tt.method))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(normalize(g, output)).To(Equal(normalize(g, tt.tool.RawOutputSchema)))
+		})
+	}
+}
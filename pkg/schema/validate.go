@@ -0,0 +1,279 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldRules returns the buf.validate rules of a field, or nil if it has none.
+func fieldRules(fd protoreflect.FieldDescriptor) *validate.FieldRules {
+	if !proto.HasExtension(fd.Options(), validate.E_Field) {
+		return nil
+	}
+	rules, _ := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldRules)
+	return rules
+}
+
+// constraints collects the JSON Schema keywords for the rules of a field. Rules that
+// have no keyword are turned into a sentence of the description. Provider dialects
+// that do not support a keyword describe it as well, see
+// runtime.StripUnsupportedKeywords.
+type constraints struct {
+	schema map[string]any
+	notes  []string
+}
+
+func (c *constraints) set(keyword string, value any) {
+	c.schema[keyword] = value
+}
+
+func (c *constraints) note(note string, args ...any) {
+	c.notes = append(c.notes, fmt.Sprintf(note, args...))
+}
+
+// done appends the notes to the description of the schema.
+func (c *constraints) done() {
+	if len(c.notes) == 0 {
+		return
+	}
+	description := strings.Join(c.notes, " ")
+	if existing, ok := c.schema["description"].(string); ok && existing != "" {
+		description = existing + "\n\n" + description
+	}
+	c.schema["description"] = description
+}
+
+// applyRules translates the buf.validate rules of a single (non-repeated) value into
+// JSON Schema keywords.
+func applyRules(schema map[string]any, fd protoreflect.FieldDescriptor, rules *validate.FieldRules) {
+	if rules == nil {
+		return
+	}
+	c := &constraints{schema: schema}
+	defer c.done()
+
+	switch {
+	case rules.GetString() != nil:
+		applyStringRules(c, rules.GetString())
+	case rules.GetEnum() != nil && fd.Kind() == protoreflect.EnumKind:
+		applyEnumRules(c, fd.Enum(), rules.GetEnum())
+	case isNumeric(fd.Kind()):
+		oneof := rules.ProtoReflect().Descriptor().Oneofs().ByName("type")
+		if typed := rules.ProtoReflect().WhichOneof(oneof); typed != nil {
+			applyNumericRules(c, rules.ProtoReflect().Get(typed).Message())
+		}
+	}
+}
+
+// applyRepeatedRules translates the buf.validate rules of a repeated field into JSON
+// Schema keywords of the array schema.
+func applyRepeatedRules(schema map[string]any, rules *validate.RepeatedRules) {
+	if rules == nil {
+		return
+	}
+	c := &constraints{schema: schema}
+	defer c.done()
+
+	if rules.HasMinItems() {
+		c.set("minItems", rules.GetMinItems())
+	}
+	if rules.HasMaxItems() {
+		c.set("maxItems", rules.GetMaxItems())
+	}
+	if rules.GetUnique() {
+		c.set("uniqueItems", true)
+	}
+}
+
+func applyStringRules(c *constraints, rules *validate.StringRules) {
+	if rules.HasLen() {
+		c.set("minLength", rules.GetLen())
+		c.set("maxLength", rules.GetLen())
+	}
+	if rules.HasMinLen() {
+		c.set("minLength", rules.GetMinLen())
+	}
+	if rules.HasMaxLen() {
+		c.set("maxLength", rules.GetMaxLen())
+	}
+	if rules.HasPattern() {
+		c.set("pattern", rules.GetPattern())
+	}
+	if rules.HasPrefix() {
+		c.note("Must start with %q.", rules.GetPrefix())
+	}
+	if rules.HasSuffix() {
+		c.note("Must end with %q.", rules.GetSuffix())
+	}
+	if rules.HasContains() {
+		c.note("Must contain %q.", rules.GetContains())
+	}
+
+	formats := []struct {
+		set    bool
+		format string
+	}{
+		{rules.GetEmail(), "email"},
+		{rules.GetHostname(), "hostname"},
+		{rules.GetIpv4(), "ipv4"},
+		{rules.GetIpv6(), "ipv6"},
+		{rules.GetUri(), "uri"},
+		{rules.GetUriRef(), "uri-reference"},
+		{rules.GetUuid(), "uuid"},
+	}
+	for _, f := range formats {
+		if f.set {
+			c.set("format", f.format)
+		}
+	}
+
+	if len(rules.GetIn()) > 0 {
+		c.set("enum", rules.GetIn())
+	}
+	if len(rules.GetNotIn()) > 0 {
+		c.set("not", map[string]any{"enum": rules.GetNotIn()})
+	}
+}
+
+// applyEnumRules restricts the values of an enum schema. Only defined values are ever
+// listed in the schema, so defined_only needs no translation.
+func applyEnumRules(c *constraints, ed protoreflect.EnumDescriptor, rules *validate.EnumRules) {
+	values, ok := c.schema["enum"].([]string)
+	if !ok {
+		return
+	}
+	var allowed []string
+	for _, name := range values {
+		number := int32(ed.Values().ByName(protoreflect.Name(name)).Number())
+		if len(rules.GetIn()) > 0 && !slices.Contains(rules.GetIn(), number) {
+			continue
+		}
+		if slices.Contains(rules.GetNotIn(), number) {
+			continue
+		}
+		allowed = append(allowed, name)
+	}
+	c.schema["enum"] = allowed
+}
+
+// applyNumericRules translates the rules shared by all numeric rule messages
+// (Int32Rules, DoubleRules, ...). 64-bit integers are encoded as strings, so their
+// bounds can only be described.
+func applyNumericRules(c *constraints, rules protoreflect.Message) {
+	isNumber := c.schema["type"] == "integer" || c.schema["type"] == "number"
+
+	bounds := []struct {
+		field   protoreflect.Name
+		keyword string
+		note    string
+	}{
+		{"gt", "exclusiveMinimum", "Must be greater than %v."},
+		{"gte", "minimum", "Must be greater than or equal to %v."},
+		{"lt", "exclusiveMaximum", "Must be less than %v."},
+		{"lte", "maximum", "Must be less than or equal to %v."},
+	}
+	for _, bound := range bounds {
+		fd := rules.Descriptor().Fields().ByName(bound.field)
+		if fd == nil || fd.Kind() == protoreflect.MessageKind || !rules.Has(fd) {
+			continue
+		}
+		// JSON has no infinity or NaN, such bounds are described as well.
+		value := rules.Get(fd).Interface()
+		if isNumber && isFinite(value) {
+			c.set(bound.keyword, value)
+		} else {
+			c.note(bound.note, value)
+		}
+	}
+
+	in := listValues(rules, "in", isNumber)
+	switch {
+	case len(in) == 0:
+	case isFinite(in...):
+		c.set("enum", in)
+	default:
+		c.note("Must be one of %s.", joinValues(in))
+	}
+	notIn := listValues(rules, "not_in", isNumber)
+	switch {
+	case len(notIn) == 0:
+	case isFinite(notIn...):
+		c.set("not", map[string]any{"enum": notIn})
+	default:
+		c.note("Must not be one of %s.", joinValues(notIn))
+	}
+}
+
+// isFinite reports whether the values can be encoded as JSON numbers, which excludes
+// infinite and NaN floating point values.
+func isFinite(values ...any) bool {
+	for _, value := range values {
+		switch v := value.(type) {
+		case float32:
+			if math.IsInf(float64(v), 0) || math.IsNaN(float64(v)) {
+				return false
+			}
+		case float64:
+			if math.IsInf(v, 0) || math.IsNaN(v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func joinValues(values []any) string {
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, fmt.Sprint(value))
+	}
+	return strings.Join(strs, ", ")
+}
+
+func isNumeric(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.BoolKind, protoreflect.StringKind, protoreflect.BytesKind,
+		protoreflect.EnumKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	}
+	return true
+}
+
+// listValues returns the values of a repeated numeric rule, as strings if the schema
+// encodes the number as a string.
+func listValues(rules protoreflect.Message, name protoreflect.Name, isNumber bool) []any {
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || !fd.IsList() || fd.Kind() == protoreflect.MessageKind {
+		return nil
+	}
+	list := rules.Get(fd).List()
+	var values []any
+	for i := 0; i < list.Len(); i++ {
+		value := list.Get(i).Interface()
+		if !isNumber {
+			value = fmt.Sprint(value)
+		}
+		values = append(values, value)
+	}
+	return values
+}
@@ -19,13 +19,435 @@ import (
 )
 
 var (
-	ByteStream_QueryWriteStatusTool       = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_bytestream_ByteStream_QueryWriteStatus", Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	ByteStream_QueryWriteStatusToolOpenAI = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_bytestream_ByteStream_QueryWriteStatus", Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
+	ByteStream_QueryWriteStatusTool = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_QueryWriteStatus",
+		Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "Request object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "resource_name": {
+      "description": "The name of the resource whose write status is being requested.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    },
+    "complete": {
+      "description": "` + "`" + `complete` + "`" + ` is ` + "`" + `true` + "`" + ` only if the client has sent a ` + "`" + `WriteRequest` + "`" + ` with\n` + "`" + `finish_write` + "`" + ` set to true, and the server has processed that request.",
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_ReadTool = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Read",
+		Description: "`Read()` is used to retrieve the contents of a resource as a sequence\nof bytes. The bytes are returned in a sequence of responses, and the\nresponses are delivered as the results of a server-side streaming RPC.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "Request object for ByteStream.Read.",
+  "properties": {
+    "read_limit": {
+      "description": "The maximum number of ` + "`" + `data` + "`" + ` bytes the server is allowed to return in the\nsum of all ` + "`" + `ReadResponse` + "`" + ` messages. A ` + "`" + `read_limit` + "`" + ` of zero indicates that\nthere is no limit, and a negative ` + "`" + `read_limit` + "`" + ` will cause an error.\n\nIf the stream returns fewer bytes than allowed by the ` + "`" + `read_limit` + "`" + ` and no\nerror occurred, the stream includes all data from the ` + "`" + `read_offset` + "`" + ` to the\nend of the resource.",
+      "type": "string"
+    },
+    "read_offset": {
+      "description": "The offset for the first byte to return in the read, relative to the start\nof the resource.\n\nA ` + "`" + `read_offset` + "`" + ` that is negative or greater than the size of the resource\nwill cause an ` + "`" + `OUT_OF_RANGE` + "`" + ` error.",
+      "type": "string"
+    },
+    "resource_name": {
+      "description": "The name of the resource to read.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "Response object for ByteStream.Read.",
+        "properties": {
+          "data": {
+            "contentEncoding": "base64",
+            "description": "A portion of the data for the resource. The service **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `ReadResponse` + "`" + `. This enables the service to inform the\nclient that the request is still live while it is running an operation to\ngenerate more data.",
+            "format": "byte",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_WriteTool = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Write",
+		Description: "`Write()` is used to send the contents of a resource as a sequence of\nbytes. The bytes are sent in a sequence of request protos of a client-side\nstreaming RPC.\n\nA `Write()` action is resumable. If there is an error or the connection is\nbroken during the `Write()`, the client should check the status of the\n`Write()` by calling `QueryWriteStatus()` and continue writing from the\nreturned `committed_size`. This may be less than the amount of data the\nclient previously sent.\n\nCalling `Write()` on a resource name that was previously written and\nfinalized could cause an error, depending on whether the underlying service\nallows over-writing of previously written resources.\n\nWhen the client closes the request channel, the service will respond with\na `WriteResponse`. The service will not view the resource as `complete`\nuntil the client has sent a `WriteRequest` with `finish_write` set to\n`true`. Sending any requests on a stream after sending a request with\n`finish_write` set to `true` will cause an error. The client **should**\ncheck the `WriteResponse` it receives to determine how much data the\nservice was able to commit and whether the service views the resource as\n`complete` or not.\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "description": "Request object for ByteStream.Write.",
+        "properties": {
+          "data": {
+            "contentEncoding": "base64",
+            "description": "A portion of the data for the resource. The client **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `WriteRequest` + "`" + `. This enables the client to inform the\nservice that the request is still live while it is running an operation to\ngenerate more data.",
+            "format": "byte",
+            "type": "string"
+          },
+          "finish_write": {
+            "description": "If ` + "`" + `true` + "`" + `, this indicates that the write is complete. Sending any\n` + "`" + `WriteRequest` + "`" + `s subsequent to one in which ` + "`" + `finish_write` + "`" + ` is ` + "`" + `true` + "`" + ` will\ncause an error.",
+            "type": "boolean"
+          },
+          "resource_name": {
+            "description": "The name of the resource to write. This **must** be set on the first\n` + "`" + `WriteRequest` + "`" + ` of each ` + "`" + `Write()` + "`" + ` action. If it is set on subsequent calls,\nit **must** match the value of the first request.",
+            "type": "string"
+          },
+          "write_offset": {
+            "description": "The offset from the beginning of the resource at which the data should be\nwritten. It is required on all ` + "`" + `WriteRequest` + "`" + `s.\n\nIn the first ` + "`" + `WriteRequest` + "`" + ` of a ` + "`" + `Write()` + "`" + ` action, it indicates\nthe initial offset for the ` + "`" + `Write()` + "`" + ` call. The value **must** be equal to\nthe ` + "`" + `committed_size` + "`" + ` that a call to ` + "`" + `QueryWriteStatus()` + "`" + ` would return.\n\nOn subsequent calls, this value **must** be set and **must** be equal to\nthe sum of the first ` + "`" + `write_offset` + "`" + ` and the sizes of all ` + "`" + `data` + "`" + ` bundles\nsent previously on this stream.\n\nAn incorrect value will cause an error.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.Write.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_QueryWriteStatusToolOpenAI = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_QueryWriteStatus",
+		Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "Request object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "resource_name": {
+      "description": "The name of the resource whose write status is being requested.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "resource_name"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    },
+    "complete": {
+      "description": "` + "`" + `complete` + "`" + ` is ` + "`" + `true` + "`" + ` only if the client has sent a ` + "`" + `WriteRequest` + "`" + ` with\n` + "`" + `finish_write` + "`" + ` set to true, and the server has processed that request.",
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_ReadToolOpenAI = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Read",
+		Description: "`Read()` is used to retrieve the contents of a resource as a sequence\nof bytes. The bytes are returned in a sequence of responses, and the\nresponses are delivered as the results of a server-side streaming RPC.\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "Request object for ByteStream.Read.",
+  "properties": {
+    "read_limit": {
+      "description": "The maximum number of ` + "`" + `data` + "`" + ` bytes the server is allowed to return in the\nsum of all ` + "`" + `ReadResponse` + "`" + ` messages. A ` + "`" + `read_limit` + "`" + ` of zero indicates that\nthere is no limit, and a negative ` + "`" + `read_limit` + "`" + ` will cause an error.\n\nIf the stream returns fewer bytes than allowed by the ` + "`" + `read_limit` + "`" + ` and no\nerror occurred, the stream includes all data from the ` + "`" + `read_offset` + "`" + ` to the\nend of the resource.",
+      "type": "string"
+    },
+    "read_offset": {
+      "description": "The offset for the first byte to return in the read, relative to the start\nof the resource.\n\nA ` + "`" + `read_offset` + "`" + ` that is negative or greater than the size of the resource\nwill cause an ` + "`" + `OUT_OF_RANGE` + "`" + ` error.",
+      "type": "string"
+    },
+    "resource_name": {
+      "description": "The name of the resource to read.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "read_limit",
+    "read_offset",
+    "resource_name"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "Response object for ByteStream.Read.",
+        "properties": {
+          "data": {
+            "contentEncoding": "base64",
+            "description": "A portion of the data for the resource. The service **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `ReadResponse` + "`" + `. This enables the service to inform the\nclient that the request is still live while it is running an operation to\ngenerate more data.",
+            "format": "byte",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_WriteToolOpenAI = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Write",
+		Description: "`Write()` is used to send the contents of a resource as a sequence of\nbytes. The bytes are sent in a sequence of request protos of a client-side\nstreaming RPC.\n\nA `Write()` action is resumable. If there is an error or the connection is\nbroken during the `Write()`, the client should check the status of the\n`Write()` by calling `QueryWriteStatus()` and continue writing from the\nreturned `committed_size`. This may be less than the amount of data the\nclient previously sent.\n\nCalling `Write()` on a resource name that was previously written and\nfinalized could cause an error, depending on whether the underlying service\nallows over-writing of previously written resources.\n\nWhen the client closes the request channel, the service will respond with\na `WriteResponse`. The service will not view the resource as `complete`\nuntil the client has sent a `WriteRequest` with `finish_write` set to\n`true`. Sending any requests on a stream after sending a request with\n`finish_write` set to `true` will cause an error. The client **should**\ncheck the `WriteResponse` it receives to determine how much data the\nservice was able to commit and whether the service views the resource as\n`complete` or not.\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "additionalProperties": false,
+        "description": "Request object for ByteStream.Write.",
+        "properties": {
+          "data": {
+            "description": "A portion of the data for the resource. The client **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `WriteRequest` + "`" + `. This enables the client to inform the\nservice that the request is still live while it is running an operation to\ngenerate more data.\n\nBase64 encoded.",
+            "type": "string"
+          },
+          "finish_write": {
+            "description": "If ` + "`" + `true` + "`" + `, this indicates that the write is complete. Sending any\n` + "`" + `WriteRequest` + "`" + `s subsequent to one in which ` + "`" + `finish_write` + "`" + ` is ` + "`" + `true` + "`" + ` will\ncause an error.",
+            "type": "boolean"
+          },
+          "resource_name": {
+            "description": "The name of the resource to write. This **must** be set on the first\n` + "`" + `WriteRequest` + "`" + ` of each ` + "`" + `Write()` + "`" + ` action. If it is set on subsequent calls,\nit **must** match the value of the first request.",
+            "type": "string"
+          },
+          "write_offset": {
+            "description": "The offset from the beginning of the resource at which the data should be\nwritten. It is required on all ` + "`" + `WriteRequest` + "`" + `s.\n\nIn the first ` + "`" + `WriteRequest` + "`" + ` of a ` + "`" + `Write()` + "`" + ` action, it indicates\nthe initial offset for the ` + "`" + `Write()` + "`" + ` call. The value **must** be equal to\nthe ` + "`" + `committed_size` + "`" + ` that a call to ` + "`" + `QueryWriteStatus()` + "`" + ` would return.\n\nOn subsequent calls, this value **must** be set and **must** be equal to\nthe sum of the first ` + "`" + `write_offset` + "`" + ` and the sizes of all ` + "`" + `data` + "`" + ` bundles\nsent previously on this stream.\n\nAn incorrect value will cause an error.",
+            "type": "string"
+          }
+        },
+        "required": [
+          "data",
+          "finish_write",
+          "resource_name",
+          "write_offset"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.Write.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_QueryWriteStatusToolGemini = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_QueryWriteStatus",
+		Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "Request object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "resource_name": {
+      "description": "The name of the resource whose write status is being requested.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    },
+    "complete": {
+      "description": "` + "`" + `complete` + "`" + ` is ` + "`" + `true` + "`" + ` only if the client has sent a ` + "`" + `WriteRequest` + "`" + ` with\n` + "`" + `finish_write` + "`" + ` set to true, and the server has processed that request.",
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_ReadToolGemini = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Read",
+		Description: "`Read()` is used to retrieve the contents of a resource as a sequence\nof bytes. The bytes are returned in a sequence of responses, and the\nresponses are delivered as the results of a server-side streaming RPC.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "Request object for ByteStream.Read.",
+  "properties": {
+    "read_limit": {
+      "description": "The maximum number of ` + "`" + `data` + "`" + ` bytes the server is allowed to return in the\nsum of all ` + "`" + `ReadResponse` + "`" + ` messages. A ` + "`" + `read_limit` + "`" + ` of zero indicates that\nthere is no limit, and a negative ` + "`" + `read_limit` + "`" + ` will cause an error.\n\nIf the stream returns fewer bytes than allowed by the ` + "`" + `read_limit` + "`" + ` and no\nerror occurred, the stream includes all data from the ` + "`" + `read_offset` + "`" + ` to the\nend of the resource.",
+      "type": "string"
+    },
+    "read_offset": {
+      "description": "The offset for the first byte to return in the read, relative to the start\nof the resource.\n\nA ` + "`" + `read_offset` + "`" + ` that is negative or greater than the size of the resource\nwill cause an ` + "`" + `OUT_OF_RANGE` + "`" + ` error.",
+      "type": "string"
+    },
+    "resource_name": {
+      "description": "The name of the resource to read.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "Response object for ByteStream.Read.",
+        "properties": {
+          "data": {
+            "contentEncoding": "base64",
+            "description": "A portion of the data for the resource. The service **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `ReadResponse` + "`" + `. This enables the service to inform the\nclient that the request is still live while it is running an operation to\ngenerate more data.",
+            "format": "byte",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_WriteToolGemini = mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Write",
+		Description: "`Write()` is used to send the contents of a resource as a sequence of\nbytes. The bytes are sent in a sequence of request protos of a client-side\nstreaming RPC.\n\nA `Write()` action is resumable. If there is an error or the connection is\nbroken during the `Write()`, the client should check the status of the\n`Write()` by calling `QueryWriteStatus()` and continue writing from the\nreturned `committed_size`. This may be less than the amount of data the\nclient previously sent.\n\nCalling `Write()` on a resource name that was previously written and\nfinalized could cause an error, depending on whether the underlying service\nallows over-writing of previously written resources.\n\nWhen the client closes the request channel, the service will respond with\na `WriteResponse`. The service will not view the resource as `complete`\nuntil the client has sent a `WriteRequest` with `finish_write` set to\n`true`. Sending any requests on a stream after sending a request with\n`finish_write` set to `true` will cause an error. The client **should**\ncheck the `WriteResponse` it receives to determine how much data the\nservice was able to commit and whether the service views the resource as\n`complete` or not.\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "description": "Request object for ByteStream.Write.",
+        "properties": {
+          "data": {
+            "description": "A portion of the data for the resource. The client **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `WriteRequest` + "`" + `. This enables the client to inform the\nservice that the request is still live while it is running an operation to\ngenerate more data.\n\nBase64 encoded.",
+            "type": "string"
+          },
+          "finish_write": {
+            "description": "If ` + "`" + `true` + "`" + `, this indicates that the write is complete. Sending any\n` + "`" + `WriteRequest` + "`" + `s subsequent to one in which ` + "`" + `finish_write` + "`" + ` is ` + "`" + `true` + "`" + ` will\ncause an error.",
+            "type": "boolean"
+          },
+          "resource_name": {
+            "description": "The name of the resource to write. This **must** be set on the first\n` + "`" + `WriteRequest` + "`" + ` of each ` + "`" + `Write()` + "`" + ` action. If it is set on subsequent calls,\nit **must** match the value of the first request.",
+            "type": "string"
+          },
+          "write_offset": {
+            "description": "The offset from the beginning of the resource at which the data should be\nwritten. It is required on all ` + "`" + `WriteRequest` + "`" + `s.\n\nIn the first ` + "`" + `WriteRequest` + "`" + ` of a ` + "`" + `Write()` + "`" + ` action, it indicates\nthe initial offset for the ` + "`" + `Write()` + "`" + ` call. The value **must** be equal to\nthe ` + "`" + `committed_size` + "`" + ` that a call to ` + "`" + `QueryWriteStatus()` + "`" + ` would return.\n\nOn subsequent calls, this value **must** be set and **must** be equal to\nthe sum of the first ` + "`" + `write_offset` + "`" + ` and the sizes of all ` + "`" + `data` + "`" + ` bundles\nsent previously on this stream.\n\nAn incorrect value will cause an error.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.Write.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
 )
 
 // ByteStreamServer is compatible with the grpc-go server interface.
 type ByteStreamServer interface {
 	QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest) (*bytestream.QueryWriteStatusResponse, error)
+	Read(req *bytestream.ReadRequest, stream grpc.ServerStreamingServer[bytestream.ReadResponse]) error
+	Write(stream grpc.ClientStreamingServer[bytestream.WriteRequest, bytestream.WriteResponse]) error
 }
 
 // RegisterByteStreamHandler registers standard MCP handlers for ByteStream
@@ -34,10 +456,23 @@ func RegisterByteStreamHandler(s *mcpserver.MCPServer, srv ByteStreamServer, opt
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		QueryWriteStatusTool = runtime.TransformTool(QueryWriteStatusTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		QueryWriteStatusTool = runtime.AddExtraPropertiesToTool(QueryWriteStatusTool, config.ExtraProperties)
+		QueryWriteStatusTool = runtime.AddExtraPropertiesToTool(QueryWriteStatusTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusTool = runtime.BindFieldsToTool(QueryWriteStatusTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -46,32 +481,141 @@ func RegisterByteStreamHandler(s *mcpserver.MCPServer, srv ByteStreamServer, opt
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			return srv.QueryWriteStatus(ctx, req.(*bytestream.QueryWriteStatusRequest))
+		})
 		if err != nil {
-			return nil, err
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := srv.QueryWriteStatus(ctx, &req)
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ReadTool := ByteStream_ReadTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ReadTool = runtime.TransformTool(ReadTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadTool = runtime.AddExtraPropertiesToTool(ReadTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadTool = runtime.BindFieldsToTool(ReadTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.Read(req.(*bytestream.ReadRequest), runtime.NewServerStream[bytestream.ReadResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+	WriteTool := ByteStream_WriteTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WriteTool = runtime.TransformTool(WriteTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteTool = runtime.AddExtraPropertiesToTool(WriteTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteTool = runtime.BindFieldsToTool(WriteTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			stream := runtime.NewClientStream[bytestream.WriteRequest, bytestream.WriteResponse](ctx, req.([]*bytestream.WriteRequest), nil)
+			if err := srv.Write(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -84,7 +628,11 @@ func RegisterByteStreamHandlerOpenAI(s *mcpserver.MCPServer, srv ByteStreamServe
 	QueryWriteStatusToolOpenAI := ByteStream_QueryWriteStatusToolOpenAI
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		QueryWriteStatusToolOpenAI = runtime.AddExtraPropertiesToTool(QueryWriteStatusToolOpenAI, config.ExtraProperties)
+		QueryWriteStatusToolOpenAI = runtime.AddExtraPropertiesToTool(QueryWriteStatusToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusToolOpenAI = runtime.BindFieldsToTool(QueryWriteStatusToolOpenAI, config.FieldBindings, false)
 	}
 
 	s.AddTool(QueryWriteStatusToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -93,34 +641,285 @@ func RegisterByteStreamHandlerOpenAI(s *mcpserver.MCPServer, srv ByteStreamServe
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			return srv.QueryWriteStatus(ctx, req.(*bytestream.QueryWriteStatusRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
 
-		marshaled, err := json.Marshal(message)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ReadToolOpenAI := ByteStream_ReadToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadToolOpenAI = runtime.AddExtraPropertiesToTool(ReadToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadToolOpenAI = runtime.BindFieldsToTool(ReadToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.Read(req.(*bytestream.ReadRequest), runtime.NewServerStream[bytestream.ReadResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+	WriteToolOpenAI := ByteStream_WriteToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteToolOpenAI = runtime.AddExtraPropertiesToTool(WriteToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteToolOpenAI = runtime.BindFieldsToTool(WriteToolOpenAI, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, runtime.LLMProviderOpenAI)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			stream := runtime.NewClientStream[bytestream.WriteRequest, bytestream.WriteResponse](ctx, req.([]*bytestream.WriteRequest), nil)
+			if err := srv.Write(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := srv.QueryWriteStatus(ctx, &req)
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterByteStreamHandlerGemini registers Gemini-compatible MCP handlers for ByteStream
+func RegisterByteStreamHandlerGemini(s *mcpserver.MCPServer, srv ByteStreamServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	QueryWriteStatusToolGemini := ByteStream_QueryWriteStatusToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		QueryWriteStatusToolGemini = runtime.AddExtraPropertiesToTool(QueryWriteStatusToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusToolGemini = runtime.BindFieldsToTool(QueryWriteStatusToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(QueryWriteStatusToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.QueryWriteStatusRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			return srv.QueryWriteStatus(ctx, req.(*bytestream.QueryWriteStatusRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ReadToolGemini := ByteStream_ReadToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadToolGemini = runtime.AddExtraPropertiesToTool(ReadToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadToolGemini = runtime.BindFieldsToTool(ReadToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.Read(req.(*bytestream.ReadRequest), runtime.NewServerStream[bytestream.ReadResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+	WriteToolGemini := ByteStream_WriteToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteToolGemini = runtime.AddExtraPropertiesToTool(WriteToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteToolGemini = runtime.BindFieldsToTool(WriteToolGemini, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, runtime.LLMProviderGemini)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			stream := runtime.NewClientStream[bytestream.WriteRequest, bytestream.WriteResponse](ctx, req.([]*bytestream.WriteRequest), nil)
+			if err := srv.Write(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -129,7 +928,9 @@ func RegisterByteStreamHandlerWithProvider(s *mcpserver.MCPServer, srv ByteStrea
 	switch provider {
 	case runtime.LLMProviderOpenAI:
 		RegisterByteStreamHandlerOpenAI(s, srv, opts...)
-	case runtime.LLMProviderStandard:
+	case runtime.LLMProviderGemini:
+		RegisterByteStreamHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
 		fallthrough
 	default:
 		RegisterByteStreamHandler(s, srv, opts...)
@@ -139,11 +940,15 @@ func RegisterByteStreamHandlerWithProvider(s *mcpserver.MCPServer, srv ByteStrea
 // ByteStreamClient is compatible with the grpc-go client interface.
 type ByteStreamClient interface {
 	QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest, opts ...grpc.CallOption) (*bytestream.QueryWriteStatusResponse, error)
+	Read(ctx context.Context, req *bytestream.ReadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[bytestream.ReadResponse], error)
+	Write(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[bytestream.WriteRequest, bytestream.WriteResponse], error)
 }
 
 // ConnectByteStreamClient is compatible with the connectrpc-go client interface.
 type ConnectByteStreamClient interface {
 	QueryWriteStatus(ctx context.Context, req *connect.Request[bytestream.QueryWriteStatusRequest]) (*connect.Response[bytestream.QueryWriteStatusResponse], error)
+	Read(ctx context.Context, req *connect.Request[bytestream.ReadRequest]) (*connect.ServerStreamForClient[bytestream.ReadResponse], error)
+	Write(ctx context.Context) *connect.ClientStreamForClient[bytestream.WriteRequest, bytestream.WriteResponse]
 }
 
 // ForwardToConnectByteStreamClient registers a connectrpc client, to forward MCP calls to it.
@@ -152,10 +957,23 @@ func ForwardToConnectByteStreamClient(s *mcpserver.MCPServer, client ConnectByte
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		QueryWriteStatusTool = runtime.TransformTool(QueryWriteStatusTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		QueryWriteStatusTool = runtime.AddExtraPropertiesToTool(QueryWriteStatusTool, config.ExtraProperties)
+		QueryWriteStatusTool = runtime.AddExtraPropertiesToTool(QueryWriteStatusTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusTool = runtime.BindFieldsToTool(QueryWriteStatusTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -164,31 +982,170 @@ func ForwardToConnectByteStreamClient(s *mcpserver.MCPServer, client ConnectByte
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			connectReq := connect.NewRequest(req.(*bytestream.QueryWriteStatusRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.QueryWriteStatus(ctx, connectReq)
+			if err != nil {
+				return nil, err
 			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ReadTool := ByteStream_ReadTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ReadTool = runtime.TransformTool(ReadTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadTool = runtime.AddExtraPropertiesToTool(ReadTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadTool = runtime.BindFieldsToTool(ReadTool, config.FieldBindings, false)
+	}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+	s.AddTool(ReadTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			connectReq := connect.NewRequest(req.(*bytestream.ReadRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			stream, err := client.Read(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			defer stream.Close()
+
+			for stream.Receive() {
+				if err := collector.Add(stream.Msg()); err != nil {
+					return nil, err
+				}
+			}
+			return nil, stream.Err()
+		})
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		return collector.Result()
+	})
+	WriteTool := ByteStream_WriteTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WriteTool = runtime.TransformTool(WriteTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteTool = runtime.AddExtraPropertiesToTool(WriteTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteTool = runtime.BindFieldsToTool(WriteTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		resp, err := client.QueryWriteStatus(ctx, connect.NewRequest(&req))
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream := client.Write(ctx)
+			runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+			if err := runtime.SendAll(req.([]*bytestream.WriteRequest), stream.Send); err != nil {
+				return nil, err
+			}
+			resp, err := stream.CloseAndReceive()
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -198,10 +1155,23 @@ func ForwardToByteStreamClient(s *mcpserver.MCPServer, client ByteStreamClient,
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		QueryWriteStatusTool = runtime.TransformTool(QueryWriteStatusTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		QueryWriteStatusTool = runtime.AddExtraPropertiesToTool(QueryWriteStatusTool, config.ExtraProperties)
+		QueryWriteStatusTool = runtime.AddExtraPropertiesToTool(QueryWriteStatusTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusTool = runtime.BindFieldsToTool(QueryWriteStatusTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -210,30 +1180,154 @@ func ForwardToByteStreamClient(s *mcpserver.MCPServer, client ByteStreamClient,
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			return client.QueryWriteStatus(ctx, req.(*bytestream.QueryWriteStatusRequest))
+		})
 		if err != nil {
-			return nil, err
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
 			return nil, err
 		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ReadTool := ByteStream_ReadTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ReadTool = runtime.TransformTool(ReadTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadTool = runtime.AddExtraPropertiesToTool(ReadTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadTool = runtime.BindFieldsToTool(ReadTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
 
-		resp, err := client.QueryWriteStatus(ctx, &req)
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.Read(ctx, req.(*bytestream.ReadRequest))
+			if err != nil {
+				return nil, err
+			}
+			return nil, runtime.ReceiveAll(collector, stream.Recv)
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		return collector.Result()
+	})
+	WriteTool := ByteStream_WriteTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WriteTool = runtime.TransformTool(WriteTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteTool = runtime.AddExtraPropertiesToTool(WriteTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteTool = runtime.BindFieldsToTool(WriteTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.Write(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err := runtime.SendAll(req.([]*bytestream.WriteRequest), stream.Send); err != nil {
+				return nil, err
+			}
+			return stream.CloseAndRecv()
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
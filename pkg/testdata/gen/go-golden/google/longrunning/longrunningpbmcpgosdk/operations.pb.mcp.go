@@ -0,0 +1,3445 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: google/longrunning/operations.proto
+
+package longrunningpbmcpgosdk
+
+import (
+	longrunningpb "cloud.google.com/go/longrunning/autogen/longrunningpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"encoding/json"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	Operations_CancelOperationTool = &mcp.Tool{
+		Name:        "google_longrunning_Operations_CancelOperation",
+		Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.CancelOperation][google.longrunning.Operations.CancelOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be cancelled.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_DeleteOperationTool = &mcp.Tool{
+		Name:        "google_longrunning_Operations_DeleteOperation",
+		Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.DeleteOperation][google.longrunning.Operations.DeleteOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be deleted.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: runtime.BoolPtr(true), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_GetOperationTool = &mcp.Tool{
+		Name:        "google_longrunning_Operations_GetOperation",
+		Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.GetOperation][google.longrunning.Operations.GetOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsTool = &mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperations",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsAllTool = &mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperationsAll",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_WaitOperationTool = &mcp.Tool{
+		Name:        "google_longrunning_Operations_WaitOperation",
+		Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.WaitOperation][google.longrunning.Operations.WaitOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to wait on.",
+      "type": "string"
+    },
+    "timeout": {
+      "description": "The maximum duration to wait before timing out. If left blank, the wait\nwill be at most the time permitted by the underlying HTTP/RPC protocol.\nIf RPC context deadline is also specified, the shorter one will be used.",
+      "pattern": "^-?[0-9]+(\\.[0-9]+)?s$",
+      "type": [
+        "string",
+        "null"
+      ]
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_CancelOperationToolOpenAI = &mcp.Tool{
+		Name:        "google_longrunning_Operations_CancelOperation",
+		Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.CancelOperation][google.longrunning.Operations.CancelOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be cancelled.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_DeleteOperationToolOpenAI = &mcp.Tool{
+		Name:        "google_longrunning_Operations_DeleteOperation",
+		Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.DeleteOperation][google.longrunning.Operations.DeleteOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be deleted.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: runtime.BoolPtr(true), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_GetOperationToolOpenAI = &mcp.Tool{
+		Name:        "google_longrunning_Operations_GetOperation",
+		Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.GetOperation][google.longrunning.Operations.GetOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsToolOpenAI = &mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperations",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "filter",
+    "name",
+    "page_size",
+    "page_token"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsAllToolOpenAI = &mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperationsAll",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "filter",
+    "name",
+    "page_size",
+    "page_token"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_WaitOperationToolOpenAI = &mcp.Tool{
+		Name:        "google_longrunning_Operations_WaitOperation",
+		Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.WaitOperation][google.longrunning.Operations.WaitOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to wait on.",
+      "type": "string"
+    },
+    "timeout": {
+      "description": "The maximum duration to wait before timing out. If left blank, the wait\nwill be at most the time permitted by the underlying HTTP/RPC protocol.\nIf RPC context deadline is also specified, the shorter one will be used.",
+      "pattern": "^-?[0-9]+(\\.[0-9]+)?s$",
+      "type": [
+        "string",
+        "null"
+      ]
+    }
+  },
+  "required": [
+    "name",
+    "timeout"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_CancelOperationToolGemini = &mcp.Tool{
+		Name:        "google_longrunning_Operations_CancelOperation",
+		Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.CancelOperation][google.longrunning.Operations.CancelOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be cancelled.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_DeleteOperationToolGemini = &mcp.Tool{
+		Name:        "google_longrunning_Operations_DeleteOperation",
+		Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.DeleteOperation][google.longrunning.Operations.DeleteOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be deleted.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: runtime.BoolPtr(true), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_GetOperationToolGemini = &mcp.Tool{
+		Name:        "google_longrunning_Operations_GetOperation",
+		Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.GetOperation][google.longrunning.Operations.GetOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsToolGemini = &mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperations",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsAllToolGemini = &mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperationsAll",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_WaitOperationToolGemini = &mcp.Tool{
+		Name:        "google_longrunning_Operations_WaitOperation",
+		Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.WaitOperation][google.longrunning.Operations.WaitOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to wait on.",
+      "type": "string"
+    },
+    "timeout": {
+      "description": "The maximum duration to wait before timing out. If left blank, the wait\nwill be at most the time permitted by the underlying HTTP/RPC protocol.\nIf RPC context deadline is also specified, the shorter one will be used.",
+      "nullable": true,
+      "pattern": "^-?[0-9]+(\\.[0-9]+)?s$",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// OperationsServer is compatible with the grpc-go server interface.
+type OperationsServer interface {
+	CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest) (*emptypb.Empty, error)
+	DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest) (*emptypb.Empty, error)
+	GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest) (*longrunningpb.Operation, error)
+	ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest) (*longrunningpb.ListOperationsResponse, error)
+	WaitOperation(ctx context.Context, req *longrunningpb.WaitOperationRequest) (*longrunningpb.Operation, error)
+}
+
+// RegisterOperationsHandler registers standard MCP handlers for Operations
+func RegisterOperationsHandler(s *mcp.Server, srv OperationsServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CancelOperationTool := Operations_CancelOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CancelOperationTool = runtime.TransformToolGoSDK(CancelOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CancelOperationTool = runtime.AddExtraPropertiesToToolGoSDK(CancelOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationTool = runtime.BindFieldsToToolGoSDK(CancelOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CancelOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.CancelOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.CancelOperation(ctx, req.(*longrunningpb.CancelOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	DeleteOperationTool := Operations_DeleteOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteOperationTool = runtime.TransformToolGoSDK(DeleteOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteOperationTool = runtime.AddExtraPropertiesToToolGoSDK(DeleteOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationTool = runtime.BindFieldsToToolGoSDK(DeleteOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.DeleteOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.DeleteOperation(ctx, req.(*longrunningpb.DeleteOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetOperationTool := Operations_GetOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetOperationTool = runtime.TransformToolGoSDK(GetOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetOperationTool = runtime.AddExtraPropertiesToToolGoSDK(GetOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationTool = runtime.BindFieldsToToolGoSDK(GetOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.GetOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.GetOperation(ctx, req.(*longrunningpb.GetOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ListOperationsTool := Operations_ListOperationsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsTool = runtime.TransformToolGoSDK(ListOperationsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsTool = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsTool = runtime.BindFieldsToToolGoSDK(ListOperationsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return srv.ListOperations(ctx, req.(*longrunningpb.ListOperationsRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListOperationsAllTool follows the pages of ListOperations
+	ListOperationsAllTool := Operations_ListOperationsAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsAllTool = runtime.TransformToolGoSDK(ListOperationsAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllTool = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllTool = runtime.BindFieldsToToolGoSDK(ListOperationsAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", srv.ListOperations)
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	WaitOperationTool := Operations_WaitOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WaitOperationTool = runtime.TransformToolGoSDK(WaitOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WaitOperationTool = runtime.AddExtraPropertiesToToolGoSDK(WaitOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationTool = runtime.BindFieldsToToolGoSDK(WaitOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(WaitOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.WaitOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.WaitOperation(ctx, req.(*longrunningpb.WaitOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterOperationsHandlerOpenAI registers OpenAI-compatible MCP handlers for Operations
+func RegisterOperationsHandlerOpenAI(s *mcp.Server, srv OperationsServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CancelOperationToolOpenAI := Operations_CancelOperationToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CancelOperationToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(CancelOperationToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationToolOpenAI = runtime.BindFieldsToToolGoSDK(CancelOperationToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(CancelOperationToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.CancelOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.CancelOperation(ctx, req.(*longrunningpb.CancelOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	DeleteOperationToolOpenAI := Operations_DeleteOperationToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteOperationToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(DeleteOperationToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationToolOpenAI = runtime.BindFieldsToToolGoSDK(DeleteOperationToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteOperationToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.DeleteOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.DeleteOperation(ctx, req.(*longrunningpb.DeleteOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetOperationToolOpenAI := Operations_GetOperationToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetOperationToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(GetOperationToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationToolOpenAI = runtime.BindFieldsToToolGoSDK(GetOperationToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetOperationToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.GetOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.GetOperation(ctx, req.(*longrunningpb.GetOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ListOperationsToolOpenAI := Operations_ListOperationsToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsToolOpenAI = runtime.BindFieldsToToolGoSDK(ListOperationsToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return srv.ListOperations(ctx, req.(*longrunningpb.ListOperationsRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListOperationsAllToolOpenAI follows the pages of ListOperations
+	ListOperationsAllToolOpenAI := Operations_ListOperationsAllToolOpenAI
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsAllToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllToolOpenAI = runtime.BindFieldsToToolGoSDK(ListOperationsAllToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", srv.ListOperations)
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	WaitOperationToolOpenAI := Operations_WaitOperationToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WaitOperationToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(WaitOperationToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationToolOpenAI = runtime.BindFieldsToToolGoSDK(WaitOperationToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(WaitOperationToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.WaitOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.WaitOperation(ctx, req.(*longrunningpb.WaitOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterOperationsHandlerGemini registers Gemini-compatible MCP handlers for Operations
+func RegisterOperationsHandlerGemini(s *mcp.Server, srv OperationsServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CancelOperationToolGemini := Operations_CancelOperationToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CancelOperationToolGemini = runtime.AddExtraPropertiesToToolGoSDK(CancelOperationToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationToolGemini = runtime.BindFieldsToToolGoSDK(CancelOperationToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(CancelOperationToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.CancelOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.CancelOperation(ctx, req.(*longrunningpb.CancelOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	DeleteOperationToolGemini := Operations_DeleteOperationToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteOperationToolGemini = runtime.AddExtraPropertiesToToolGoSDK(DeleteOperationToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationToolGemini = runtime.BindFieldsToToolGoSDK(DeleteOperationToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteOperationToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.DeleteOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.DeleteOperation(ctx, req.(*longrunningpb.DeleteOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetOperationToolGemini := Operations_GetOperationToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetOperationToolGemini = runtime.AddExtraPropertiesToToolGoSDK(GetOperationToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationToolGemini = runtime.BindFieldsToToolGoSDK(GetOperationToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetOperationToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.GetOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.GetOperation(ctx, req.(*longrunningpb.GetOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ListOperationsToolGemini := Operations_ListOperationsToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsToolGemini = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsToolGemini = runtime.BindFieldsToToolGoSDK(ListOperationsToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return srv.ListOperations(ctx, req.(*longrunningpb.ListOperationsRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListOperationsAllToolGemini follows the pages of ListOperations
+	ListOperationsAllToolGemini := Operations_ListOperationsAllToolGemini
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllToolGemini = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsAllToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllToolGemini = runtime.BindFieldsToToolGoSDK(ListOperationsAllToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", srv.ListOperations)
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	WaitOperationToolGemini := Operations_WaitOperationToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WaitOperationToolGemini = runtime.AddExtraPropertiesToToolGoSDK(WaitOperationToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationToolGemini = runtime.BindFieldsToToolGoSDK(WaitOperationToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(WaitOperationToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.WaitOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.WaitOperation(ctx, req.(*longrunningpb.WaitOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterOperationsHandlerWithProvider registers handlers for the specified LLM provider
+func RegisterOperationsHandlerWithProvider(s *mcp.Server, srv OperationsServer, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterOperationsHandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterOperationsHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterOperationsHandler(s, srv, opts...)
+	}
+}
+
+// OperationsClient is compatible with the grpc-go client interface.
+type OperationsClient interface {
+	CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, opts ...grpc.CallOption) (*longrunningpb.Operation, error)
+	ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, opts ...grpc.CallOption) (*longrunningpb.ListOperationsResponse, error)
+	WaitOperation(ctx context.Context, req *longrunningpb.WaitOperationRequest, opts ...grpc.CallOption) (*longrunningpb.Operation, error)
+}
+
+// ConnectOperationsClient is compatible with the connectrpc-go client interface.
+type ConnectOperationsClient interface {
+	CancelOperation(ctx context.Context, req *connect.Request[longrunningpb.CancelOperationRequest]) (*connect.Response[emptypb.Empty], error)
+	DeleteOperation(ctx context.Context, req *connect.Request[longrunningpb.DeleteOperationRequest]) (*connect.Response[emptypb.Empty], error)
+	GetOperation(ctx context.Context, req *connect.Request[longrunningpb.GetOperationRequest]) (*connect.Response[longrunningpb.Operation], error)
+	ListOperations(ctx context.Context, req *connect.Request[longrunningpb.ListOperationsRequest]) (*connect.Response[longrunningpb.ListOperationsResponse], error)
+	WaitOperation(ctx context.Context, req *connect.Request[longrunningpb.WaitOperationRequest]) (*connect.Response[longrunningpb.Operation], error)
+}
+
+// ForwardToConnectOperationsClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectOperationsClient(s *mcp.Server, client ConnectOperationsClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CancelOperationTool := Operations_CancelOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CancelOperationTool = runtime.TransformToolGoSDK(CancelOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CancelOperationTool = runtime.AddExtraPropertiesToToolGoSDK(CancelOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationTool = runtime.BindFieldsToToolGoSDK(CancelOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CancelOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.CancelOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.CancelOperationRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.CancelOperation(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	DeleteOperationTool := Operations_DeleteOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteOperationTool = runtime.TransformToolGoSDK(DeleteOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteOperationTool = runtime.AddExtraPropertiesToToolGoSDK(DeleteOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationTool = runtime.BindFieldsToToolGoSDK(DeleteOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.DeleteOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.DeleteOperationRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.DeleteOperation(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetOperationTool := Operations_GetOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetOperationTool = runtime.TransformToolGoSDK(GetOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetOperationTool = runtime.AddExtraPropertiesToToolGoSDK(GetOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationTool = runtime.BindFieldsToToolGoSDK(GetOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.GetOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.GetOperationRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.GetOperation(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ListOperationsTool := Operations_ListOperationsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsTool = runtime.TransformToolGoSDK(ListOperationsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsTool = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsTool = runtime.BindFieldsToToolGoSDK(ListOperationsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.ListOperationsRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.ListOperations(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListOperationsAllTool follows the pages of ListOperations
+	ListOperationsAllTool := Operations_ListOperationsAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsAllTool = runtime.TransformToolGoSDK(ListOperationsAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllTool = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllTool = runtime.BindFieldsToToolGoSDK(ListOperationsAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", func(ctx context.Context, req *longrunningpb.ListOperationsRequest) (*longrunningpb.ListOperationsResponse, error) {
+				connectReq := connect.NewRequest(req)
+				runtime.SetConnectHeaders(ctx, connectReq.Header())
+				resp, err := client.ListOperations(ctx, connectReq)
+				if err != nil {
+					return nil, err
+				}
+				return resp.Msg, nil
+			})
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	WaitOperationTool := Operations_WaitOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WaitOperationTool = runtime.TransformToolGoSDK(WaitOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WaitOperationTool = runtime.AddExtraPropertiesToToolGoSDK(WaitOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationTool = runtime.BindFieldsToToolGoSDK(WaitOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(WaitOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.WaitOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.WaitOperationRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.WaitOperation(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// ForwardToOperationsClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToOperationsClient(s *mcp.Server, client OperationsClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CancelOperationTool := Operations_CancelOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CancelOperationTool = runtime.TransformToolGoSDK(CancelOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CancelOperationTool = runtime.AddExtraPropertiesToToolGoSDK(CancelOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationTool = runtime.BindFieldsToToolGoSDK(CancelOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CancelOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.CancelOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return client.CancelOperation(ctx, req.(*longrunningpb.CancelOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	DeleteOperationTool := Operations_DeleteOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteOperationTool = runtime.TransformToolGoSDK(DeleteOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteOperationTool = runtime.AddExtraPropertiesToToolGoSDK(DeleteOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationTool = runtime.BindFieldsToToolGoSDK(DeleteOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.DeleteOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return client.DeleteOperation(ctx, req.(*longrunningpb.DeleteOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetOperationTool := Operations_GetOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetOperationTool = runtime.TransformToolGoSDK(GetOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetOperationTool = runtime.AddExtraPropertiesToToolGoSDK(GetOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationTool = runtime.BindFieldsToToolGoSDK(GetOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.GetOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return client.GetOperation(ctx, req.(*longrunningpb.GetOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ListOperationsTool := Operations_ListOperationsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsTool = runtime.TransformToolGoSDK(ListOperationsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsTool = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsTool = runtime.BindFieldsToToolGoSDK(ListOperationsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return client.ListOperations(ctx, req.(*longrunningpb.ListOperationsRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListOperationsAllTool follows the pages of ListOperations
+	ListOperationsAllTool := Operations_ListOperationsAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsAllTool = runtime.TransformToolGoSDK(ListOperationsAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllTool = runtime.AddExtraPropertiesToToolGoSDK(ListOperationsAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllTool = runtime.BindFieldsToToolGoSDK(ListOperationsAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", func(ctx context.Context, req *longrunningpb.ListOperationsRequest) (*longrunningpb.ListOperationsResponse, error) {
+				return client.ListOperations(ctx, req)
+			})
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	WaitOperationTool := Operations_WaitOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WaitOperationTool = runtime.TransformToolGoSDK(WaitOperationTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WaitOperationTool = runtime.AddExtraPropertiesToToolGoSDK(WaitOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationTool = runtime.BindFieldsToToolGoSDK(WaitOperationTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(WaitOperationTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.WaitOperationRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return client.WaitOperation(ctx, req.(*longrunningpb.WaitOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
@@ -42,6 +42,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Priority int32
+
+const (
+	Priority_PRIORITY_UNSPECIFIED Priority = 0
+	Priority_PRIORITY_LOW         Priority = 1
+	Priority_PRIORITY_HIGH        Priority = 2
+)
+
+// Enum value maps for Priority.
+var (
+	Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "PRIORITY_LOW",
+		2: "PRIORITY_HIGH",
+	}
+	Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_LOW":         1,
+		"PRIORITY_HIGH":        2,
+	}
+)
+
+func (x Priority) Enum() *Priority {
+	p := new(Priority)
+	*p = x
+	return p
+}
+
+func (x Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_compatibility_test_proto_enumTypes[0].Descriptor()
+}
+
+func (Priority) Type() protoreflect.EnumType {
+	return &file_testdata_compatibility_test_proto_enumTypes[0]
+}
+
+func (x Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Priority.Descriptor instead.
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_compatibility_test_proto_rawDescGZIP(), []int{0}
+}
+
 type TestMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SomeBytes     []byte                 `protobuf:"bytes,1,opt,name=some_bytes,json=someBytes,proto3" json:"some_bytes,omitempty"`
@@ -306,6 +355,98 @@ func (x *MapTestMessage) GetStringMap() map[string]string {
 	return nil
 }
 
+type NestedMapTestMessage struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Maps          []*MapTestMessage          `protobuf:"bytes,1,rep,name=maps,proto3" json:"maps,omitempty"`
+	MapMessages   map[string]*MapTestMessage `protobuf:"bytes,2,rep,name=map_messages,json=mapMessages,proto3" json:"map_messages,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Wkts          []*WktTestMessage          `protobuf:"bytes,3,rep,name=wkts,proto3" json:"wkts,omitempty"`
+	Values        map[string]*structpb.Value `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Structs       []*structpb.Struct         `protobuf:"bytes,5,rep,name=structs,proto3" json:"structs,omitempty"`
+	IntMap        map[int32]string           `protobuf:"bytes,6,rep,name=int_map,json=intMap,proto3" json:"int_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Priorities    map[bool]Priority          `protobuf:"bytes,7,rep,name=priorities,proto3" json:"priorities,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=testdata.Priority"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedMapTestMessage) Reset() {
+	*x = NestedMapTestMessage{}
+	mi := &file_testdata_compatibility_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedMapTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedMapTestMessage) ProtoMessage() {}
+
+func (x *NestedMapTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_compatibility_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedMapTestMessage.ProtoReflect.Descriptor instead.
+func (*NestedMapTestMessage) Descriptor() ([]byte, []int) {
+	return file_testdata_compatibility_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NestedMapTestMessage) GetMaps() []*MapTestMessage {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+func (x *NestedMapTestMessage) GetMapMessages() map[string]*MapTestMessage {
+	if x != nil {
+		return x.MapMessages
+	}
+	return nil
+}
+
+func (x *NestedMapTestMessage) GetWkts() []*WktTestMessage {
+	if x != nil {
+		return x.Wkts
+	}
+	return nil
+}
+
+func (x *NestedMapTestMessage) GetValues() map[string]*structpb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *NestedMapTestMessage) GetStructs() []*structpb.Struct {
+	if x != nil {
+		return x.Structs
+	}
+	return nil
+}
+
+func (x *NestedMapTestMessage) GetIntMap() map[int32]string {
+	if x != nil {
+		return x.IntMap
+	}
+	return nil
+}
+
+func (x *NestedMapTestMessage) GetPriorities() map[bool]Priority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
 var File_testdata_compatibility_test_proto protoreflect.FileDescriptor
 
 const file_testdata_compatibility_test_proto_rawDesc = "" +
@@ -342,8 +483,34 @@ const file_testdata_compatibility_test_proto_rawDesc = "" +
 	"string_map\x18\x01 \x03(\v2'.testdata.MapTestMessage.StringMapEntryR\tstringMap\x1a<\n" +
 	"\x0eStringMapEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\xb6\x01\n" +
-	"\fcom.testdataB\x16CompatibilityTestProtoP\x01ZNgithub.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8d\x06\n" +
+	"\x14NestedMapTestMessage\x12,\n" +
+	"\x04maps\x18\x01 \x03(\v2\x18.testdata.MapTestMessageR\x04maps\x12R\n" +
+	"\fmap_messages\x18\x02 \x03(\v2/.testdata.NestedMapTestMessage.MapMessagesEntryR\vmapMessages\x12,\n" +
+	"\x04wkts\x18\x03 \x03(\v2\x18.testdata.WktTestMessageR\x04wkts\x12B\n" +
+	"\x06values\x18\x04 \x03(\v2*.testdata.NestedMapTestMessage.ValuesEntryR\x06values\x121\n" +
+	"\astructs\x18\x05 \x03(\v2\x17.google.protobuf.StructR\astructs\x12C\n" +
+	"\aint_map\x18\x06 \x03(\v2*.testdata.NestedMapTestMessage.IntMapEntryR\x06intMap\x12N\n" +
+	"\n" +
+	"priorities\x18\a \x03(\v2..testdata.NestedMapTestMessage.PrioritiesEntryR\n" +
+	"priorities\x1aX\n" +
+	"\x10MapMessagesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.testdata.MapTestMessageR\x05value:\x028\x01\x1aQ\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\x1a9\n" +
+	"\vIntMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aQ\n" +
+	"\x0fPrioritiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\bR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x12.testdata.PriorityR\x05value:\x028\x01*I\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fPRIORITY_LOW\x10\x01\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x02B\xb0\x01\n" +
+	"\fcom.testdataB\x16CompatibilityTestProtoP\x01ZHgithub.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
 
 var (
 	file_testdata_compatibility_test_proto_rawDescOnce sync.Once
@@ -357,45 +524,62 @@ func file_testdata_compatibility_test_proto_rawDescGZIP() []byte {
 	return file_testdata_compatibility_test_proto_rawDescData
 }
 
-var file_testdata_compatibility_test_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_testdata_compatibility_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_compatibility_test_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_testdata_compatibility_test_proto_goTypes = []any{
-	(*TestMessage)(nil),            // 0: testdata.TestMessage
-	(*RequiredFieldTest)(nil),      // 1: testdata.RequiredFieldTest
-	(*WktTestMessage)(nil),         // 2: testdata.WktTestMessage
-	(*MapTestMessage)(nil),         // 3: testdata.MapTestMessage
-	nil,                            // 4: testdata.MapTestMessage.StringMapEntry
-	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),    // 6: google.protobuf.Duration
-	(*structpb.Struct)(nil),        // 7: google.protobuf.Struct
-	(*structpb.Value)(nil),         // 8: google.protobuf.Value
-	(*structpb.ListValue)(nil),     // 9: google.protobuf.ListValue
-	(*fieldmaskpb.FieldMask)(nil),  // 10: google.protobuf.FieldMask
-	(*anypb.Any)(nil),              // 11: google.protobuf.Any
-	(*wrapperspb.StringValue)(nil), // 12: google.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),  // 13: google.protobuf.Int32Value
-	(*wrapperspb.Int64Value)(nil),  // 14: google.protobuf.Int64Value
-	(*wrapperspb.BoolValue)(nil),   // 15: google.protobuf.BoolValue
-	(*wrapperspb.BytesValue)(nil),  // 16: google.protobuf.BytesValue
+	(Priority)(0),                  // 0: testdata.Priority
+	(*TestMessage)(nil),            // 1: testdata.TestMessage
+	(*RequiredFieldTest)(nil),      // 2: testdata.RequiredFieldTest
+	(*WktTestMessage)(nil),         // 3: testdata.WktTestMessage
+	(*MapTestMessage)(nil),         // 4: testdata.MapTestMessage
+	(*NestedMapTestMessage)(nil),   // 5: testdata.NestedMapTestMessage
+	nil,                            // 6: testdata.MapTestMessage.StringMapEntry
+	nil,                            // 7: testdata.NestedMapTestMessage.MapMessagesEntry
+	nil,                            // 8: testdata.NestedMapTestMessage.ValuesEntry
+	nil,                            // 9: testdata.NestedMapTestMessage.IntMapEntry
+	nil,                            // 10: testdata.NestedMapTestMessage.PrioritiesEntry
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 12: google.protobuf.Duration
+	(*structpb.Struct)(nil),        // 13: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 14: google.protobuf.Value
+	(*structpb.ListValue)(nil),     // 15: google.protobuf.ListValue
+	(*fieldmaskpb.FieldMask)(nil),  // 16: google.protobuf.FieldMask
+	(*anypb.Any)(nil),              // 17: google.protobuf.Any
+	(*wrapperspb.StringValue)(nil), // 18: google.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),  // 19: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),  // 20: google.protobuf.Int64Value
+	(*wrapperspb.BoolValue)(nil),   // 21: google.protobuf.BoolValue
+	(*wrapperspb.BytesValue)(nil),  // 22: google.protobuf.BytesValue
 }
 var file_testdata_compatibility_test_proto_depIdxs = []int32{
-	5,  // 0: testdata.WktTestMessage.timestamp:type_name -> google.protobuf.Timestamp
-	6,  // 1: testdata.WktTestMessage.duration:type_name -> google.protobuf.Duration
-	7,  // 2: testdata.WktTestMessage.struct_field:type_name -> google.protobuf.Struct
-	8,  // 3: testdata.WktTestMessage.value_field:type_name -> google.protobuf.Value
-	9,  // 4: testdata.WktTestMessage.list_value:type_name -> google.protobuf.ListValue
-	10, // 5: testdata.WktTestMessage.field_mask:type_name -> google.protobuf.FieldMask
-	11, // 6: testdata.WktTestMessage.any:type_name -> google.protobuf.Any
-	12, // 7: testdata.WktTestMessage.string_value:type_name -> google.protobuf.StringValue
-	13, // 8: testdata.WktTestMessage.int32_value:type_name -> google.protobuf.Int32Value
-	14, // 9: testdata.WktTestMessage.int64_value:type_name -> google.protobuf.Int64Value
-	15, // 10: testdata.WktTestMessage.bool_value:type_name -> google.protobuf.BoolValue
-	16, // 11: testdata.WktTestMessage.bytes_value:type_name -> google.protobuf.BytesValue
-	4,  // 12: testdata.MapTestMessage.string_map:type_name -> testdata.MapTestMessage.StringMapEntry
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	11, // 0: testdata.WktTestMessage.timestamp:type_name -> google.protobuf.Timestamp
+	12, // 1: testdata.WktTestMessage.duration:type_name -> google.protobuf.Duration
+	13, // 2: testdata.WktTestMessage.struct_field:type_name -> google.protobuf.Struct
+	14, // 3: testdata.WktTestMessage.value_field:type_name -> google.protobuf.Value
+	15, // 4: testdata.WktTestMessage.list_value:type_name -> google.protobuf.ListValue
+	16, // 5: testdata.WktTestMessage.field_mask:type_name -> google.protobuf.FieldMask
+	17, // 6: testdata.WktTestMessage.any:type_name -> google.protobuf.Any
+	18, // 7: testdata.WktTestMessage.string_value:type_name -> google.protobuf.StringValue
+	19, // 8: testdata.WktTestMessage.int32_value:type_name -> google.protobuf.Int32Value
+	20, // 9: testdata.WktTestMessage.int64_value:type_name -> google.protobuf.Int64Value
+	21, // 10: testdata.WktTestMessage.bool_value:type_name -> google.protobuf.BoolValue
+	22, // 11: testdata.WktTestMessage.bytes_value:type_name -> google.protobuf.BytesValue
+	6,  // 12: testdata.MapTestMessage.string_map:type_name -> testdata.MapTestMessage.StringMapEntry
+	4,  // 13: testdata.NestedMapTestMessage.maps:type_name -> testdata.MapTestMessage
+	7,  // 14: testdata.NestedMapTestMessage.map_messages:type_name -> testdata.NestedMapTestMessage.MapMessagesEntry
+	3,  // 15: testdata.NestedMapTestMessage.wkts:type_name -> testdata.WktTestMessage
+	8,  // 16: testdata.NestedMapTestMessage.values:type_name -> testdata.NestedMapTestMessage.ValuesEntry
+	13, // 17: testdata.NestedMapTestMessage.structs:type_name -> google.protobuf.Struct
+	9,  // 18: testdata.NestedMapTestMessage.int_map:type_name -> testdata.NestedMapTestMessage.IntMapEntry
+	10, // 19: testdata.NestedMapTestMessage.priorities:type_name -> testdata.NestedMapTestMessage.PrioritiesEntry
+	4,  // 20: testdata.NestedMapTestMessage.MapMessagesEntry.value:type_name -> testdata.MapTestMessage
+	14, // 21: testdata.NestedMapTestMessage.ValuesEntry.value:type_name -> google.protobuf.Value
+	0,  // 22: testdata.NestedMapTestMessage.PrioritiesEntry.value:type_name -> testdata.Priority
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_testdata_compatibility_test_proto_init() }
@@ -408,13 +592,14 @@ func file_testdata_compatibility_test_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_compatibility_test_proto_rawDesc), len(file_testdata_compatibility_test_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_testdata_compatibility_test_proto_goTypes,
 		DependencyIndexes: file_testdata_compatibility_test_proto_depIdxs,
+		EnumInfos:         file_testdata_compatibility_test_proto_enumTypes,
 		MessageInfos:      file_testdata_compatibility_test_proto_msgTypes,
 	}.Build()
 	File_testdata_compatibility_test_proto = out.File
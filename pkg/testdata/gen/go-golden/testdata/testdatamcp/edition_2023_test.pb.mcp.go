@@ -4,7 +4,7 @@
 package testdatamcp
 
 import (
-	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
 )
 
 import (
@@ -19,12 +19,643 @@ import (
 )
 
 var (
-	TestServiceEdition2023_CreateItemTool                  = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "testdata_TestServiceEdition2023_CreateItem", Description: "CreateItem creates a new item\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x6e, 0x79, 0x4f, 0x66, 0x22, 0x3a, 0x5b, 0x7b, 0x22, 0x24, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x22, 0x49, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x20, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x22, 0x2c, 0x22, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0x3a, 0x5b, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x5d, 0x7d, 0x2c, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x5d, 0x7d, 0x5d, 0x7d, 0x5d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x2c, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x62, 0x79, 0x74, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	TestServiceEdition2023_GetItemTool                     = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "testdata_TestServiceEdition2023_GetItem", Description: "GetItem retrieves an item by ID\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	TestServiceEdition2023_ProcessWellKnownTypesTool       = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "testdata_TestServiceEdition2023_ProcessWellKnownTypes", Description: "Test well-known types handling\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	TestServiceEdition2023_CreateItemToolOpenAI            = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "testdata_TestServiceEdition2023_CreateItem", Description: "CreateItem creates a new item\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x2c, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6b, 0x65, 0x79, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6b, 0x65, 0x79, 0x22, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x4e, 0x6f, 0x74, 0x65, 0x3a, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x73, 0x20, 0x70, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x27, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x27, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x61, 0x74, 0x20, 0x61, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x57, 0x49, 0x4c, 0x4c, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x20, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x20, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x20, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x73, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x2c, 0x22, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x4e, 0x6f, 0x74, 0x65, 0x3a, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x73, 0x20, 0x70, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x27, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x27, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x61, 0x74, 0x20, 0x61, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x20, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x57, 0x49, 0x4c, 0x4c, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x20, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x20, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x20, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x73, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x22, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x22, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x2c, 0x22, 0x74, 0x61, 0x67, 0x73, 0x22, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x2c, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	TestServiceEdition2023_GetItemToolOpenAI               = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "testdata_TestServiceEdition2023_GetItem", Description: "GetItem retrieves an item by ID\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x69, 0x64, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	TestServiceEdition2023_ProcessWellKnownTypesToolOpenAI = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "testdata_TestServiceEdition2023_ProcessWellKnownTypes", Description: "Test well-known types handling\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2c, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x2c, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x29, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x7b, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2c, 0x20, 0x61, 0x20, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x7b, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2c, 0x22, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
+	TestServiceEdition2023_CreateItemTool = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_CreateItem",
+		Description: "CreateItem creates a new item\n",
+		RawInputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "product": {
+              "properties": {
+                "price": {
+                  "type": "number"
+                },
+                "quantity": {
+                  "type": "integer"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "product"
+          ]
+        },
+        {
+          "properties": {
+            "service": {
+              "properties": {
+                "duration": {
+                  "type": "string"
+                },
+                "recurring": {
+                  "type": "boolean"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "service"
+          ]
+        }
+      ]
+    }
+  ],
+  "properties": {
+    "description": {
+      "description": "Optional field (no explicit 'optional' keyword needed in edition 2023)",
+      "type": "string"
+    },
+    "labels": {
+      "additionalProperties": {
+        "type": "string"
+      },
+      "description": "Map field (becomes array in OpenAI mode)",
+      "propertyNames": {
+        "type": "string"
+      },
+      "type": "object"
+    },
+    "name": {
+      "description": "Required field",
+      "type": "string"
+    },
+    "tags": {
+      "description": "Repeated field",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    },
+    "thumbnail": {
+      "contentEncoding": "base64",
+      "description": "Bytes field (has format: byte that should be removed in OpenAI)",
+      "format": "byte",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "created_at": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_GetItemTool = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_GetItem",
+		Description: "GetItem retrieves an item by ID\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "item": {
+      "properties": {
+        "created_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "labels": {
+          "additionalProperties": {
+            "type": "string"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        },
+        "updated_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_ProcessWellKnownTypesTool = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_ProcessWellKnownTypes",
+		Description: "Test well-known types handling\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "config": {
+      "description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object)."
+    },
+    "metadata": {
+      "additionalProperties": true,
+      "description": "Well-known types that need special handling in OpenAI mode\n\nrepresents a google.protobuf.Struct, a dynamic JSON object.",
+      "type": "object"
+    },
+    "payload": {
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "timestamp": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "message": {
+      "type": "string"
+    },
+    "success": {
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_CreateItemToolOpenAI = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_CreateItem",
+		Description: "CreateItem creates a new item\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "description": {
+      "description": "Optional field (no explicit 'optional' keyword needed in edition 2023)",
+      "type": "string"
+    },
+    "labels": {
+      "description": "Map field (becomes array in OpenAI mode)\n\nList of key value pairs",
+      "items": {
+        "additionalProperties": false,
+        "properties": {
+          "key": {
+            "type": "string"
+          },
+          "value": {
+            "type": "string"
+          }
+        },
+        "required": [
+          "key",
+          "value"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "name": {
+      "description": "Required field",
+      "type": "string"
+    },
+    "product": {
+      "additionalProperties": false,
+      "description": "Note: This field is part of a oneof group with \"service\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+      "properties": {
+        "price": {
+          "type": "number"
+        },
+        "quantity": {
+          "type": "integer"
+        }
+      },
+      "required": [
+        "price",
+        "quantity"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "service": {
+      "additionalProperties": false,
+      "description": "Note: This field is part of a oneof group with \"product\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+      "properties": {
+        "duration": {
+          "type": "string"
+        },
+        "recurring": {
+          "type": "boolean"
+        }
+      },
+      "required": [
+        "duration",
+        "recurring"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "tags": {
+      "description": "Repeated field",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    },
+    "thumbnail": {
+      "description": "Bytes field (has format: byte that should be removed in OpenAI)\n\nBase64 encoded.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name",
+    "description",
+    "labels",
+    "product",
+    "service",
+    "tags",
+    "thumbnail"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "created_at": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_GetItemToolOpenAI = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_GetItem",
+		Description: "GetItem retrieves an item by ID\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [
+    "id"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "item": {
+      "properties": {
+        "created_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "labels": {
+          "additionalProperties": {
+            "type": "string"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        },
+        "updated_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_ProcessWellKnownTypesToolOpenAI = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_ProcessWellKnownTypes",
+		Description: "Test well-known types handling\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "config": {
+      "description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).\n\nMust be a string representation of any JSON value.",
+      "type": "string"
+    },
+    "metadata": {
+      "description": "Well-known types that need special handling in OpenAI mode\n\nrepresents a google.protobuf.Struct, a dynamic JSON object.\n\nMust be a string representation of any JSON object.",
+      "type": "string"
+    },
+    "payload": {
+      "description": "Must be a string representation of a JSON object with an \"@type\" field.",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "timestamp": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    }
+  },
+  "required": [
+    "config",
+    "metadata",
+    "payload",
+    "timestamp"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "message": {
+      "type": "string"
+    },
+    "success": {
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_CreateItemToolGemini = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_CreateItem",
+		Description: "CreateItem creates a new item\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "description": {
+      "description": "Optional field (no explicit 'optional' keyword needed in edition 2023)",
+      "type": "string"
+    },
+    "labels": {
+      "description": "Map field (becomes array in OpenAI mode)\n\nList of key value pairs",
+      "items": {
+        "properties": {
+          "key": {
+            "type": "string"
+          },
+          "value": {
+            "type": "string"
+          }
+        },
+        "required": [
+          "key",
+          "value"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "name": {
+      "description": "Required field",
+      "type": "string"
+    },
+    "product": {
+      "description": "Note: This field is part of a oneof group with \"service\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+      "nullable": true,
+      "properties": {
+        "price": {
+          "type": "number"
+        },
+        "quantity": {
+          "type": "integer"
+        }
+      },
+      "required": [],
+      "type": "object"
+    },
+    "service": {
+      "description": "Note: This field is part of a oneof group with \"product\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+      "nullable": true,
+      "properties": {
+        "duration": {
+          "type": "string"
+        },
+        "recurring": {
+          "type": "boolean"
+        }
+      },
+      "required": [],
+      "type": "object"
+    },
+    "tags": {
+      "description": "Repeated field",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    },
+    "thumbnail": {
+      "description": "Bytes field (has format: byte that should be removed in OpenAI)\n\nBase64 encoded.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "created_at": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_GetItemToolGemini = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_GetItem",
+		Description: "GetItem retrieves an item by ID\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "item": {
+      "properties": {
+        "created_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "labels": {
+          "additionalProperties": {
+            "type": "string"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        },
+        "updated_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_ProcessWellKnownTypesToolGemini = mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_ProcessWellKnownTypes",
+		Description: "Test well-known types handling\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "config": {
+      "description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).\n\nMust be a string representation of any JSON value.",
+      "type": "string"
+    },
+    "metadata": {
+      "description": "Well-known types that need special handling in OpenAI mode\n\nrepresents a google.protobuf.Struct, a dynamic JSON object.\n\nMust be a string representation of any JSON object.",
+      "type": "string"
+    },
+    "payload": {
+      "description": "Must be a string representation of a JSON object with an \"@type\" field.",
+      "nullable": true,
+      "type": "string"
+    },
+    "timestamp": {
+      "format": "date-time",
+      "nullable": true,
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "message": {
+      "type": "string"
+    },
+    "success": {
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
 )
 
 // TestServiceEdition2023Server is compatible with the grpc-go server interface.
@@ -40,10 +671,23 @@ func RegisterTestServiceEdition2023Handler(s *mcpserver.MCPServer, srv TestServi
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	CreateItemTool := TestServiceEdition2023_CreateItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateItemTool = runtime.TransformTool(CreateItemTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		CreateItemTool = runtime.AddExtraPropertiesToTool(CreateItemTool, config.ExtraProperties)
+		CreateItemTool = runtime.AddExtraPropertiesToTool(CreateItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemTool = runtime.BindFieldsToTool(CreateItemTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(CreateItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -52,37 +696,51 @@ func RegisterTestServiceEdition2023Handler(s *mcpserver.MCPServer, srv TestServi
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.CreateItem(ctx, &req)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			return srv.CreateItem(ctx, req.(*testdata.CreateItemRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	GetItemTool := TestServiceEdition2023_GetItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetItemTool = runtime.TransformTool(GetItemTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		GetItemTool = runtime.AddExtraPropertiesToTool(GetItemTool, config.ExtraProperties)
+		GetItemTool = runtime.AddExtraPropertiesToTool(GetItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemTool = runtime.BindFieldsToTool(GetItemTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(GetItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -91,37 +749,51 @@ func RegisterTestServiceEdition2023Handler(s *mcpserver.MCPServer, srv TestServi
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.GetItem(ctx, &req)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			return srv.GetItem(ctx, req.(*testdata.GetItemRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	ProcessWellKnownTypesTool := TestServiceEdition2023_ProcessWellKnownTypesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ProcessWellKnownTypesTool = runtime.TransformTool(ProcessWellKnownTypesTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesTool, config.ExtraProperties)
+		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesTool = runtime.BindFieldsToTool(ProcessWellKnownTypesTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -130,32 +802,38 @@ func RegisterTestServiceEdition2023Handler(s *mcpserver.MCPServer, srv TestServi
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.ProcessWellKnownTypes(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			return srv.ProcessWellKnownTypes(ctx, req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -168,7 +846,11 @@ func RegisterTestServiceEdition2023HandlerOpenAI(s *mcpserver.MCPServer, srv Tes
 	CreateItemToolOpenAI := TestServiceEdition2023_CreateItemToolOpenAI
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		CreateItemToolOpenAI = runtime.AddExtraPropertiesToTool(CreateItemToolOpenAI, config.ExtraProperties)
+		CreateItemToolOpenAI = runtime.AddExtraPropertiesToTool(CreateItemToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemToolOpenAI = runtime.BindFieldsToTool(CreateItemToolOpenAI, config.FieldBindings, false)
 	}
 
 	s.AddTool(CreateItemToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -177,39 +859,47 @@ func RegisterTestServiceEdition2023HandlerOpenAI(s *mcpserver.MCPServer, srv Tes
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.CreateItem(ctx, &req)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			return srv.CreateItem(ctx, req.(*testdata.CreateItemRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	GetItemToolOpenAI := TestServiceEdition2023_GetItemToolOpenAI
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		GetItemToolOpenAI = runtime.AddExtraPropertiesToTool(GetItemToolOpenAI, config.ExtraProperties)
+		GetItemToolOpenAI = runtime.AddExtraPropertiesToTool(GetItemToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemToolOpenAI = runtime.BindFieldsToTool(GetItemToolOpenAI, config.FieldBindings, false)
 	}
 
 	s.AddTool(GetItemToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -218,39 +908,47 @@ func RegisterTestServiceEdition2023HandlerOpenAI(s *mcpserver.MCPServer, srv Tes
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.GetItem(ctx, &req)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			return srv.GetItem(ctx, req.(*testdata.GetItemRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	ProcessWellKnownTypesToolOpenAI := TestServiceEdition2023_ProcessWellKnownTypesToolOpenAI
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		ProcessWellKnownTypesToolOpenAI = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesToolOpenAI, config.ExtraProperties)
+		ProcessWellKnownTypesToolOpenAI = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesToolOpenAI = runtime.BindFieldsToTool(ProcessWellKnownTypesToolOpenAI, config.FieldBindings, false)
 	}
 
 	s.AddTool(ProcessWellKnownTypesToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -259,34 +957,193 @@ func RegisterTestServiceEdition2023HandlerOpenAI(s *mcpserver.MCPServer, srv Tes
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
 
-		marshaled, err := json.Marshal(message)
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			return srv.ProcessWellKnownTypes(ctx, req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterTestServiceEdition2023HandlerGemini registers Gemini-compatible MCP handlers for TestServiceEdition2023
+func RegisterTestServiceEdition2023HandlerGemini(s *mcpserver.MCPServer, srv TestServiceEdition2023Server, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CreateItemToolGemini := TestServiceEdition2023_CreateItemToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateItemToolGemini = runtime.AddExtraPropertiesToTool(CreateItemToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemToolGemini = runtime.BindFieldsToTool(CreateItemToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateItemToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateItemRequestEdition2023
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			return srv.CreateItem(ctx, req.(*testdata.CreateItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := srv.ProcessWellKnownTypes(ctx, &req)
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	GetItemToolGemini := TestServiceEdition2023_GetItemToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetItemToolGemini = runtime.AddExtraPropertiesToTool(GetItemToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemToolGemini = runtime.BindFieldsToTool(GetItemToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetItemToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetItemRequestEdition2023
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			return srv.GetItem(ctx, req.(*testdata.GetItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ProcessWellKnownTypesToolGemini := TestServiceEdition2023_ProcessWellKnownTypesToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ProcessWellKnownTypesToolGemini = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesToolGemini = runtime.BindFieldsToTool(ProcessWellKnownTypesToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ProcessWellKnownTypesToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ProcessWellKnownTypesRequestEdition2023
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			return srv.ProcessWellKnownTypes(ctx, req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -295,7 +1152,9 @@ func RegisterTestServiceEdition2023HandlerWithProvider(s *mcpserver.MCPServer, s
 	switch provider {
 	case runtime.LLMProviderOpenAI:
 		RegisterTestServiceEdition2023HandlerOpenAI(s, srv, opts...)
-	case runtime.LLMProviderStandard:
+	case runtime.LLMProviderGemini:
+		RegisterTestServiceEdition2023HandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
 		fallthrough
 	default:
 		RegisterTestServiceEdition2023Handler(s, srv, opts...)
@@ -322,10 +1181,23 @@ func ForwardToConnectTestServiceEdition2023Client(s *mcpserver.MCPServer, client
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	CreateItemTool := TestServiceEdition2023_CreateItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateItemTool = runtime.TransformTool(CreateItemTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		CreateItemTool = runtime.AddExtraPropertiesToTool(CreateItemTool, config.ExtraProperties)
+		CreateItemTool = runtime.AddExtraPropertiesToTool(CreateItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemTool = runtime.BindFieldsToTool(CreateItemTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(CreateItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -334,36 +1206,58 @@ func ForwardToConnectTestServiceEdition2023Client(s *mcpserver.MCPServer, client
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.CreateItem(ctx, connect.NewRequest(&req))
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			connectReq := connect.NewRequest(req.(*testdata.CreateItemRequestEdition2023))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.CreateItem(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	GetItemTool := TestServiceEdition2023_GetItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetItemTool = runtime.TransformTool(GetItemTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		GetItemTool = runtime.AddExtraPropertiesToTool(GetItemTool, config.ExtraProperties)
+		GetItemTool = runtime.AddExtraPropertiesToTool(GetItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemTool = runtime.BindFieldsToTool(GetItemTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(GetItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -372,36 +1266,58 @@ func ForwardToConnectTestServiceEdition2023Client(s *mcpserver.MCPServer, client
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.GetItem(ctx, connect.NewRequest(&req))
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			connectReq := connect.NewRequest(req.(*testdata.GetItemRequestEdition2023))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.GetItem(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	ProcessWellKnownTypesTool := TestServiceEdition2023_ProcessWellKnownTypesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ProcessWellKnownTypesTool = runtime.TransformTool(ProcessWellKnownTypesTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesTool, config.ExtraProperties)
+		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesTool = runtime.BindFieldsToTool(ProcessWellKnownTypesTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -410,31 +1326,45 @@ func ForwardToConnectTestServiceEdition2023Client(s *mcpserver.MCPServer, client
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.ProcessWellKnownTypes(ctx, connect.NewRequest(&req))
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			connectReq := connect.NewRequest(req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.ProcessWellKnownTypes(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -444,10 +1374,23 @@ func ForwardToTestServiceEdition2023Client(s *mcpserver.MCPServer, client TestSe
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	CreateItemTool := TestServiceEdition2023_CreateItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateItemTool = runtime.TransformTool(CreateItemTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		CreateItemTool = runtime.AddExtraPropertiesToTool(CreateItemTool, config.ExtraProperties)
+		CreateItemTool = runtime.AddExtraPropertiesToTool(CreateItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemTool = runtime.BindFieldsToTool(CreateItemTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(CreateItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -456,36 +1399,52 @@ func ForwardToTestServiceEdition2023Client(s *mcpserver.MCPServer, client TestSe
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.CreateItem(ctx, &req)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			return client.CreateItem(ctx, req.(*testdata.CreateItemRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	GetItemTool := TestServiceEdition2023_GetItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetItemTool = runtime.TransformTool(GetItemTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		GetItemTool = runtime.AddExtraPropertiesToTool(GetItemTool, config.ExtraProperties)
+		GetItemTool = runtime.AddExtraPropertiesToTool(GetItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemTool = runtime.BindFieldsToTool(GetItemTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(GetItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -494,36 +1453,52 @@ func ForwardToTestServiceEdition2023Client(s *mcpserver.MCPServer, client TestSe
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.GetItem(ctx, &req)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			return client.GetItem(ctx, req.(*testdata.GetItemRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	ProcessWellKnownTypesTool := TestServiceEdition2023_ProcessWellKnownTypesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ProcessWellKnownTypesTool = runtime.TransformTool(ProcessWellKnownTypesTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesTool, config.ExtraProperties)
+		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesTool = runtime.BindFieldsToTool(ProcessWellKnownTypesTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -532,30 +1507,38 @@ func ForwardToTestServiceEdition2023Client(s *mcpserver.MCPServer, client TestSe
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.ProcessWellKnownTypes(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			return client.ProcessWellKnownTypes(ctx, req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
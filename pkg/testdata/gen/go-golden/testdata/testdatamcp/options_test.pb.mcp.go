@@ -0,0 +1,1603 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/options_test.proto
+
+package testdatamcp
+
+import (
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
+)
+
+import (
+	"context"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"connectrpc.com/connect"
+	grpc "google.golang.org/grpc"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+)
+
+var (
+	OptionsTestService_DeleteProductTool = mcp.Tool{
+		Name:        "remove_product",
+		Description: "Removes a product from the inventory. This cannot be undone.",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "description": "ID of the product to delete",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: mcp.ToBoolPtr(true), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	OptionsTestService_GetProductTool = mcp.Tool{
+		Name:        "inventory_GetProduct",
+		Description: "GetProduct returns a single product\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "description": "ID of the product",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "product": {
+      "description": "The product",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: mcp.ToBoolPtr(true), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	OptionsTestService_ListProductsTool = mcp.Tool{
+		Name:        "inventory_ListProducts",
+		Description: "ListProducts lists all products\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "products": {
+      "description": "All products",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the product",
+            "type": "string"
+          },
+          "name": {
+            "description": "Name of the product",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "List products", ReadOnlyHint: mcp.ToBoolPtr(true), DestructiveHint: (*bool)(nil), IdempotentHint: mcp.ToBoolPtr(true), OpenWorldHint: mcp.ToBoolPtr(false)},
+	}
+	OptionsTestService_UpdateProductTool = mcp.Tool{
+		Name:        "inventory_UpdateProduct",
+		Description: "UpdateProduct replaces a product\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "product": {
+      "description": "The product to update",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "product": {
+      "description": "The updated product",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: mcp.ToBoolPtr(true), OpenWorldHint: (*bool)(nil)},
+	}
+	OptionsTestService_DeleteProductToolOpenAI = mcp.Tool{
+		Name:        "remove_product",
+		Description: "Removes a product from the inventory. This cannot be undone.",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "id": {
+      "description": "ID of the product to delete",
+      "type": "string"
+    }
+  },
+  "required": [
+    "id"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: mcp.ToBoolPtr(true), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	OptionsTestService_GetProductToolOpenAI = mcp.Tool{
+		Name:        "inventory_GetProduct",
+		Description: "GetProduct returns a single product\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "id": {
+      "description": "ID of the product",
+      "type": "string"
+    }
+  },
+  "required": [
+    "id"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "product": {
+      "description": "The product",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: mcp.ToBoolPtr(true), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	OptionsTestService_ListProductsToolOpenAI = mcp.Tool{
+		Name:        "inventory_ListProducts",
+		Description: "ListProducts lists all products\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "products": {
+      "description": "All products",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the product",
+            "type": "string"
+          },
+          "name": {
+            "description": "Name of the product",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "List products", ReadOnlyHint: mcp.ToBoolPtr(true), DestructiveHint: (*bool)(nil), IdempotentHint: mcp.ToBoolPtr(true), OpenWorldHint: mcp.ToBoolPtr(false)},
+	}
+	OptionsTestService_UpdateProductToolOpenAI = mcp.Tool{
+		Name:        "inventory_UpdateProduct",
+		Description: "UpdateProduct replaces a product\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "product": {
+      "additionalProperties": false,
+      "description": "The product to update",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [
+        "id",
+        "name"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    }
+  },
+  "required": [
+    "product"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "product": {
+      "description": "The updated product",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: mcp.ToBoolPtr(true), OpenWorldHint: (*bool)(nil)},
+	}
+	OptionsTestService_DeleteProductToolGemini = mcp.Tool{
+		Name:        "remove_product",
+		Description: "Removes a product from the inventory. This cannot be undone.",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "description": "ID of the product to delete",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: mcp.ToBoolPtr(true), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	OptionsTestService_GetProductToolGemini = mcp.Tool{
+		Name:        "inventory_GetProduct",
+		Description: "GetProduct returns a single product\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "description": "ID of the product",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "product": {
+      "description": "The product",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: mcp.ToBoolPtr(true), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	OptionsTestService_ListProductsToolGemini = mcp.Tool{
+		Name:        "inventory_ListProducts",
+		Description: "ListProducts lists all products\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "products": {
+      "description": "All products",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the product",
+            "type": "string"
+          },
+          "name": {
+            "description": "Name of the product",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "List products", ReadOnlyHint: mcp.ToBoolPtr(true), DestructiveHint: (*bool)(nil), IdempotentHint: mcp.ToBoolPtr(true), OpenWorldHint: mcp.ToBoolPtr(false)},
+	}
+	OptionsTestService_UpdateProductToolGemini = mcp.Tool{
+		Name:        "inventory_UpdateProduct",
+		Description: "UpdateProduct replaces a product\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "product": {
+      "description": "The product to update",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "product": {
+      "description": "The updated product",
+      "properties": {
+        "id": {
+          "description": "ID of the product",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the product",
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: mcp.ToBoolPtr(true), OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// OptionsTestServiceServer is compatible with the grpc-go server interface.
+type OptionsTestServiceServer interface {
+	DeleteProduct(ctx context.Context, req *testdata.DeleteProductRequest) (*testdata.DeleteProductResponse, error)
+	GetProduct(ctx context.Context, req *testdata.GetProductRequest) (*testdata.GetProductResponse, error)
+	ListProducts(ctx context.Context, req *testdata.ListProductsRequest) (*testdata.ListProductsResponse, error)
+	UpdateProduct(ctx context.Context, req *testdata.UpdateProductRequest) (*testdata.UpdateProductResponse, error)
+}
+
+// RegisterOptionsTestServiceHandler registers standard MCP handlers for OptionsTestService
+func RegisterOptionsTestServiceHandler(s *mcpserver.MCPServer, srv OptionsTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	DeleteProductTool := OptionsTestService_DeleteProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteProductTool = runtime.TransformTool(DeleteProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteProductTool = runtime.AddExtraPropertiesToTool(DeleteProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteProductTool = runtime.BindFieldsToTool(DeleteProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.DeleteProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/DeleteProduct", &req, func(ctx context.Context, req any) (*testdata.DeleteProductResponse, error) {
+			return srv.DeleteProduct(ctx, req.(*testdata.DeleteProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	GetProductTool := OptionsTestService_GetProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetProductTool = runtime.TransformTool(GetProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetProductTool = runtime.AddExtraPropertiesToTool(GetProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetProductTool = runtime.BindFieldsToTool(GetProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/GetProduct", &req, func(ctx context.Context, req any) (*testdata.GetProductResponse, error) {
+			return srv.GetProduct(ctx, req.(*testdata.GetProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ListProductsTool := OptionsTestService_ListProductsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListProductsTool = runtime.TransformTool(ListProductsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListProductsTool = runtime.AddExtraPropertiesToTool(ListProductsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListProductsTool = runtime.BindFieldsToTool(ListProductsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListProductsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListProductsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/ListProducts", &req, func(ctx context.Context, req any) (*testdata.ListProductsResponse, error) {
+			return srv.ListProducts(ctx, req.(*testdata.ListProductsRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	UpdateProductTool := OptionsTestService_UpdateProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UpdateProductTool = runtime.TransformTool(UpdateProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateProductTool = runtime.AddExtraPropertiesToTool(UpdateProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateProductTool = runtime.BindFieldsToTool(UpdateProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/UpdateProduct", &req, func(ctx context.Context, req any) (*testdata.UpdateProductResponse, error) {
+			return srv.UpdateProduct(ctx, req.(*testdata.UpdateProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterOptionsTestServiceHandlerOpenAI registers OpenAI-compatible MCP handlers for OptionsTestService
+func RegisterOptionsTestServiceHandlerOpenAI(s *mcpserver.MCPServer, srv OptionsTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	DeleteProductToolOpenAI := OptionsTestService_DeleteProductToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteProductToolOpenAI = runtime.AddExtraPropertiesToTool(DeleteProductToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteProductToolOpenAI = runtime.BindFieldsToTool(DeleteProductToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteProductToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.DeleteProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/DeleteProduct", &req, func(ctx context.Context, req any) (*testdata.DeleteProductResponse, error) {
+			return srv.DeleteProduct(ctx, req.(*testdata.DeleteProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	GetProductToolOpenAI := OptionsTestService_GetProductToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetProductToolOpenAI = runtime.AddExtraPropertiesToTool(GetProductToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetProductToolOpenAI = runtime.BindFieldsToTool(GetProductToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetProductToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/GetProduct", &req, func(ctx context.Context, req any) (*testdata.GetProductResponse, error) {
+			return srv.GetProduct(ctx, req.(*testdata.GetProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ListProductsToolOpenAI := OptionsTestService_ListProductsToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListProductsToolOpenAI = runtime.AddExtraPropertiesToTool(ListProductsToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListProductsToolOpenAI = runtime.BindFieldsToTool(ListProductsToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListProductsToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListProductsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/ListProducts", &req, func(ctx context.Context, req any) (*testdata.ListProductsResponse, error) {
+			return srv.ListProducts(ctx, req.(*testdata.ListProductsRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	UpdateProductToolOpenAI := OptionsTestService_UpdateProductToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateProductToolOpenAI = runtime.AddExtraPropertiesToTool(UpdateProductToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateProductToolOpenAI = runtime.BindFieldsToTool(UpdateProductToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateProductToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/UpdateProduct", &req, func(ctx context.Context, req any) (*testdata.UpdateProductResponse, error) {
+			return srv.UpdateProduct(ctx, req.(*testdata.UpdateProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterOptionsTestServiceHandlerGemini registers Gemini-compatible MCP handlers for OptionsTestService
+func RegisterOptionsTestServiceHandlerGemini(s *mcpserver.MCPServer, srv OptionsTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	DeleteProductToolGemini := OptionsTestService_DeleteProductToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteProductToolGemini = runtime.AddExtraPropertiesToTool(DeleteProductToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteProductToolGemini = runtime.BindFieldsToTool(DeleteProductToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteProductToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.DeleteProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/DeleteProduct", &req, func(ctx context.Context, req any) (*testdata.DeleteProductResponse, error) {
+			return srv.DeleteProduct(ctx, req.(*testdata.DeleteProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	GetProductToolGemini := OptionsTestService_GetProductToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetProductToolGemini = runtime.AddExtraPropertiesToTool(GetProductToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetProductToolGemini = runtime.BindFieldsToTool(GetProductToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetProductToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/GetProduct", &req, func(ctx context.Context, req any) (*testdata.GetProductResponse, error) {
+			return srv.GetProduct(ctx, req.(*testdata.GetProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ListProductsToolGemini := OptionsTestService_ListProductsToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListProductsToolGemini = runtime.AddExtraPropertiesToTool(ListProductsToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListProductsToolGemini = runtime.BindFieldsToTool(ListProductsToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListProductsToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListProductsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/ListProducts", &req, func(ctx context.Context, req any) (*testdata.ListProductsResponse, error) {
+			return srv.ListProducts(ctx, req.(*testdata.ListProductsRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	UpdateProductToolGemini := OptionsTestService_UpdateProductToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateProductToolGemini = runtime.AddExtraPropertiesToTool(UpdateProductToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateProductToolGemini = runtime.BindFieldsToTool(UpdateProductToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateProductToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/UpdateProduct", &req, func(ctx context.Context, req any) (*testdata.UpdateProductResponse, error) {
+			return srv.UpdateProduct(ctx, req.(*testdata.UpdateProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterOptionsTestServiceHandlerWithProvider registers handlers for the specified LLM provider
+func RegisterOptionsTestServiceHandlerWithProvider(s *mcpserver.MCPServer, srv OptionsTestServiceServer, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterOptionsTestServiceHandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterOptionsTestServiceHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterOptionsTestServiceHandler(s, srv, opts...)
+	}
+}
+
+// OptionsTestServiceClient is compatible with the grpc-go client interface.
+type OptionsTestServiceClient interface {
+	DeleteProduct(ctx context.Context, req *testdata.DeleteProductRequest, opts ...grpc.CallOption) (*testdata.DeleteProductResponse, error)
+	GetProduct(ctx context.Context, req *testdata.GetProductRequest, opts ...grpc.CallOption) (*testdata.GetProductResponse, error)
+	ListProducts(ctx context.Context, req *testdata.ListProductsRequest, opts ...grpc.CallOption) (*testdata.ListProductsResponse, error)
+	UpdateProduct(ctx context.Context, req *testdata.UpdateProductRequest, opts ...grpc.CallOption) (*testdata.UpdateProductResponse, error)
+}
+
+// ConnectOptionsTestServiceClient is compatible with the connectrpc-go client interface.
+type ConnectOptionsTestServiceClient interface {
+	DeleteProduct(ctx context.Context, req *connect.Request[testdata.DeleteProductRequest]) (*connect.Response[testdata.DeleteProductResponse], error)
+	GetProduct(ctx context.Context, req *connect.Request[testdata.GetProductRequest]) (*connect.Response[testdata.GetProductResponse], error)
+	ListProducts(ctx context.Context, req *connect.Request[testdata.ListProductsRequest]) (*connect.Response[testdata.ListProductsResponse], error)
+	UpdateProduct(ctx context.Context, req *connect.Request[testdata.UpdateProductRequest]) (*connect.Response[testdata.UpdateProductResponse], error)
+}
+
+// ForwardToConnectOptionsTestServiceClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectOptionsTestServiceClient(s *mcpserver.MCPServer, client ConnectOptionsTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	DeleteProductTool := OptionsTestService_DeleteProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteProductTool = runtime.TransformTool(DeleteProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteProductTool = runtime.AddExtraPropertiesToTool(DeleteProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteProductTool = runtime.BindFieldsToTool(DeleteProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.DeleteProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/DeleteProduct", &req, func(ctx context.Context, req any) (*testdata.DeleteProductResponse, error) {
+			connectReq := connect.NewRequest(req.(*testdata.DeleteProductRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.DeleteProduct(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	GetProductTool := OptionsTestService_GetProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetProductTool = runtime.TransformTool(GetProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetProductTool = runtime.AddExtraPropertiesToTool(GetProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetProductTool = runtime.BindFieldsToTool(GetProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/GetProduct", &req, func(ctx context.Context, req any) (*testdata.GetProductResponse, error) {
+			connectReq := connect.NewRequest(req.(*testdata.GetProductRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.GetProduct(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ListProductsTool := OptionsTestService_ListProductsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListProductsTool = runtime.TransformTool(ListProductsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListProductsTool = runtime.AddExtraPropertiesToTool(ListProductsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListProductsTool = runtime.BindFieldsToTool(ListProductsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListProductsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListProductsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/ListProducts", &req, func(ctx context.Context, req any) (*testdata.ListProductsResponse, error) {
+			connectReq := connect.NewRequest(req.(*testdata.ListProductsRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.ListProducts(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	UpdateProductTool := OptionsTestService_UpdateProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UpdateProductTool = runtime.TransformTool(UpdateProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateProductTool = runtime.AddExtraPropertiesToTool(UpdateProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateProductTool = runtime.BindFieldsToTool(UpdateProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/UpdateProduct", &req, func(ctx context.Context, req any) (*testdata.UpdateProductResponse, error) {
+			connectReq := connect.NewRequest(req.(*testdata.UpdateProductRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.UpdateProduct(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// ForwardToOptionsTestServiceClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToOptionsTestServiceClient(s *mcpserver.MCPServer, client OptionsTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	DeleteProductTool := OptionsTestService_DeleteProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteProductTool = runtime.TransformTool(DeleteProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteProductTool = runtime.AddExtraPropertiesToTool(DeleteProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteProductTool = runtime.BindFieldsToTool(DeleteProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.DeleteProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/DeleteProduct", &req, func(ctx context.Context, req any) (*testdata.DeleteProductResponse, error) {
+			return client.DeleteProduct(ctx, req.(*testdata.DeleteProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	GetProductTool := OptionsTestService_GetProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetProductTool = runtime.TransformTool(GetProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetProductTool = runtime.AddExtraPropertiesToTool(GetProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetProductTool = runtime.BindFieldsToTool(GetProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/GetProduct", &req, func(ctx context.Context, req any) (*testdata.GetProductResponse, error) {
+			return client.GetProduct(ctx, req.(*testdata.GetProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	ListProductsTool := OptionsTestService_ListProductsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListProductsTool = runtime.TransformTool(ListProductsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListProductsTool = runtime.AddExtraPropertiesToTool(ListProductsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListProductsTool = runtime.BindFieldsToTool(ListProductsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListProductsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListProductsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/ListProducts", &req, func(ctx context.Context, req any) (*testdata.ListProductsResponse, error) {
+			return client.ListProducts(ctx, req.(*testdata.ListProductsRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	UpdateProductTool := OptionsTestService_UpdateProductTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UpdateProductTool = runtime.TransformTool(UpdateProductTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateProductTool = runtime.AddExtraPropertiesToTool(UpdateProductTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateProductTool = runtime.BindFieldsToTool(UpdateProductTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateProductTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateProductRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.OptionsTestService/UpdateProduct", &req, func(ctx context.Context, req any) (*testdata.UpdateProductResponse, error) {
+			return client.UpdateProduct(ctx, req.(*testdata.UpdateProductRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
@@ -0,0 +1,1543 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/edition_2023_test.proto
+
+package testdatamcpgosdk
+
+import (
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
+)
+
+import (
+	"context"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"connectrpc.com/connect"
+	grpc "google.golang.org/grpc"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+)
+
+var (
+	TestServiceEdition2023_CreateItemTool = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_CreateItem",
+		Description: "CreateItem creates a new item\n",
+		InputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "product": {
+              "properties": {
+                "price": {
+                  "type": "number"
+                },
+                "quantity": {
+                  "type": "integer"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "product"
+          ]
+        },
+        {
+          "properties": {
+            "service": {
+              "properties": {
+                "duration": {
+                  "type": "string"
+                },
+                "recurring": {
+                  "type": "boolean"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "service"
+          ]
+        }
+      ]
+    }
+  ],
+  "properties": {
+    "description": {
+      "description": "Optional field (no explicit 'optional' keyword needed in edition 2023)",
+      "type": "string"
+    },
+    "labels": {
+      "additionalProperties": {
+        "type": "string"
+      },
+      "description": "Map field (becomes array in OpenAI mode)",
+      "propertyNames": {
+        "type": "string"
+      },
+      "type": "object"
+    },
+    "name": {
+      "description": "Required field",
+      "type": "string"
+    },
+    "tags": {
+      "description": "Repeated field",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    },
+    "thumbnail": {
+      "contentEncoding": "base64",
+      "description": "Bytes field (has format: byte that should be removed in OpenAI)",
+      "format": "byte",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "created_at": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_GetItemTool = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_GetItem",
+		Description: "GetItem retrieves an item by ID\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "item": {
+      "properties": {
+        "created_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "labels": {
+          "additionalProperties": {
+            "type": "string"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        },
+        "updated_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_ProcessWellKnownTypesTool = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_ProcessWellKnownTypes",
+		Description: "Test well-known types handling\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "config": {
+      "description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object)."
+    },
+    "metadata": {
+      "additionalProperties": true,
+      "description": "Well-known types that need special handling in OpenAI mode\n\nrepresents a google.protobuf.Struct, a dynamic JSON object.",
+      "type": "object"
+    },
+    "payload": {
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "timestamp": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "message": {
+      "type": "string"
+    },
+    "success": {
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_CreateItemToolOpenAI = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_CreateItem",
+		Description: "CreateItem creates a new item\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "description": {
+      "description": "Optional field (no explicit 'optional' keyword needed in edition 2023)",
+      "type": "string"
+    },
+    "labels": {
+      "description": "Map field (becomes array in OpenAI mode)\n\nList of key value pairs",
+      "items": {
+        "additionalProperties": false,
+        "properties": {
+          "key": {
+            "type": "string"
+          },
+          "value": {
+            "type": "string"
+          }
+        },
+        "required": [
+          "key",
+          "value"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "name": {
+      "description": "Required field",
+      "type": "string"
+    },
+    "product": {
+      "additionalProperties": false,
+      "description": "Note: This field is part of a oneof group with \"service\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+      "properties": {
+        "price": {
+          "type": "number"
+        },
+        "quantity": {
+          "type": "integer"
+        }
+      },
+      "required": [
+        "price",
+        "quantity"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "service": {
+      "additionalProperties": false,
+      "description": "Note: This field is part of a oneof group with \"product\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+      "properties": {
+        "duration": {
+          "type": "string"
+        },
+        "recurring": {
+          "type": "boolean"
+        }
+      },
+      "required": [
+        "duration",
+        "recurring"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "tags": {
+      "description": "Repeated field",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    },
+    "thumbnail": {
+      "description": "Bytes field (has format: byte that should be removed in OpenAI)\n\nBase64 encoded.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name",
+    "description",
+    "labels",
+    "product",
+    "service",
+    "tags",
+    "thumbnail"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "created_at": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_GetItemToolOpenAI = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_GetItem",
+		Description: "GetItem retrieves an item by ID\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [
+    "id"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "item": {
+      "properties": {
+        "created_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "labels": {
+          "additionalProperties": {
+            "type": "string"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        },
+        "updated_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_ProcessWellKnownTypesToolOpenAI = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_ProcessWellKnownTypes",
+		Description: "Test well-known types handling\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "config": {
+      "description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).\n\nMust be a string representation of any JSON value.",
+      "type": "string"
+    },
+    "metadata": {
+      "description": "Well-known types that need special handling in OpenAI mode\n\nrepresents a google.protobuf.Struct, a dynamic JSON object.\n\nMust be a string representation of any JSON object.",
+      "type": "string"
+    },
+    "payload": {
+      "description": "Must be a string representation of a JSON object with an \"@type\" field.",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "timestamp": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    }
+  },
+  "required": [
+    "config",
+    "metadata",
+    "payload",
+    "timestamp"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "message": {
+      "type": "string"
+    },
+    "success": {
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_CreateItemToolGemini = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_CreateItem",
+		Description: "CreateItem creates a new item\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "description": {
+      "description": "Optional field (no explicit 'optional' keyword needed in edition 2023)",
+      "type": "string"
+    },
+    "labels": {
+      "description": "Map field (becomes array in OpenAI mode)\n\nList of key value pairs",
+      "items": {
+        "properties": {
+          "key": {
+            "type": "string"
+          },
+          "value": {
+            "type": "string"
+          }
+        },
+        "required": [
+          "key",
+          "value"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "name": {
+      "description": "Required field",
+      "type": "string"
+    },
+    "product": {
+      "description": "Note: This field is part of a oneof group with \"service\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+      "nullable": true,
+      "properties": {
+        "price": {
+          "type": "number"
+        },
+        "quantity": {
+          "type": "integer"
+        }
+      },
+      "required": [],
+      "type": "object"
+    },
+    "service": {
+      "description": "Note: This field is part of a oneof group with \"product\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+      "nullable": true,
+      "properties": {
+        "duration": {
+          "type": "string"
+        },
+        "recurring": {
+          "type": "boolean"
+        }
+      },
+      "required": [],
+      "type": "object"
+    },
+    "tags": {
+      "description": "Repeated field",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    },
+    "thumbnail": {
+      "description": "Bytes field (has format: byte that should be removed in OpenAI)\n\nBase64 encoded.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "created_at": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_GetItemToolGemini = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_GetItem",
+		Description: "GetItem retrieves an item by ID\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "item": {
+      "properties": {
+        "created_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "labels": {
+          "additionalProperties": {
+            "type": "string"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        },
+        "updated_at": {
+          "format": "date-time",
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	TestServiceEdition2023_ProcessWellKnownTypesToolGemini = &mcp.Tool{
+		Name:        "testdata_TestServiceEdition2023_ProcessWellKnownTypes",
+		Description: "Test well-known types handling\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "config": {
+      "description": "represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).\n\nMust be a string representation of any JSON value.",
+      "type": "string"
+    },
+    "metadata": {
+      "description": "Well-known types that need special handling in OpenAI mode\n\nrepresents a google.protobuf.Struct, a dynamic JSON object.\n\nMust be a string representation of any JSON object.",
+      "type": "string"
+    },
+    "payload": {
+      "description": "Must be a string representation of a JSON object with an \"@type\" field.",
+      "nullable": true,
+      "type": "string"
+    },
+    "timestamp": {
+      "format": "date-time",
+      "nullable": true,
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "message": {
+      "type": "string"
+    },
+    "success": {
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// TestServiceEdition2023Server is compatible with the grpc-go server interface.
+type TestServiceEdition2023Server interface {
+	CreateItem(ctx context.Context, req *testdata.CreateItemRequestEdition2023) (*testdata.CreateItemResponseEdition2023, error)
+	GetItem(ctx context.Context, req *testdata.GetItemRequestEdition2023) (*testdata.GetItemResponseEdition2023, error)
+	ProcessWellKnownTypes(ctx context.Context, req *testdata.ProcessWellKnownTypesRequestEdition2023) (*testdata.ProcessWellKnownTypesResponseEdition2023, error)
+}
+
+// RegisterTestServiceEdition2023Handler registers standard MCP handlers for TestServiceEdition2023
+func RegisterTestServiceEdition2023Handler(s *mcp.Server, srv TestServiceEdition2023Server, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateItemTool := TestServiceEdition2023_CreateItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateItemTool = runtime.TransformToolGoSDK(CreateItemTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateItemTool = runtime.AddExtraPropertiesToToolGoSDK(CreateItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemTool = runtime.BindFieldsToToolGoSDK(CreateItemTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateItemTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			return srv.CreateItem(ctx, req.(*testdata.CreateItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetItemTool := TestServiceEdition2023_GetItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetItemTool = runtime.TransformToolGoSDK(GetItemTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetItemTool = runtime.AddExtraPropertiesToToolGoSDK(GetItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemTool = runtime.BindFieldsToToolGoSDK(GetItemTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetItemTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			return srv.GetItem(ctx, req.(*testdata.GetItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ProcessWellKnownTypesTool := TestServiceEdition2023_ProcessWellKnownTypesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ProcessWellKnownTypesTool = runtime.TransformToolGoSDK(ProcessWellKnownTypesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToToolGoSDK(ProcessWellKnownTypesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesTool = runtime.BindFieldsToToolGoSDK(ProcessWellKnownTypesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ProcessWellKnownTypesRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			return srv.ProcessWellKnownTypes(ctx, req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterTestServiceEdition2023HandlerOpenAI registers OpenAI-compatible MCP handlers for TestServiceEdition2023
+func RegisterTestServiceEdition2023HandlerOpenAI(s *mcp.Server, srv TestServiceEdition2023Server, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CreateItemToolOpenAI := TestServiceEdition2023_CreateItemToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateItemToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(CreateItemToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemToolOpenAI = runtime.BindFieldsToToolGoSDK(CreateItemToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateItemToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			return srv.CreateItem(ctx, req.(*testdata.CreateItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetItemToolOpenAI := TestServiceEdition2023_GetItemToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetItemToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(GetItemToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemToolOpenAI = runtime.BindFieldsToToolGoSDK(GetItemToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetItemToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			return srv.GetItem(ctx, req.(*testdata.GetItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ProcessWellKnownTypesToolOpenAI := TestServiceEdition2023_ProcessWellKnownTypesToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ProcessWellKnownTypesToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(ProcessWellKnownTypesToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesToolOpenAI = runtime.BindFieldsToToolGoSDK(ProcessWellKnownTypesToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ProcessWellKnownTypesToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ProcessWellKnownTypesRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			return srv.ProcessWellKnownTypes(ctx, req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterTestServiceEdition2023HandlerGemini registers Gemini-compatible MCP handlers for TestServiceEdition2023
+func RegisterTestServiceEdition2023HandlerGemini(s *mcp.Server, srv TestServiceEdition2023Server, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CreateItemToolGemini := TestServiceEdition2023_CreateItemToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateItemToolGemini = runtime.AddExtraPropertiesToToolGoSDK(CreateItemToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemToolGemini = runtime.BindFieldsToToolGoSDK(CreateItemToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateItemToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			return srv.CreateItem(ctx, req.(*testdata.CreateItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetItemToolGemini := TestServiceEdition2023_GetItemToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetItemToolGemini = runtime.AddExtraPropertiesToToolGoSDK(GetItemToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemToolGemini = runtime.BindFieldsToToolGoSDK(GetItemToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetItemToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			return srv.GetItem(ctx, req.(*testdata.GetItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ProcessWellKnownTypesToolGemini := TestServiceEdition2023_ProcessWellKnownTypesToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ProcessWellKnownTypesToolGemini = runtime.AddExtraPropertiesToToolGoSDK(ProcessWellKnownTypesToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesToolGemini = runtime.BindFieldsToToolGoSDK(ProcessWellKnownTypesToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ProcessWellKnownTypesToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ProcessWellKnownTypesRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			return srv.ProcessWellKnownTypes(ctx, req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterTestServiceEdition2023HandlerWithProvider registers handlers for the specified LLM provider
+func RegisterTestServiceEdition2023HandlerWithProvider(s *mcp.Server, srv TestServiceEdition2023Server, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterTestServiceEdition2023HandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterTestServiceEdition2023HandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterTestServiceEdition2023Handler(s, srv, opts...)
+	}
+}
+
+// TestServiceEdition2023Client is compatible with the grpc-go client interface.
+type TestServiceEdition2023Client interface {
+	CreateItem(ctx context.Context, req *testdata.CreateItemRequestEdition2023, opts ...grpc.CallOption) (*testdata.CreateItemResponseEdition2023, error)
+	GetItem(ctx context.Context, req *testdata.GetItemRequestEdition2023, opts ...grpc.CallOption) (*testdata.GetItemResponseEdition2023, error)
+	ProcessWellKnownTypes(ctx context.Context, req *testdata.ProcessWellKnownTypesRequestEdition2023, opts ...grpc.CallOption) (*testdata.ProcessWellKnownTypesResponseEdition2023, error)
+}
+
+// ConnectTestServiceEdition2023Client is compatible with the connectrpc-go client interface.
+type ConnectTestServiceEdition2023Client interface {
+	CreateItem(ctx context.Context, req *connect.Request[testdata.CreateItemRequestEdition2023]) (*connect.Response[testdata.CreateItemResponseEdition2023], error)
+	GetItem(ctx context.Context, req *connect.Request[testdata.GetItemRequestEdition2023]) (*connect.Response[testdata.GetItemResponseEdition2023], error)
+	ProcessWellKnownTypes(ctx context.Context, req *connect.Request[testdata.ProcessWellKnownTypesRequestEdition2023]) (*connect.Response[testdata.ProcessWellKnownTypesResponseEdition2023], error)
+}
+
+// ForwardToConnectTestServiceEdition2023Client registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectTestServiceEdition2023Client(s *mcp.Server, client ConnectTestServiceEdition2023Client, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateItemTool := TestServiceEdition2023_CreateItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateItemTool = runtime.TransformToolGoSDK(CreateItemTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateItemTool = runtime.AddExtraPropertiesToToolGoSDK(CreateItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemTool = runtime.BindFieldsToToolGoSDK(CreateItemTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateItemTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			connectReq := connect.NewRequest(req.(*testdata.CreateItemRequestEdition2023))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.CreateItem(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetItemTool := TestServiceEdition2023_GetItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetItemTool = runtime.TransformToolGoSDK(GetItemTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetItemTool = runtime.AddExtraPropertiesToToolGoSDK(GetItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemTool = runtime.BindFieldsToToolGoSDK(GetItemTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetItemTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			connectReq := connect.NewRequest(req.(*testdata.GetItemRequestEdition2023))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.GetItem(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ProcessWellKnownTypesTool := TestServiceEdition2023_ProcessWellKnownTypesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ProcessWellKnownTypesTool = runtime.TransformToolGoSDK(ProcessWellKnownTypesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToToolGoSDK(ProcessWellKnownTypesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesTool = runtime.BindFieldsToToolGoSDK(ProcessWellKnownTypesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ProcessWellKnownTypesRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			connectReq := connect.NewRequest(req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.ProcessWellKnownTypes(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// ForwardToTestServiceEdition2023Client registers a gRPC client, to forward MCP calls to it.
+func ForwardToTestServiceEdition2023Client(s *mcp.Server, client TestServiceEdition2023Client, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateItemTool := TestServiceEdition2023_CreateItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateItemTool = runtime.TransformToolGoSDK(CreateItemTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateItemTool = runtime.AddExtraPropertiesToToolGoSDK(CreateItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateItemTool = runtime.BindFieldsToToolGoSDK(CreateItemTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateItemTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/CreateItem", &req, func(ctx context.Context, req any) (*testdata.CreateItemResponseEdition2023, error) {
+			return client.CreateItem(ctx, req.(*testdata.CreateItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	GetItemTool := TestServiceEdition2023_GetItemTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetItemTool = runtime.TransformToolGoSDK(GetItemTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetItemTool = runtime.AddExtraPropertiesToToolGoSDK(GetItemTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetItemTool = runtime.BindFieldsToToolGoSDK(GetItemTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetItemTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.GetItemRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/GetItem", &req, func(ctx context.Context, req any) (*testdata.GetItemResponseEdition2023, error) {
+			return client.GetItem(ctx, req.(*testdata.GetItemRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ProcessWellKnownTypesTool := TestServiceEdition2023_ProcessWellKnownTypesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ProcessWellKnownTypesTool = runtime.TransformToolGoSDK(ProcessWellKnownTypesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToToolGoSDK(ProcessWellKnownTypesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ProcessWellKnownTypesTool = runtime.BindFieldsToToolGoSDK(ProcessWellKnownTypesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ProcessWellKnownTypesRequestEdition2023
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.TestServiceEdition2023/ProcessWellKnownTypes", &req, func(ctx context.Context, req any) (*testdata.ProcessWellKnownTypesResponseEdition2023, error) {
+			return client.ProcessWellKnownTypes(ctx, req.(*testdata.ProcessWellKnownTypesRequestEdition2023))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
@@ -0,0 +1,1332 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: google/bytestream/bytestream.proto
+
+package bytestreammcpgosdk
+
+import (
+	bytestream "google.golang.org/genproto/googleapis/bytestream"
+)
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"encoding/json"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	ByteStream_QueryWriteStatusTool = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_QueryWriteStatus",
+		Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "Request object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "resource_name": {
+      "description": "The name of the resource whose write status is being requested.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    },
+    "complete": {
+      "description": "` + "`" + `complete` + "`" + ` is ` + "`" + `true` + "`" + ` only if the client has sent a ` + "`" + `WriteRequest` + "`" + ` with\n` + "`" + `finish_write` + "`" + ` set to true, and the server has processed that request.",
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_ReadTool = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Read",
+		Description: "`Read()` is used to retrieve the contents of a resource as a sequence\nof bytes. The bytes are returned in a sequence of responses, and the\nresponses are delivered as the results of a server-side streaming RPC.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "Request object for ByteStream.Read.",
+  "properties": {
+    "read_limit": {
+      "description": "The maximum number of ` + "`" + `data` + "`" + ` bytes the server is allowed to return in the\nsum of all ` + "`" + `ReadResponse` + "`" + ` messages. A ` + "`" + `read_limit` + "`" + ` of zero indicates that\nthere is no limit, and a negative ` + "`" + `read_limit` + "`" + ` will cause an error.\n\nIf the stream returns fewer bytes than allowed by the ` + "`" + `read_limit` + "`" + ` and no\nerror occurred, the stream includes all data from the ` + "`" + `read_offset` + "`" + ` to the\nend of the resource.",
+      "type": "string"
+    },
+    "read_offset": {
+      "description": "The offset for the first byte to return in the read, relative to the start\nof the resource.\n\nA ` + "`" + `read_offset` + "`" + ` that is negative or greater than the size of the resource\nwill cause an ` + "`" + `OUT_OF_RANGE` + "`" + ` error.",
+      "type": "string"
+    },
+    "resource_name": {
+      "description": "The name of the resource to read.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "Response object for ByteStream.Read.",
+        "properties": {
+          "data": {
+            "contentEncoding": "base64",
+            "description": "A portion of the data for the resource. The service **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `ReadResponse` + "`" + `. This enables the service to inform the\nclient that the request is still live while it is running an operation to\ngenerate more data.",
+            "format": "byte",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_WriteTool = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Write",
+		Description: "`Write()` is used to send the contents of a resource as a sequence of\nbytes. The bytes are sent in a sequence of request protos of a client-side\nstreaming RPC.\n\nA `Write()` action is resumable. If there is an error or the connection is\nbroken during the `Write()`, the client should check the status of the\n`Write()` by calling `QueryWriteStatus()` and continue writing from the\nreturned `committed_size`. This may be less than the amount of data the\nclient previously sent.\n\nCalling `Write()` on a resource name that was previously written and\nfinalized could cause an error, depending on whether the underlying service\nallows over-writing of previously written resources.\n\nWhen the client closes the request channel, the service will respond with\na `WriteResponse`. The service will not view the resource as `complete`\nuntil the client has sent a `WriteRequest` with `finish_write` set to\n`true`. Sending any requests on a stream after sending a request with\n`finish_write` set to `true` will cause an error. The client **should**\ncheck the `WriteResponse` it receives to determine how much data the\nservice was able to commit and whether the service views the resource as\n`complete` or not.\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "description": "Request object for ByteStream.Write.",
+        "properties": {
+          "data": {
+            "contentEncoding": "base64",
+            "description": "A portion of the data for the resource. The client **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `WriteRequest` + "`" + `. This enables the client to inform the\nservice that the request is still live while it is running an operation to\ngenerate more data.",
+            "format": "byte",
+            "type": "string"
+          },
+          "finish_write": {
+            "description": "If ` + "`" + `true` + "`" + `, this indicates that the write is complete. Sending any\n` + "`" + `WriteRequest` + "`" + `s subsequent to one in which ` + "`" + `finish_write` + "`" + ` is ` + "`" + `true` + "`" + ` will\ncause an error.",
+            "type": "boolean"
+          },
+          "resource_name": {
+            "description": "The name of the resource to write. This **must** be set on the first\n` + "`" + `WriteRequest` + "`" + ` of each ` + "`" + `Write()` + "`" + ` action. If it is set on subsequent calls,\nit **must** match the value of the first request.",
+            "type": "string"
+          },
+          "write_offset": {
+            "description": "The offset from the beginning of the resource at which the data should be\nwritten. It is required on all ` + "`" + `WriteRequest` + "`" + `s.\n\nIn the first ` + "`" + `WriteRequest` + "`" + ` of a ` + "`" + `Write()` + "`" + ` action, it indicates\nthe initial offset for the ` + "`" + `Write()` + "`" + ` call. The value **must** be equal to\nthe ` + "`" + `committed_size` + "`" + ` that a call to ` + "`" + `QueryWriteStatus()` + "`" + ` would return.\n\nOn subsequent calls, this value **must** be set and **must** be equal to\nthe sum of the first ` + "`" + `write_offset` + "`" + ` and the sizes of all ` + "`" + `data` + "`" + ` bundles\nsent previously on this stream.\n\nAn incorrect value will cause an error.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.Write.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_QueryWriteStatusToolOpenAI = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_QueryWriteStatus",
+		Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "Request object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "resource_name": {
+      "description": "The name of the resource whose write status is being requested.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "resource_name"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    },
+    "complete": {
+      "description": "` + "`" + `complete` + "`" + ` is ` + "`" + `true` + "`" + ` only if the client has sent a ` + "`" + `WriteRequest` + "`" + ` with\n` + "`" + `finish_write` + "`" + ` set to true, and the server has processed that request.",
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_ReadToolOpenAI = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Read",
+		Description: "`Read()` is used to retrieve the contents of a resource as a sequence\nof bytes. The bytes are returned in a sequence of responses, and the\nresponses are delivered as the results of a server-side streaming RPC.\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "Request object for ByteStream.Read.",
+  "properties": {
+    "read_limit": {
+      "description": "The maximum number of ` + "`" + `data` + "`" + ` bytes the server is allowed to return in the\nsum of all ` + "`" + `ReadResponse` + "`" + ` messages. A ` + "`" + `read_limit` + "`" + ` of zero indicates that\nthere is no limit, and a negative ` + "`" + `read_limit` + "`" + ` will cause an error.\n\nIf the stream returns fewer bytes than allowed by the ` + "`" + `read_limit` + "`" + ` and no\nerror occurred, the stream includes all data from the ` + "`" + `read_offset` + "`" + ` to the\nend of the resource.",
+      "type": "string"
+    },
+    "read_offset": {
+      "description": "The offset for the first byte to return in the read, relative to the start\nof the resource.\n\nA ` + "`" + `read_offset` + "`" + ` that is negative or greater than the size of the resource\nwill cause an ` + "`" + `OUT_OF_RANGE` + "`" + ` error.",
+      "type": "string"
+    },
+    "resource_name": {
+      "description": "The name of the resource to read.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "read_limit",
+    "read_offset",
+    "resource_name"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "Response object for ByteStream.Read.",
+        "properties": {
+          "data": {
+            "contentEncoding": "base64",
+            "description": "A portion of the data for the resource. The service **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `ReadResponse` + "`" + `. This enables the service to inform the\nclient that the request is still live while it is running an operation to\ngenerate more data.",
+            "format": "byte",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_WriteToolOpenAI = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Write",
+		Description: "`Write()` is used to send the contents of a resource as a sequence of\nbytes. The bytes are sent in a sequence of request protos of a client-side\nstreaming RPC.\n\nA `Write()` action is resumable. If there is an error or the connection is\nbroken during the `Write()`, the client should check the status of the\n`Write()` by calling `QueryWriteStatus()` and continue writing from the\nreturned `committed_size`. This may be less than the amount of data the\nclient previously sent.\n\nCalling `Write()` on a resource name that was previously written and\nfinalized could cause an error, depending on whether the underlying service\nallows over-writing of previously written resources.\n\nWhen the client closes the request channel, the service will respond with\na `WriteResponse`. The service will not view the resource as `complete`\nuntil the client has sent a `WriteRequest` with `finish_write` set to\n`true`. Sending any requests on a stream after sending a request with\n`finish_write` set to `true` will cause an error. The client **should**\ncheck the `WriteResponse` it receives to determine how much data the\nservice was able to commit and whether the service views the resource as\n`complete` or not.\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "additionalProperties": false,
+        "description": "Request object for ByteStream.Write.",
+        "properties": {
+          "data": {
+            "description": "A portion of the data for the resource. The client **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `WriteRequest` + "`" + `. This enables the client to inform the\nservice that the request is still live while it is running an operation to\ngenerate more data.\n\nBase64 encoded.",
+            "type": "string"
+          },
+          "finish_write": {
+            "description": "If ` + "`" + `true` + "`" + `, this indicates that the write is complete. Sending any\n` + "`" + `WriteRequest` + "`" + `s subsequent to one in which ` + "`" + `finish_write` + "`" + ` is ` + "`" + `true` + "`" + ` will\ncause an error.",
+            "type": "boolean"
+          },
+          "resource_name": {
+            "description": "The name of the resource to write. This **must** be set on the first\n` + "`" + `WriteRequest` + "`" + ` of each ` + "`" + `Write()` + "`" + ` action. If it is set on subsequent calls,\nit **must** match the value of the first request.",
+            "type": "string"
+          },
+          "write_offset": {
+            "description": "The offset from the beginning of the resource at which the data should be\nwritten. It is required on all ` + "`" + `WriteRequest` + "`" + `s.\n\nIn the first ` + "`" + `WriteRequest` + "`" + ` of a ` + "`" + `Write()` + "`" + ` action, it indicates\nthe initial offset for the ` + "`" + `Write()` + "`" + ` call. The value **must** be equal to\nthe ` + "`" + `committed_size` + "`" + ` that a call to ` + "`" + `QueryWriteStatus()` + "`" + ` would return.\n\nOn subsequent calls, this value **must** be set and **must** be equal to\nthe sum of the first ` + "`" + `write_offset` + "`" + ` and the sizes of all ` + "`" + `data` + "`" + ` bundles\nsent previously on this stream.\n\nAn incorrect value will cause an error.",
+            "type": "string"
+          }
+        },
+        "required": [
+          "data",
+          "finish_write",
+          "resource_name",
+          "write_offset"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.Write.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_QueryWriteStatusToolGemini = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_QueryWriteStatus",
+		Description: "`QueryWriteStatus()` is used to find the `committed_size` for a resource\nthat is being written, which can then be used as the `write_offset` for\nthe next `Write()` call.\n\nIf the resource does not exist (i.e., the resource has been deleted, or the\nfirst `Write()` has not yet reached the service), this method returns the\nerror `NOT_FOUND`.\n\nThe client **may** call `QueryWriteStatus()` at any time to determine how\nmuch data has been processed for this resource. This is useful if the\nclient is buffering data and needs to know which data can be safely\nevicted. For any sequence of `QueryWriteStatus()` calls for a given\nresource name, the sequence of returned `committed_size` values will be\nnon-decreasing.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "Request object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "resource_name": {
+      "description": "The name of the resource whose write status is being requested.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.QueryWriteStatus.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    },
+    "complete": {
+      "description": "` + "`" + `complete` + "`" + ` is ` + "`" + `true` + "`" + ` only if the client has sent a ` + "`" + `WriteRequest` + "`" + ` with\n` + "`" + `finish_write` + "`" + ` set to true, and the server has processed that request.",
+      "type": "boolean"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_ReadToolGemini = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Read",
+		Description: "`Read()` is used to retrieve the contents of a resource as a sequence\nof bytes. The bytes are returned in a sequence of responses, and the\nresponses are delivered as the results of a server-side streaming RPC.\n",
+		InputSchema: json.RawMessage(`{
+  "description": "Request object for ByteStream.Read.",
+  "properties": {
+    "read_limit": {
+      "description": "The maximum number of ` + "`" + `data` + "`" + ` bytes the server is allowed to return in the\nsum of all ` + "`" + `ReadResponse` + "`" + ` messages. A ` + "`" + `read_limit` + "`" + ` of zero indicates that\nthere is no limit, and a negative ` + "`" + `read_limit` + "`" + ` will cause an error.\n\nIf the stream returns fewer bytes than allowed by the ` + "`" + `read_limit` + "`" + ` and no\nerror occurred, the stream includes all data from the ` + "`" + `read_offset` + "`" + ` to the\nend of the resource.",
+      "type": "string"
+    },
+    "read_offset": {
+      "description": "The offset for the first byte to return in the read, relative to the start\nof the resource.\n\nA ` + "`" + `read_offset` + "`" + ` that is negative or greater than the size of the resource\nwill cause an ` + "`" + `OUT_OF_RANGE` + "`" + ` error.",
+      "type": "string"
+    },
+    "resource_name": {
+      "description": "The name of the resource to read.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "Response object for ByteStream.Read.",
+        "properties": {
+          "data": {
+            "contentEncoding": "base64",
+            "description": "A portion of the data for the resource. The service **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `ReadResponse` + "`" + `. This enables the service to inform the\nclient that the request is still live while it is running an operation to\ngenerate more data.",
+            "format": "byte",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	ByteStream_WriteToolGemini = &mcp.Tool{
+		Name:        "google_bytestream_ByteStream_Write",
+		Description: "`Write()` is used to send the contents of a resource as a sequence of\nbytes. The bytes are sent in a sequence of request protos of a client-side\nstreaming RPC.\n\nA `Write()` action is resumable. If there is an error or the connection is\nbroken during the `Write()`, the client should check the status of the\n`Write()` by calling `QueryWriteStatus()` and continue writing from the\nreturned `committed_size`. This may be less than the amount of data the\nclient previously sent.\n\nCalling `Write()` on a resource name that was previously written and\nfinalized could cause an error, depending on whether the underlying service\nallows over-writing of previously written resources.\n\nWhen the client closes the request channel, the service will respond with\na `WriteResponse`. The service will not view the resource as `complete`\nuntil the client has sent a `WriteRequest` with `finish_write` set to\n`true`. Sending any requests on a stream after sending a request with\n`finish_write` set to `true` will cause an error. The client **should**\ncheck the `WriteResponse` it receives to determine how much data the\nservice was able to commit and whether the service views the resource as\n`complete` or not.\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "description": "Request object for ByteStream.Write.",
+        "properties": {
+          "data": {
+            "description": "A portion of the data for the resource. The client **may** leave ` + "`" + `data` + "`" + `\nempty for any given ` + "`" + `WriteRequest` + "`" + `. This enables the client to inform the\nservice that the request is still live while it is running an operation to\ngenerate more data.\n\nBase64 encoded.",
+            "type": "string"
+          },
+          "finish_write": {
+            "description": "If ` + "`" + `true` + "`" + `, this indicates that the write is complete. Sending any\n` + "`" + `WriteRequest` + "`" + `s subsequent to one in which ` + "`" + `finish_write` + "`" + ` is ` + "`" + `true` + "`" + ` will\ncause an error.",
+            "type": "boolean"
+          },
+          "resource_name": {
+            "description": "The name of the resource to write. This **must** be set on the first\n` + "`" + `WriteRequest` + "`" + ` of each ` + "`" + `Write()` + "`" + ` action. If it is set on subsequent calls,\nit **must** match the value of the first request.",
+            "type": "string"
+          },
+          "write_offset": {
+            "description": "The offset from the beginning of the resource at which the data should be\nwritten. It is required on all ` + "`" + `WriteRequest` + "`" + `s.\n\nIn the first ` + "`" + `WriteRequest` + "`" + ` of a ` + "`" + `Write()` + "`" + ` action, it indicates\nthe initial offset for the ` + "`" + `Write()` + "`" + ` call. The value **must** be equal to\nthe ` + "`" + `committed_size` + "`" + ` that a call to ` + "`" + `QueryWriteStatus()` + "`" + ` would return.\n\nOn subsequent calls, this value **must** be set and **must** be equal to\nthe sum of the first ` + "`" + `write_offset` + "`" + ` and the sizes of all ` + "`" + `data` + "`" + ` bundles\nsent previously on this stream.\n\nAn incorrect value will cause an error.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "description": "Response object for ByteStream.Write.",
+  "properties": {
+    "committed_size": {
+      "description": "The number of bytes that have been processed for the given resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// ByteStreamServer is compatible with the grpc-go server interface.
+type ByteStreamServer interface {
+	QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest) (*bytestream.QueryWriteStatusResponse, error)
+	Read(req *bytestream.ReadRequest, stream grpc.ServerStreamingServer[bytestream.ReadResponse]) error
+	Write(stream grpc.ClientStreamingServer[bytestream.WriteRequest, bytestream.WriteResponse]) error
+}
+
+// RegisterByteStreamHandler registers standard MCP handlers for ByteStream
+func RegisterByteStreamHandler(s *mcp.Server, srv ByteStreamServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		QueryWriteStatusTool = runtime.TransformToolGoSDK(QueryWriteStatusTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		QueryWriteStatusTool = runtime.AddExtraPropertiesToToolGoSDK(QueryWriteStatusTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusTool = runtime.BindFieldsToToolGoSDK(QueryWriteStatusTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.QueryWriteStatusRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			return srv.QueryWriteStatus(ctx, req.(*bytestream.QueryWriteStatusRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ReadTool := ByteStream_ReadTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ReadTool = runtime.TransformToolGoSDK(ReadTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadTool = runtime.AddExtraPropertiesToToolGoSDK(ReadTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadTool = runtime.BindFieldsToToolGoSDK(ReadTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		collector := runtime.NewStreamCollectorGoSDK(ctx, request, config)
+		_, err = runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.Read(req.(*bytestream.ReadRequest), runtime.NewServerStream[bytestream.ReadResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		return collector.ResultGoSDK()
+	})
+	WriteTool := ByteStream_WriteTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WriteTool = runtime.TransformToolGoSDK(WriteTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteTool = runtime.AddExtraPropertiesToToolGoSDK(WriteTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteTool = runtime.BindFieldsToToolGoSDK(WriteTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, provider)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			stream := runtime.NewClientStream[bytestream.WriteRequest, bytestream.WriteResponse](ctx, req.([]*bytestream.WriteRequest), nil)
+			if err := srv.Write(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterByteStreamHandlerOpenAI registers OpenAI-compatible MCP handlers for ByteStream
+func RegisterByteStreamHandlerOpenAI(s *mcp.Server, srv ByteStreamServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	QueryWriteStatusToolOpenAI := ByteStream_QueryWriteStatusToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		QueryWriteStatusToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(QueryWriteStatusToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusToolOpenAI = runtime.BindFieldsToToolGoSDK(QueryWriteStatusToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(QueryWriteStatusToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.QueryWriteStatusRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			return srv.QueryWriteStatus(ctx, req.(*bytestream.QueryWriteStatusRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ReadToolOpenAI := ByteStream_ReadToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(ReadToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadToolOpenAI = runtime.BindFieldsToToolGoSDK(ReadToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		collector := runtime.NewStreamCollectorGoSDK(ctx, request, config)
+		_, err = runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.Read(req.(*bytestream.ReadRequest), runtime.NewServerStream[bytestream.ReadResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		return collector.ResultGoSDK()
+	})
+	WriteToolOpenAI := ByteStream_WriteToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(WriteToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteToolOpenAI = runtime.BindFieldsToToolGoSDK(WriteToolOpenAI, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, runtime.LLMProviderOpenAI)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			stream := runtime.NewClientStream[bytestream.WriteRequest, bytestream.WriteResponse](ctx, req.([]*bytestream.WriteRequest), nil)
+			if err := srv.Write(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterByteStreamHandlerGemini registers Gemini-compatible MCP handlers for ByteStream
+func RegisterByteStreamHandlerGemini(s *mcp.Server, srv ByteStreamServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	QueryWriteStatusToolGemini := ByteStream_QueryWriteStatusToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		QueryWriteStatusToolGemini = runtime.AddExtraPropertiesToToolGoSDK(QueryWriteStatusToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusToolGemini = runtime.BindFieldsToToolGoSDK(QueryWriteStatusToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(QueryWriteStatusToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.QueryWriteStatusRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			return srv.QueryWriteStatus(ctx, req.(*bytestream.QueryWriteStatusRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ReadToolGemini := ByteStream_ReadToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadToolGemini = runtime.AddExtraPropertiesToToolGoSDK(ReadToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadToolGemini = runtime.BindFieldsToToolGoSDK(ReadToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		collector := runtime.NewStreamCollectorGoSDK(ctx, request, config)
+		_, err = runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.Read(req.(*bytestream.ReadRequest), runtime.NewServerStream[bytestream.ReadResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		return collector.ResultGoSDK()
+	})
+	WriteToolGemini := ByteStream_WriteToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteToolGemini = runtime.AddExtraPropertiesToToolGoSDK(WriteToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteToolGemini = runtime.BindFieldsToToolGoSDK(WriteToolGemini, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, runtime.LLMProviderGemini)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			stream := runtime.NewClientStream[bytestream.WriteRequest, bytestream.WriteResponse](ctx, req.([]*bytestream.WriteRequest), nil)
+			if err := srv.Write(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterByteStreamHandlerWithProvider registers handlers for the specified LLM provider
+func RegisterByteStreamHandlerWithProvider(s *mcp.Server, srv ByteStreamServer, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterByteStreamHandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterByteStreamHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterByteStreamHandler(s, srv, opts...)
+	}
+}
+
+// ByteStreamClient is compatible with the grpc-go client interface.
+type ByteStreamClient interface {
+	QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest, opts ...grpc.CallOption) (*bytestream.QueryWriteStatusResponse, error)
+	Read(ctx context.Context, req *bytestream.ReadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[bytestream.ReadResponse], error)
+	Write(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[bytestream.WriteRequest, bytestream.WriteResponse], error)
+}
+
+// ConnectByteStreamClient is compatible with the connectrpc-go client interface.
+type ConnectByteStreamClient interface {
+	QueryWriteStatus(ctx context.Context, req *connect.Request[bytestream.QueryWriteStatusRequest]) (*connect.Response[bytestream.QueryWriteStatusResponse], error)
+	Read(ctx context.Context, req *connect.Request[bytestream.ReadRequest]) (*connect.ServerStreamForClient[bytestream.ReadResponse], error)
+	Write(ctx context.Context) *connect.ClientStreamForClient[bytestream.WriteRequest, bytestream.WriteResponse]
+}
+
+// ForwardToConnectByteStreamClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectByteStreamClient(s *mcp.Server, client ConnectByteStreamClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		QueryWriteStatusTool = runtime.TransformToolGoSDK(QueryWriteStatusTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		QueryWriteStatusTool = runtime.AddExtraPropertiesToToolGoSDK(QueryWriteStatusTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusTool = runtime.BindFieldsToToolGoSDK(QueryWriteStatusTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.QueryWriteStatusRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			connectReq := connect.NewRequest(req.(*bytestream.QueryWriteStatusRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.QueryWriteStatus(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ReadTool := ByteStream_ReadTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ReadTool = runtime.TransformToolGoSDK(ReadTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadTool = runtime.AddExtraPropertiesToToolGoSDK(ReadTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadTool = runtime.BindFieldsToToolGoSDK(ReadTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		collector := runtime.NewStreamCollectorGoSDK(ctx, request, config)
+		_, err = runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			connectReq := connect.NewRequest(req.(*bytestream.ReadRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			stream, err := client.Read(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			defer stream.Close()
+
+			for stream.Receive() {
+				if err := collector.Add(stream.Msg()); err != nil {
+					return nil, err
+				}
+			}
+			return nil, stream.Err()
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		return collector.ResultGoSDK()
+	})
+	WriteTool := ByteStream_WriteTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WriteTool = runtime.TransformToolGoSDK(WriteTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteTool = runtime.AddExtraPropertiesToToolGoSDK(WriteTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteTool = runtime.BindFieldsToToolGoSDK(WriteTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, provider)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream := client.Write(ctx)
+			runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+			if err := runtime.SendAll(req.([]*bytestream.WriteRequest), stream.Send); err != nil {
+				return nil, err
+			}
+			resp, err := stream.CloseAndReceive()
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// ForwardToByteStreamClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToByteStreamClient(s *mcp.Server, client ByteStreamClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	QueryWriteStatusTool := ByteStream_QueryWriteStatusTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		QueryWriteStatusTool = runtime.TransformToolGoSDK(QueryWriteStatusTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		QueryWriteStatusTool = runtime.AddExtraPropertiesToToolGoSDK(QueryWriteStatusTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		QueryWriteStatusTool = runtime.BindFieldsToToolGoSDK(QueryWriteStatusTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.QueryWriteStatusRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/QueryWriteStatus", &req, func(ctx context.Context, req any) (*bytestream.QueryWriteStatusResponse, error) {
+			return client.QueryWriteStatus(ctx, req.(*bytestream.QueryWriteStatusRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	ReadTool := ByteStream_ReadTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ReadTool = runtime.TransformToolGoSDK(ReadTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ReadTool = runtime.AddExtraPropertiesToToolGoSDK(ReadTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ReadTool = runtime.BindFieldsToToolGoSDK(ReadTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ReadTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req bytestream.ReadRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		collector := runtime.NewStreamCollectorGoSDK(ctx, request, config)
+		_, err = runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Read", &req, func(ctx context.Context, req any) (any, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.Read(ctx, req.(*bytestream.ReadRequest))
+			if err != nil {
+				return nil, err
+			}
+			return nil, runtime.ReceiveAll(collector, stream.Recv)
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		return collector.ResultGoSDK()
+	})
+	WriteTool := ByteStream_WriteTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WriteTool = runtime.TransformToolGoSDK(WriteTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WriteTool = runtime.AddExtraPropertiesToToolGoSDK(WriteTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WriteTool = runtime.BindFieldsToToolGoSDK(WriteTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WriteTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[bytestream.WriteRequest](message, provider)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/google.bytestream.ByteStream/Write", reqs, func(ctx context.Context, req any) (*bytestream.WriteResponse, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.Write(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err := runtime.SendAll(req.([]*bytestream.WriteRequest), stream.Send); err != nil {
+				return nil, err
+			}
+			return stream.CloseAndRecv()
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
@@ -20,16 +20,1757 @@ import (
 )
 
 var (
-	Operations_CancelOperationTool       = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_CancelOperation", Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_DeleteOperationTool       = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_DeleteOperation", Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_GetOperationTool          = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_GetOperation", Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_ListOperationsTool        = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_ListOperations", Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_WaitOperationTool         = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_WaitOperation", Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x3a, 0x22, 0x5e, 0x2d, 0x3f, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x28, 0x5c, 0x5c, 0x2e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x29, 0x3f, 0x73, 0x24, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_CancelOperationToolOpenAI = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_CancelOperation", Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_DeleteOperationToolOpenAI = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_DeleteOperation", Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_GetOperationToolOpenAI    = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_GetOperation", Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_ListOperationsToolOpenAI  = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_ListOperations", Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22, 0x7d, 0x2c, 0x22, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x2c, 0x22, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x2c, 0x22, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
-	Operations_WaitOperationToolOpenAI   = mcp.Tool{Meta: (*mcp.Meta)(nil), Name: "google_longrunning_Operations_WaitOperation", Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n", InputSchema: mcp.ToolInputSchema{Defs: map[string]interface{}(nil), Type: "", Properties: map[string]interface{}(nil), Required: []string(nil)}, RawInputSchema: json.RawMessage{0x7b, 0x22, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x7b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x7b, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x7d, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x3a, 0x22, 0x5e, 0x2d, 0x3f, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x28, 0x5c, 0x5c, 0x2e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x29, 0x3f, 0x73, 0x24, 0x22, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x5b, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x22, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d, 0x7d, 0x7d, 0x2c, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x5b, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x5d, 0x2c, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d}, RawOutputSchema: json.RawMessage(nil), Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)}}
+	Operations_CancelOperationTool = mcp.Tool{
+		Name:        "google_longrunning_Operations_CancelOperation",
+		Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.CancelOperation][google.longrunning.Operations.CancelOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be cancelled.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_DeleteOperationTool = mcp.Tool{
+		Name:        "google_longrunning_Operations_DeleteOperation",
+		Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.DeleteOperation][google.longrunning.Operations.DeleteOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be deleted.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: mcp.ToBoolPtr(true), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_GetOperationTool = mcp.Tool{
+		Name:        "google_longrunning_Operations_GetOperation",
+		Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.GetOperation][google.longrunning.Operations.GetOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsTool = mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperations",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsAllTool = mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperationsAll",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_WaitOperationTool = mcp.Tool{
+		Name:        "google_longrunning_Operations_WaitOperation",
+		Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.WaitOperation][google.longrunning.Operations.WaitOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to wait on.",
+      "type": "string"
+    },
+    "timeout": {
+      "description": "The maximum duration to wait before timing out. If left blank, the wait\nwill be at most the time permitted by the underlying HTTP/RPC protocol.\nIf RPC context deadline is also specified, the shorter one will be used.",
+      "pattern": "^-?[0-9]+(\\.[0-9]+)?s$",
+      "type": [
+        "string",
+        "null"
+      ]
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_CancelOperationToolOpenAI = mcp.Tool{
+		Name:        "google_longrunning_Operations_CancelOperation",
+		Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.CancelOperation][google.longrunning.Operations.CancelOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be cancelled.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_DeleteOperationToolOpenAI = mcp.Tool{
+		Name:        "google_longrunning_Operations_DeleteOperation",
+		Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.DeleteOperation][google.longrunning.Operations.DeleteOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be deleted.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: mcp.ToBoolPtr(true), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_GetOperationToolOpenAI = mcp.Tool{
+		Name:        "google_longrunning_Operations_GetOperation",
+		Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.GetOperation][google.longrunning.Operations.GetOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "name"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsToolOpenAI = mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperations",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "filter",
+    "name",
+    "page_size",
+    "page_token"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsAllToolOpenAI = mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperationsAll",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "filter",
+    "name",
+    "page_size",
+    "page_token"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_WaitOperationToolOpenAI = mcp.Tool{
+		Name:        "google_longrunning_Operations_WaitOperation",
+		Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "description": "The request message for\n[Operations.WaitOperation][google.longrunning.Operations.WaitOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to wait on.",
+      "type": "string"
+    },
+    "timeout": {
+      "description": "The maximum duration to wait before timing out. If left blank, the wait\nwill be at most the time permitted by the underlying HTTP/RPC protocol.\nIf RPC context deadline is also specified, the shorter one will be used.",
+      "pattern": "^-?[0-9]+(\\.[0-9]+)?s$",
+      "type": [
+        "string",
+        "null"
+      ]
+    }
+  },
+  "required": [
+    "name",
+    "timeout"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_CancelOperationToolGemini = mcp.Tool{
+		Name:        "google_longrunning_Operations_CancelOperation",
+		Description: "Starts asynchronous cancellation on a long-running operation.  The server\nmakes a best effort to cancel the operation, but success is not\nguaranteed.  If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.  Clients can use\n[Operations.GetOperation][google.longrunning.Operations.GetOperation] or\nother methods to check whether the cancellation succeeded or whether the\noperation completed despite cancellation. On successful cancellation,\nthe operation is not deleted; instead, it becomes an operation with\nan [Operation.error][google.longrunning.Operation.error] value with a\n[google.rpc.Status.code][google.rpc.Status.code] of `1`, corresponding to\n`Code.CANCELLED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.CancelOperation][google.longrunning.Operations.CancelOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be cancelled.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_DeleteOperationToolGemini = mcp.Tool{
+		Name:        "google_longrunning_Operations_DeleteOperation",
+		Description: "Deletes a long-running operation. This method indicates that the client is\nno longer interested in the operation result. It does not cancel the\noperation. If the server doesn't support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.DeleteOperation][google.longrunning.Operations.DeleteOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to be deleted.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {},
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: mcp.ToBoolPtr(true), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_GetOperationToolGemini = mcp.Tool{
+		Name:        "google_longrunning_Operations_GetOperation",
+		Description: "Gets the latest state of a long-running operation.  Clients can use this\nmethod to poll the operation result at intervals as recommended by the API\nservice.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.GetOperation][google.longrunning.Operations.GetOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsToolGemini = mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperations",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_ListOperationsAllToolGemini = mcp.Tool{
+		Name:        "google_longrunning_Operations_ListOperationsAll",
+		Description: "Lists operations that match the specified filter in the request. If the\nserver doesn't support this method, it returns `UNIMPLEMENTED`.\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "filter": {
+      "description": "The standard list filter.",
+      "type": "string"
+    },
+    "name": {
+      "description": "The name of the operation's parent resource.",
+      "type": "string"
+    },
+    "page_size": {
+      "description": "The standard list page size.",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "The standard list page token.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "description": "The response message for\n[Operations.ListOperations][google.longrunning.Operations.ListOperations].",
+  "properties": {
+    "next_page_token": {
+      "description": "The standard List next-page token.",
+      "type": "string"
+    },
+    "operations": {
+      "description": "A list of operations that matches the specified filter in the request.",
+      "items": {
+        "anyOf": [
+          {
+            "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+            "oneOf": [
+              {
+                "properties": {
+                  "error": {
+                    "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+                    "properties": {
+                      "code": {
+                        "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                        "type": "integer"
+                      },
+                      "details": {
+                        "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                        "items": {
+                          "properties": {
+                            "@type": {
+                              "type": "string"
+                            },
+                            "value": {}
+                          },
+                          "required": [
+                            "@type"
+                          ],
+                          "type": [
+                            "object",
+                            "null"
+                          ]
+                        },
+                        "type": "array"
+                      },
+                      "message": {
+                        "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  }
+                },
+                "required": [
+                  "error"
+                ]
+              },
+              {
+                "properties": {
+                  "response": {
+                    "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  }
+                },
+                "required": [
+                  "response"
+                ]
+              }
+            ]
+          }
+        ],
+        "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+        "properties": {
+          "done": {
+            "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+            "type": "boolean"
+          },
+          "metadata": {
+            "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+            "properties": {
+              "@type": {
+                "type": "string"
+              },
+              "value": {}
+            },
+            "required": [
+              "@type"
+            ],
+            "type": [
+              "object",
+              "null"
+            ]
+          },
+          "name": {
+            "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	Operations_WaitOperationToolGemini = mcp.Tool{
+		Name:        "google_longrunning_Operations_WaitOperation",
+		Description: "Waits until the specified long-running operation is done or reaches at most\na specified timeout, returning the latest state.  If the operation is\nalready done, the latest state is immediately returned.  If the timeout\nspecified is greater than the default HTTP/RPC timeout, the HTTP/RPC\ntimeout is used.  If the server does not support this method, it returns\n`google.rpc.Code.UNIMPLEMENTED`.\nNote that this method is on a best-effort basis.  It may return the latest\nstate before the specified timeout (including immediately), meaning even an\nimmediate response is no guarantee that the operation is done.\n",
+		RawInputSchema: json.RawMessage(`{
+  "description": "The request message for\n[Operations.WaitOperation][google.longrunning.Operations.WaitOperation].",
+  "properties": {
+    "name": {
+      "description": "The name of the operation resource to wait on.",
+      "type": "string"
+    },
+    "timeout": {
+      "description": "The maximum duration to wait before timing out. If left blank, the wait\nwill be at most the time permitted by the underlying HTTP/RPC protocol.\nIf RPC context deadline is also specified, the shorter one will be used.",
+      "nullable": true,
+      "pattern": "^-?[0-9]+(\\.[0-9]+)?s$",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "anyOf": [
+    {
+      "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+      "oneOf": [
+        {
+          "properties": {
+            "error": {
+              "description": "The error result of the operation in case of failure or cancellation.\n\nThe ` + "`" + `Status` + "`" + ` type defines a logical error model that is suitable for\ndifferent programming environments, including REST APIs and RPC APIs. It is\nused by [gRPC](https://github.com/grpc). Each ` + "`" + `Status` + "`" + ` message contains\nthree pieces of data: error code, error message, and error details.\n\nYou can find out more about this error model and how to work with it in the\n[API Design Guide](https://cloud.google.com/apis/design/errors).",
+              "properties": {
+                "code": {
+                  "description": "The status code, which should be an enum value of\n[google.rpc.Code][google.rpc.Code].",
+                  "type": "integer"
+                },
+                "details": {
+                  "description": "A list of messages that carry the error details.  There is a common set of\nmessage types for APIs to use.",
+                  "items": {
+                    "properties": {
+                      "@type": {
+                        "type": "string"
+                      },
+                      "value": {}
+                    },
+                    "required": [
+                      "@type"
+                    ],
+                    "type": [
+                      "object",
+                      "null"
+                    ]
+                  },
+                  "type": "array"
+                },
+                "message": {
+                  "description": "A developer-facing error message, which should be in English. Any\nuser-facing error message should be localized and sent in the\n[google.rpc.Status.details][google.rpc.Status.details] field, or localized\nby the client.",
+                  "type": "string"
+                }
+              },
+              "required": [],
+              "type": "object"
+            }
+          },
+          "required": [
+            "error"
+          ]
+        },
+        {
+          "properties": {
+            "response": {
+              "description": "The normal, successful response of the operation.  If the original\nmethod returns no data on success, such as ` + "`" + `Delete` + "`" + `, the response is\n` + "`" + `google.protobuf.Empty` + "`" + `.  If the original method is standard\n` + "`" + `Get` + "`" + `/` + "`" + `Create` + "`" + `/` + "`" + `Update` + "`" + `, the response should be the resource.  For other\nmethods, the response should have the type ` + "`" + `XxxResponse` + "`" + `, where ` + "`" + `Xxx` + "`" + `\nis the original method name.  For example, if the original method name\nis ` + "`" + `TakeSnapshot()` + "`" + `, the inferred response type is\n` + "`" + `TakeSnapshotResponse` + "`" + `.",
+              "properties": {
+                "@type": {
+                  "type": "string"
+                },
+                "value": {}
+              },
+              "required": [
+                "@type"
+              ],
+              "type": [
+                "object",
+                "null"
+              ]
+            }
+          },
+          "required": [
+            "response"
+          ]
+        }
+      ]
+    }
+  ],
+  "description": "This resource represents a long-running operation that is the result of a\nnetwork API call.",
+  "properties": {
+    "done": {
+      "description": "If the value is ` + "`" + `false` + "`" + `, it means the operation is still in progress.\nIf ` + "`" + `true` + "`" + `, the operation is completed, and either ` + "`" + `error` + "`" + ` or ` + "`" + `response` + "`" + ` is\navailable.",
+      "type": "boolean"
+    },
+    "metadata": {
+      "description": "Service-specific metadata associated with the operation.  It typically\ncontains progress information and common metadata such as create time.\nSome services might not provide such metadata.  Any method that returns a\nlong-running operation should document the metadata type, if any.",
+      "properties": {
+        "@type": {
+          "type": "string"
+        },
+        "value": {}
+      },
+      "required": [
+        "@type"
+      ],
+      "type": [
+        "object",
+        "null"
+      ]
+    },
+    "name": {
+      "description": "The server-assigned name, which is only unique within the same service that\noriginally returns it. If you use the default HTTP mapping, the\n` + "`" + `name` + "`" + ` should be a resource name ending with ` + "`" + `operations/{unique_id}` + "`" + `.",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
 )
 
 // OperationsServer is compatible with the grpc-go server interface.
@@ -47,10 +1788,23 @@ func RegisterOperationsHandler(s *mcpserver.MCPServer, srv OperationsServer, opt
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	CancelOperationTool := Operations_CancelOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CancelOperationTool = runtime.TransformTool(CancelOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		CancelOperationTool = runtime.AddExtraPropertiesToTool(CancelOperationTool, config.ExtraProperties)
+		CancelOperationTool = runtime.AddExtraPropertiesToTool(CancelOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationTool = runtime.BindFieldsToTool(CancelOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(CancelOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -59,37 +1813,51 @@ func RegisterOperationsHandler(s *mcpserver.MCPServer, srv OperationsServer, opt
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.CancelOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.CancelOperation(ctx, req.(*longrunningpb.CancelOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	DeleteOperationTool := Operations_DeleteOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteOperationTool = runtime.TransformTool(DeleteOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		DeleteOperationTool = runtime.AddExtraPropertiesToTool(DeleteOperationTool, config.ExtraProperties)
+		DeleteOperationTool = runtime.AddExtraPropertiesToTool(DeleteOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationTool = runtime.BindFieldsToTool(DeleteOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(DeleteOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -98,37 +1866,51 @@ func RegisterOperationsHandler(s *mcpserver.MCPServer, srv OperationsServer, opt
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.DeleteOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.DeleteOperation(ctx, req.(*longrunningpb.DeleteOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	GetOperationTool := Operations_GetOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetOperationTool = runtime.TransformTool(GetOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		GetOperationTool = runtime.AddExtraPropertiesToTool(GetOperationTool, config.ExtraProperties)
+		GetOperationTool = runtime.AddExtraPropertiesToTool(GetOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationTool = runtime.BindFieldsToTool(GetOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(GetOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -137,37 +1919,51 @@ func RegisterOperationsHandler(s *mcpserver.MCPServer, srv OperationsServer, opt
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.GetOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.GetOperation(ctx, req.(*longrunningpb.GetOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	ListOperationsTool := Operations_ListOperationsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsTool = runtime.TransformTool(ListOperationsTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		ListOperationsTool = runtime.AddExtraPropertiesToTool(ListOperationsTool, config.ExtraProperties)
+		ListOperationsTool = runtime.AddExtraPropertiesToTool(ListOperationsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsTool = runtime.BindFieldsToTool(ListOperationsTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(ListOperationsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -176,37 +1972,100 @@ func RegisterOperationsHandler(s *mcpserver.MCPServer, srv OperationsServer, opt
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return srv.ListOperations(ctx, req.(*longrunningpb.ListOperationsRequest))
+		})
 		if err != nil {
-			return nil, err
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := srv.ListOperations(ctx, &req)
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+
+	// ListOperationsAllTool follows the pages of ListOperations
+	ListOperationsAllTool := Operations_ListOperationsAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsAllTool = runtime.TransformTool(ListOperationsAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllTool = runtime.AddExtraPropertiesToTool(ListOperationsAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllTool = runtime.BindFieldsToTool(ListOperationsAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := request.GetArguments()
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", srv.ListOperations)
+		})
 		if err != nil {
-			return nil, err
+			return runtime.HandleError(err)
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	WaitOperationTool := Operations_WaitOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WaitOperationTool = runtime.TransformTool(WaitOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		WaitOperationTool = runtime.AddExtraPropertiesToTool(WaitOperationTool, config.ExtraProperties)
+		WaitOperationTool = runtime.AddExtraPropertiesToTool(WaitOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationTool = runtime.BindFieldsToTool(WaitOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(WaitOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -215,32 +2074,38 @@ func RegisterOperationsHandler(s *mcpserver.MCPServer, srv OperationsServer, opt
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.WaitOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.WaitOperation(ctx, req.(*longrunningpb.WaitOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -253,7 +2118,11 @@ func RegisterOperationsHandlerOpenAI(s *mcpserver.MCPServer, srv OperationsServe
 	CancelOperationToolOpenAI := Operations_CancelOperationToolOpenAI
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		CancelOperationToolOpenAI = runtime.AddExtraPropertiesToTool(CancelOperationToolOpenAI, config.ExtraProperties)
+		CancelOperationToolOpenAI = runtime.AddExtraPropertiesToTool(CancelOperationToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationToolOpenAI = runtime.BindFieldsToTool(CancelOperationToolOpenAI, config.FieldBindings, false)
 	}
 
 	s.AddTool(CancelOperationToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -262,198 +2131,579 @@ func RegisterOperationsHandlerOpenAI(s *mcpserver.MCPServer, srv OperationsServe
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
 
-		marshaled, err := json.Marshal(message)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.CancelOperation(ctx, req.(*longrunningpb.CancelOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	DeleteOperationToolOpenAI := Operations_DeleteOperationToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteOperationToolOpenAI = runtime.AddExtraPropertiesToTool(DeleteOperationToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationToolOpenAI = runtime.BindFieldsToTool(DeleteOperationToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteOperationToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.DeleteOperationRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.DeleteOperation(ctx, req.(*longrunningpb.DeleteOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := srv.CancelOperation(ctx, &req)
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	GetOperationToolOpenAI := Operations_GetOperationToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetOperationToolOpenAI = runtime.AddExtraPropertiesToTool(GetOperationToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationToolOpenAI = runtime.BindFieldsToTool(GetOperationToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetOperationToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.GetOperationRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.GetOperation(ctx, req.(*longrunningpb.GetOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
-	DeleteOperationToolOpenAI := Operations_DeleteOperationToolOpenAI
+	ListOperationsToolOpenAI := Operations_ListOperationsToolOpenAI
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		DeleteOperationToolOpenAI = runtime.AddExtraPropertiesToTool(DeleteOperationToolOpenAI, config.ExtraProperties)
+		ListOperationsToolOpenAI = runtime.AddExtraPropertiesToTool(ListOperationsToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsToolOpenAI = runtime.BindFieldsToTool(ListOperationsToolOpenAI, config.FieldBindings, false)
 	}
 
-	s.AddTool(DeleteOperationToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	s.AddTool(ListOperationsToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return srv.ListOperations(ctx, req.(*longrunningpb.ListOperationsRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+
+	// ListOperationsAllToolOpenAI follows the pages of ListOperations
+	ListOperationsAllToolOpenAI := Operations_ListOperationsAllToolOpenAI
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllToolOpenAI = runtime.AddExtraPropertiesToTool(ListOperationsAllToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllToolOpenAI = runtime.BindFieldsToTool(ListOperationsAllToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := request.GetArguments()
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", srv.ListOperations)
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WaitOperationToolOpenAI := Operations_WaitOperationToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WaitOperationToolOpenAI = runtime.AddExtraPropertiesToTool(WaitOperationToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationToolOpenAI = runtime.BindFieldsToTool(WaitOperationToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(WaitOperationToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.WaitOperationRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.WaitOperation(ctx, req.(*longrunningpb.WaitOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterOperationsHandlerGemini registers Gemini-compatible MCP handlers for Operations
+func RegisterOperationsHandlerGemini(s *mcpserver.MCPServer, srv OperationsServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CancelOperationToolGemini := Operations_CancelOperationToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CancelOperationToolGemini = runtime.AddExtraPropertiesToTool(CancelOperationToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationToolGemini = runtime.BindFieldsToTool(CancelOperationToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(CancelOperationToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.CancelOperationRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.CancelOperation(ctx, req.(*longrunningpb.CancelOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	DeleteOperationToolGemini := Operations_DeleteOperationToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		DeleteOperationToolGemini = runtime.AddExtraPropertiesToTool(DeleteOperationToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationToolGemini = runtime.BindFieldsToTool(DeleteOperationToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(DeleteOperationToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		var req longrunningpb.DeleteOperationRequest
 
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return srv.DeleteOperation(ctx, req.(*longrunningpb.DeleteOperationRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	GetOperationToolGemini := Operations_GetOperationToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		GetOperationToolGemini = runtime.AddExtraPropertiesToTool(GetOperationToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationToolGemini = runtime.BindFieldsToTool(GetOperationToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(GetOperationToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.GetOperationRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
-
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.DeleteOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.GetOperation(ctx, req.(*longrunningpb.GetOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
-	GetOperationToolOpenAI := Operations_GetOperationToolOpenAI
+	ListOperationsToolGemini := Operations_ListOperationsToolGemini
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		GetOperationToolOpenAI = runtime.AddExtraPropertiesToTool(GetOperationToolOpenAI, config.ExtraProperties)
+		ListOperationsToolGemini = runtime.AddExtraPropertiesToTool(ListOperationsToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsToolGemini = runtime.BindFieldsToTool(ListOperationsToolGemini, config.FieldBindings, false)
 	}
 
-	s.AddTool(GetOperationToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req longrunningpb.GetOperationRequest
+	s.AddTool(ListOperationsToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
 
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.GetOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return srv.ListOperations(ctx, req.(*longrunningpb.ListOperationsRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
-	ListOperationsToolOpenAI := Operations_ListOperationsToolOpenAI
-	// Add extra properties to schema if configured
+
+	// ListOperationsAllToolGemini follows the pages of ListOperations
+	ListOperationsAllToolGemini := Operations_ListOperationsAllToolGemini
 	if len(config.ExtraProperties) > 0 {
-		ListOperationsToolOpenAI = runtime.AddExtraPropertiesToTool(ListOperationsToolOpenAI, config.ExtraProperties)
+		ListOperationsAllToolGemini = runtime.AddExtraPropertiesToTool(ListOperationsAllToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllToolGemini = runtime.BindFieldsToTool(ListOperationsAllToolGemini, config.FieldBindings, false)
 	}
 
-	s.AddTool(ListOperationsToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	s.AddTool(ListOperationsAllToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		var req longrunningpb.ListOperationsRequest
-
 		message := request.GetArguments()
 
-		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.ListOperations(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", srv.ListOperations)
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
-	WaitOperationToolOpenAI := Operations_WaitOperationToolOpenAI
+	WaitOperationToolGemini := Operations_WaitOperationToolGemini
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		WaitOperationToolOpenAI = runtime.AddExtraPropertiesToTool(WaitOperationToolOpenAI, config.ExtraProperties)
+		WaitOperationToolGemini = runtime.AddExtraPropertiesToTool(WaitOperationToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationToolGemini = runtime.BindFieldsToTool(WaitOperationToolGemini, config.FieldBindings, false)
 	}
 
-	s.AddTool(WaitOperationToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	s.AddTool(WaitOperationToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		var req longrunningpb.WaitOperationRequest
 
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		runtime.FixOpenAI(req.ProtoReflect().Descriptor(), message)
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := srv.WaitOperation(ctx, &req)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return srv.WaitOperation(ctx, req.(*longrunningpb.WaitOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
 
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -462,7 +2712,9 @@ func RegisterOperationsHandlerWithProvider(s *mcpserver.MCPServer, srv Operation
 	switch provider {
 	case runtime.LLMProviderOpenAI:
 		RegisterOperationsHandlerOpenAI(s, srv, opts...)
-	case runtime.LLMProviderStandard:
+	case runtime.LLMProviderGemini:
+		RegisterOperationsHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
 		fallthrough
 	default:
 		RegisterOperationsHandler(s, srv, opts...)
@@ -493,10 +2745,23 @@ func ForwardToConnectOperationsClient(s *mcpserver.MCPServer, client ConnectOper
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	CancelOperationTool := Operations_CancelOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CancelOperationTool = runtime.TransformTool(CancelOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		CancelOperationTool = runtime.AddExtraPropertiesToTool(CancelOperationTool, config.ExtraProperties)
+		CancelOperationTool = runtime.AddExtraPropertiesToTool(CancelOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationTool = runtime.BindFieldsToTool(CancelOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(CancelOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -505,36 +2770,58 @@ func ForwardToConnectOperationsClient(s *mcpserver.MCPServer, client ConnectOper
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.CancelOperation(ctx, connect.NewRequest(&req))
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.CancelOperationRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.CancelOperation(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	DeleteOperationTool := Operations_DeleteOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteOperationTool = runtime.TransformTool(DeleteOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		DeleteOperationTool = runtime.AddExtraPropertiesToTool(DeleteOperationTool, config.ExtraProperties)
+		DeleteOperationTool = runtime.AddExtraPropertiesToTool(DeleteOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationTool = runtime.BindFieldsToTool(DeleteOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(DeleteOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -543,36 +2830,58 @@ func ForwardToConnectOperationsClient(s *mcpserver.MCPServer, client ConnectOper
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.DeleteOperation(ctx, connect.NewRequest(&req))
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.DeleteOperationRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.DeleteOperation(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	GetOperationTool := Operations_GetOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetOperationTool = runtime.TransformTool(GetOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		GetOperationTool = runtime.AddExtraPropertiesToTool(GetOperationTool, config.ExtraProperties)
+		GetOperationTool = runtime.AddExtraPropertiesToTool(GetOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationTool = runtime.BindFieldsToTool(GetOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(GetOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -581,36 +2890,58 @@ func ForwardToConnectOperationsClient(s *mcpserver.MCPServer, client ConnectOper
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.GetOperation(ctx, connect.NewRequest(&req))
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.GetOperationRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.GetOperation(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	ListOperationsTool := Operations_ListOperationsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsTool = runtime.TransformTool(ListOperationsTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		ListOperationsTool = runtime.AddExtraPropertiesToTool(ListOperationsTool, config.ExtraProperties)
+		ListOperationsTool = runtime.AddExtraPropertiesToTool(ListOperationsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsTool = runtime.BindFieldsToTool(ListOperationsTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(ListOperationsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -619,36 +2950,116 @@ func ForwardToConnectOperationsClient(s *mcpserver.MCPServer, client ConnectOper
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.ListOperationsRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.ListOperations(ctx, connectReq)
+			if err != nil {
+				return nil, err
 			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+	// ListOperationsAllTool follows the pages of ListOperations
+	ListOperationsAllTool := Operations_ListOperationsAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsAllTool = runtime.TransformTool(ListOperationsAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllTool = runtime.AddExtraPropertiesToTool(ListOperationsAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllTool = runtime.BindFieldsToTool(ListOperationsAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := request.GetArguments()
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.ListOperations(ctx, connect.NewRequest(&req))
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", func(ctx context.Context, req *longrunningpb.ListOperationsRequest) (*longrunningpb.ListOperationsResponse, error) {
+				connectReq := connect.NewRequest(req)
+				runtime.SetConnectHeaders(ctx, connectReq.Header())
+				resp, err := client.ListOperations(ctx, connectReq)
+				if err != nil {
+					return nil, err
+				}
+				return resp.Msg, nil
+			})
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	WaitOperationTool := Operations_WaitOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WaitOperationTool = runtime.TransformTool(WaitOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		WaitOperationTool = runtime.AddExtraPropertiesToTool(WaitOperationTool, config.ExtraProperties)
+		WaitOperationTool = runtime.AddExtraPropertiesToTool(WaitOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationTool = runtime.BindFieldsToTool(WaitOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(WaitOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -657,31 +3068,45 @@ func ForwardToConnectOperationsClient(s *mcpserver.MCPServer, client ConnectOper
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.WaitOperation(ctx, connect.NewRequest(&req))
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			connectReq := connect.NewRequest(req.(*longrunningpb.WaitOperationRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.WaitOperation(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp.Msg)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
 
@@ -691,10 +3116,23 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 	for _, opt := range opts {
 		opt(config)
 	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
 	CancelOperationTool := Operations_CancelOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CancelOperationTool = runtime.TransformTool(CancelOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		CancelOperationTool = runtime.AddExtraPropertiesToTool(CancelOperationTool, config.ExtraProperties)
+		CancelOperationTool = runtime.AddExtraPropertiesToTool(CancelOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CancelOperationTool = runtime.BindFieldsToTool(CancelOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(CancelOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -703,36 +3141,52 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.CancelOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/CancelOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return client.CancelOperation(ctx, req.(*longrunningpb.CancelOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	DeleteOperationTool := Operations_DeleteOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		DeleteOperationTool = runtime.TransformTool(DeleteOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		DeleteOperationTool = runtime.AddExtraPropertiesToTool(DeleteOperationTool, config.ExtraProperties)
+		DeleteOperationTool = runtime.AddExtraPropertiesToTool(DeleteOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		DeleteOperationTool = runtime.BindFieldsToTool(DeleteOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(DeleteOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -741,36 +3195,52 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.DeleteOperation(ctx, &req)
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/DeleteOperation", &req, func(ctx context.Context, req any) (*emptypb.Empty, error) {
+			return client.DeleteOperation(ctx, req.(*longrunningpb.DeleteOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	GetOperationTool := Operations_GetOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		GetOperationTool = runtime.TransformTool(GetOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		GetOperationTool = runtime.AddExtraPropertiesToTool(GetOperationTool, config.ExtraProperties)
+		GetOperationTool = runtime.AddExtraPropertiesToTool(GetOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		GetOperationTool = runtime.BindFieldsToTool(GetOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(GetOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -779,36 +3249,52 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.GetOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/GetOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return client.GetOperation(ctx, req.(*longrunningpb.GetOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	ListOperationsTool := Operations_ListOperationsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsTool = runtime.TransformTool(ListOperationsTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		ListOperationsTool = runtime.AddExtraPropertiesToTool(ListOperationsTool, config.ExtraProperties)
+		ListOperationsTool = runtime.AddExtraPropertiesToTool(ListOperationsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListOperationsTool = runtime.BindFieldsToTool(ListOperationsTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(ListOperationsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -817,36 +3303,104 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		marshaled, err := json.Marshal(message)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return client.ListOperations(ctx, req.(*longrunningpb.ListOperationsRequest))
+		})
 		if err != nil {
-			return nil, err
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
 			return nil, err
 		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+
+	// ListOperationsAllTool follows the pages of ListOperations
+	ListOperationsAllTool := Operations_ListOperationsAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListOperationsAllTool = runtime.TransformTool(ListOperationsAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListOperationsAllTool = runtime.AddExtraPropertiesToTool(ListOperationsAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListOperationsAllTool = runtime.BindFieldsToTool(ListOperationsAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListOperationsAllTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req longrunningpb.ListOperationsRequest
+		message := request.GetArguments()
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
 
-		resp, err := client.ListOperations(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/ListOperations", &req, func(ctx context.Context, req any) (*longrunningpb.ListOperationsResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*longrunningpb.ListOperationsRequest), "operations", func(ctx context.Context, req *longrunningpb.ListOperationsRequest) (*longrunningpb.ListOperationsResponse, error) {
+				return client.ListOperations(ctx, req)
+			})
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 	WaitOperationTool := Operations_WaitOperationTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WaitOperationTool = runtime.TransformTool(WaitOperationTool, config.SchemaTransforms)
+	}
 	// Add extra properties to schema if configured
 	if len(config.ExtraProperties) > 0 {
-		WaitOperationTool = runtime.AddExtraPropertiesToTool(WaitOperationTool, config.ExtraProperties)
+		WaitOperationTool = runtime.AddExtraPropertiesToTool(WaitOperationTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WaitOperationTool = runtime.BindFieldsToTool(WaitOperationTool, config.FieldBindings, false)
 	}
 
 	s.AddTool(WaitOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -855,30 +3409,38 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		message := request.GetArguments()
 
 		// Extract extra properties if configured
-		for _, prop := range config.ExtraProperties {
-			if propVal, ok := message[prop.Name]; ok {
-				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
-			}
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
 		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
 
-		marshaled, err := json.Marshal(message)
-		if err != nil {
-			return nil, err
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
-			return nil, err
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
 		}
 
-		resp, err := client.WaitOperation(ctx, &req)
+		resp, err := runtime.Intercept(ctx, config, request, "/google.longrunning.Operations/WaitOperation", &req, func(ctx context.Context, req any) (*longrunningpb.Operation, error) {
+			return client.WaitOperation(ctx, req.(*longrunningpb.WaitOperationRequest))
+		})
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
 		if err != nil {
 			return nil, err
 		}
-		return mcp.NewToolResultText(string(marshaled)), nil
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
 	})
 }
@@ -658,8 +658,8 @@ const file_testdata_edition_2023_test_proto_rawDesc = "" +
 	"\n" +
 	"CreateItem\x12&.testdata.CreateItemRequestEdition2023\x1a'.testdata.CreateItemResponseEdition2023\x12T\n" +
 	"\aGetItem\x12#.testdata.GetItemRequestEdition2023\x1a$.testdata.GetItemResponseEdition2023\x12~\n" +
-	"\x15ProcessWellKnownTypes\x121.testdata.ProcessWellKnownTypesRequestEdition2023\x1a2.testdata.ProcessWellKnownTypesResponseEdition2023B\xad\x01\n" +
-	"\fcom.testdataB\x14Edition2023TestProtoP\x01ZGgithub.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\beditionsp\xe8\a"
+	"\x15ProcessWellKnownTypes\x121.testdata.ProcessWellKnownTypesRequestEdition2023\x1a2.testdata.ProcessWellKnownTypesResponseEdition2023B\xa7\x01\n" +
+	"\fcom.testdataB\x14Edition2023TestProtoP\x01ZAgithub.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\beditionsp\xe8\a"
 
 var (
 	file_testdata_edition_2023_test_proto_rawDescOnce sync.Once
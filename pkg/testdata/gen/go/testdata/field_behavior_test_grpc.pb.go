@@ -0,0 +1,142 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/field_behavior_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FieldBehaviorTestService_UpdateBook_FullMethodName = "/testdata.FieldBehaviorTestService/UpdateBook"
+)
+
+// FieldBehaviorTestServiceClient is the client API for FieldBehaviorTestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FieldBehaviorTestService provides operations on resources with annotated fields
+type FieldBehaviorTestServiceClient interface {
+	// UpdateBook updates a book
+	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error)
+}
+
+type fieldBehaviorTestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFieldBehaviorTestServiceClient(cc grpc.ClientConnInterface) FieldBehaviorTestServiceClient {
+	return &fieldBehaviorTestServiceClient{cc}
+}
+
+func (c *fieldBehaviorTestServiceClient) UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, FieldBehaviorTestService_UpdateBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FieldBehaviorTestServiceServer is the server API for FieldBehaviorTestService service.
+// All implementations must embed UnimplementedFieldBehaviorTestServiceServer
+// for forward compatibility.
+//
+// FieldBehaviorTestService provides operations on resources with annotated fields
+type FieldBehaviorTestServiceServer interface {
+	// UpdateBook updates a book
+	UpdateBook(context.Context, *UpdateBookRequest) (*Book, error)
+	mustEmbedUnimplementedFieldBehaviorTestServiceServer()
+}
+
+// UnimplementedFieldBehaviorTestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFieldBehaviorTestServiceServer struct{}
+
+func (UnimplementedFieldBehaviorTestServiceServer) UpdateBook(context.Context, *UpdateBookRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBook not implemented")
+}
+func (UnimplementedFieldBehaviorTestServiceServer) mustEmbedUnimplementedFieldBehaviorTestServiceServer() {
+}
+func (UnimplementedFieldBehaviorTestServiceServer) testEmbeddedByValue() {}
+
+// UnsafeFieldBehaviorTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FieldBehaviorTestServiceServer will
+// result in compilation errors.
+type UnsafeFieldBehaviorTestServiceServer interface {
+	mustEmbedUnimplementedFieldBehaviorTestServiceServer()
+}
+
+func RegisterFieldBehaviorTestServiceServer(s grpc.ServiceRegistrar, srv FieldBehaviorTestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFieldBehaviorTestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FieldBehaviorTestService_ServiceDesc, srv)
+}
+
+func _FieldBehaviorTestService_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FieldBehaviorTestServiceServer).UpdateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FieldBehaviorTestService_UpdateBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FieldBehaviorTestServiceServer).UpdateBook(ctx, req.(*UpdateBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FieldBehaviorTestService_ServiceDesc is the grpc.ServiceDesc for FieldBehaviorTestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FieldBehaviorTestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.FieldBehaviorTestService",
+	HandlerType: (*FieldBehaviorTestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateBook",
+			Handler:    _FieldBehaviorTestService_UpdateBook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/field_behavior_test.proto",
+}
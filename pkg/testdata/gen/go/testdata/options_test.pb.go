@@ -0,0 +1,617 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: testdata/options_test.proto
+
+package testdata
+
+import (
+	_ "github.com/statico/protoc-gen-go-mcp/pkg/mcpoptions"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Product struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the product
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the product
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_testdata_options_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_testdata_options_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{1}
+}
+
+type ListProductsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All products
+	Products      []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_testdata_options_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type GetProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the product
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_testdata_options_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProductResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The product
+	Product       *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_testdata_options_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The product to update
+	Product       *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_testdata_options_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UpdateProductResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated product
+	Product       *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_testdata_options_test_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type DeleteProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the product to delete
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_testdata_options_test_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_testdata_options_test_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{8}
+}
+
+type PurgeProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeProductsRequest) Reset() {
+	*x = PurgeProductsRequest{}
+	mi := &file_testdata_options_test_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeProductsRequest) ProtoMessage() {}
+
+func (x *PurgeProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeProductsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeProductsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{9}
+}
+
+type PurgeProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeProductsResponse) Reset() {
+	*x = PurgeProductsResponse{}
+	mi := &file_testdata_options_test_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeProductsResponse) ProtoMessage() {}
+
+func (x *PurgeProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_options_test_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeProductsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeProductsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_options_test_proto_rawDescGZIP(), []int{10}
+}
+
+var File_testdata_options_test_proto protoreflect.FileDescriptor
+
+const file_testdata_options_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1btestdata/options_test.proto\x12\btestdata\x1a\x1cgoogle/api/annotations.proto\x1a\x11mcp/options.proto\"-\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x15\n" +
+	"\x13ListProductsRequest\"E\n" +
+	"\x14ListProductsResponse\x12-\n" +
+	"\bproducts\x18\x01 \x03(\v2\x11.testdata.ProductR\bproducts\"#\n" +
+	"\x11GetProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x12GetProductResponse\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.testdata.ProductR\aproduct\"C\n" +
+	"\x14UpdateProductRequest\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.testdata.ProductR\aproduct\"D\n" +
+	"\x15UpdateProductResponse\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.testdata.ProductR\aproduct\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteProductResponse\"\x16\n" +
+	"\x14PurgeProductsRequest\"\x17\n" +
+	"\x15PurgeProductsResponse2\xf8\x04\n" +
+	"\x12OptionsTestService\x12h\n" +
+	"\fListProducts\x12\x1d.testdata.ListProductsRequest\x1a\x1e.testdata.ListProductsResponse\"\x19\xba\xd7\x18\x15\x12\rList products(\x018\x01@\x00\x12L\n" +
+	"\n" +
+	"GetProduct\x12\x1b.testdata.GetProductRequest\x1a\x1c.testdata.GetProductResponse\"\x03\x90\x02\x01\x12\x7f\n" +
+	"\rUpdateProduct\x12\x1e.testdata.UpdateProductRequest\x1a\x1f.testdata.UpdateProductResponse\"-\x82\xd3\xe4\x93\x02$:\aproduct\x1a\x19/v1/products/{product.id}\x90\x02\x02\x12\xbd\x01\n" +
+	"\rDeleteProduct\x12\x1e.testdata.DeleteProductRequest\x1a\x1f.testdata.DeleteProductResponse\"k\xba\xd7\x18N\n" +
+	"\x0eremove_product\x1a<Removes a product from the inventory. This cannot be undone.\x82\xd3\xe4\x93\x02\x13*\x11/v1/products/{id}\x12X\n" +
+	"\rPurgeProducts\x12\x1e.testdata.PurgeProductsRequest\x1a\x1f.testdata.PurgeProductsResponse\"\x06\xba\xd7\x18\x02 \x01\x1a\x0f\xc2\xd7\x18\v\n" +
+	"\tinventory2o\n" +
+	"\x13ExcludedTestService\x12P\n" +
+	"\rPurgeProducts\x12\x1e.testdata.PurgeProductsRequest\x1a\x1f.testdata.PurgeProductsResponse\x1a\x06\xc2\xd7\x18\x02\x10\x01B\xa3\x01\n" +
+	"\fcom.testdataB\x10OptionsTestProtoP\x01ZAgithub.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_options_test_proto_rawDescOnce sync.Once
+	file_testdata_options_test_proto_rawDescData []byte
+)
+
+func file_testdata_options_test_proto_rawDescGZIP() []byte {
+	file_testdata_options_test_proto_rawDescOnce.Do(func() {
+		file_testdata_options_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_options_test_proto_rawDesc), len(file_testdata_options_test_proto_rawDesc)))
+	})
+	return file_testdata_options_test_proto_rawDescData
+}
+
+var file_testdata_options_test_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_testdata_options_test_proto_goTypes = []any{
+	(*Product)(nil),               // 0: testdata.Product
+	(*ListProductsRequest)(nil),   // 1: testdata.ListProductsRequest
+	(*ListProductsResponse)(nil),  // 2: testdata.ListProductsResponse
+	(*GetProductRequest)(nil),     // 3: testdata.GetProductRequest
+	(*GetProductResponse)(nil),    // 4: testdata.GetProductResponse
+	(*UpdateProductRequest)(nil),  // 5: testdata.UpdateProductRequest
+	(*UpdateProductResponse)(nil), // 6: testdata.UpdateProductResponse
+	(*DeleteProductRequest)(nil),  // 7: testdata.DeleteProductRequest
+	(*DeleteProductResponse)(nil), // 8: testdata.DeleteProductResponse
+	(*PurgeProductsRequest)(nil),  // 9: testdata.PurgeProductsRequest
+	(*PurgeProductsResponse)(nil), // 10: testdata.PurgeProductsResponse
+}
+var file_testdata_options_test_proto_depIdxs = []int32{
+	0,  // 0: testdata.ListProductsResponse.products:type_name -> testdata.Product
+	0,  // 1: testdata.GetProductResponse.product:type_name -> testdata.Product
+	0,  // 2: testdata.UpdateProductRequest.product:type_name -> testdata.Product
+	0,  // 3: testdata.UpdateProductResponse.product:type_name -> testdata.Product
+	1,  // 4: testdata.OptionsTestService.ListProducts:input_type -> testdata.ListProductsRequest
+	3,  // 5: testdata.OptionsTestService.GetProduct:input_type -> testdata.GetProductRequest
+	5,  // 6: testdata.OptionsTestService.UpdateProduct:input_type -> testdata.UpdateProductRequest
+	7,  // 7: testdata.OptionsTestService.DeleteProduct:input_type -> testdata.DeleteProductRequest
+	9,  // 8: testdata.OptionsTestService.PurgeProducts:input_type -> testdata.PurgeProductsRequest
+	9,  // 9: testdata.ExcludedTestService.PurgeProducts:input_type -> testdata.PurgeProductsRequest
+	2,  // 10: testdata.OptionsTestService.ListProducts:output_type -> testdata.ListProductsResponse
+	4,  // 11: testdata.OptionsTestService.GetProduct:output_type -> testdata.GetProductResponse
+	6,  // 12: testdata.OptionsTestService.UpdateProduct:output_type -> testdata.UpdateProductResponse
+	8,  // 13: testdata.OptionsTestService.DeleteProduct:output_type -> testdata.DeleteProductResponse
+	10, // 14: testdata.OptionsTestService.PurgeProducts:output_type -> testdata.PurgeProductsResponse
+	10, // 15: testdata.ExcludedTestService.PurgeProducts:output_type -> testdata.PurgeProductsResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_testdata_options_test_proto_init() }
+func file_testdata_options_test_proto_init() {
+	if File_testdata_options_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_options_test_proto_rawDesc), len(file_testdata_options_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_testdata_options_test_proto_goTypes,
+		DependencyIndexes: file_testdata_options_test_proto_depIdxs,
+		MessageInfos:      file_testdata_options_test_proto_msgTypes,
+	}.Build()
+	File_testdata_options_test_proto = out.File
+	file_testdata_options_test_proto_goTypes = nil
+	file_testdata_options_test_proto_depIdxs = nil
+}
@@ -0,0 +1,409 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/options_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OptionsTestService_ListProducts_FullMethodName  = "/testdata.OptionsTestService/ListProducts"
+	OptionsTestService_GetProduct_FullMethodName    = "/testdata.OptionsTestService/GetProduct"
+	OptionsTestService_UpdateProduct_FullMethodName = "/testdata.OptionsTestService/UpdateProduct"
+	OptionsTestService_DeleteProduct_FullMethodName = "/testdata.OptionsTestService/DeleteProduct"
+	OptionsTestService_PurgeProducts_FullMethodName = "/testdata.OptionsTestService/PurgeProducts"
+)
+
+// OptionsTestServiceClient is the client API for OptionsTestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OptionsTestService tests tool configuration through MCP options
+type OptionsTestServiceClient interface {
+	// ListProducts lists all products
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	// GetProduct returns a single product
+	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
+	// UpdateProduct replaces a product
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
+	// DeleteProduct deletes a product
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	// PurgeProducts is an admin operation that must not be exposed
+	PurgeProducts(ctx context.Context, in *PurgeProductsRequest, opts ...grpc.CallOption) (*PurgeProductsResponse, error)
+}
+
+type optionsTestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOptionsTestServiceClient(cc grpc.ClientConnInterface) OptionsTestServiceClient {
+	return &optionsTestServiceClient{cc}
+}
+
+func (c *optionsTestServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, OptionsTestService_ListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionsTestServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductResponse)
+	err := c.cc.Invoke(ctx, OptionsTestService_GetProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionsTestServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductResponse)
+	err := c.cc.Invoke(ctx, OptionsTestService_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionsTestServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, OptionsTestService_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionsTestServiceClient) PurgeProducts(ctx context.Context, in *PurgeProductsRequest, opts ...grpc.CallOption) (*PurgeProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeProductsResponse)
+	err := c.cc.Invoke(ctx, OptionsTestService_PurgeProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OptionsTestServiceServer is the server API for OptionsTestService service.
+// All implementations must embed UnimplementedOptionsTestServiceServer
+// for forward compatibility.
+//
+// OptionsTestService tests tool configuration through MCP options
+type OptionsTestServiceServer interface {
+	// ListProducts lists all products
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	// GetProduct returns a single product
+	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
+	// UpdateProduct replaces a product
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+	// DeleteProduct deletes a product
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	// PurgeProducts is an admin operation that must not be exposed
+	PurgeProducts(context.Context, *PurgeProductsRequest) (*PurgeProductsResponse, error)
+	mustEmbedUnimplementedOptionsTestServiceServer()
+}
+
+// UnimplementedOptionsTestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOptionsTestServiceServer struct{}
+
+func (UnimplementedOptionsTestServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedOptionsTestServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedOptionsTestServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedOptionsTestServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedOptionsTestServiceServer) PurgeProducts(context.Context, *PurgeProductsRequest) (*PurgeProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeProducts not implemented")
+}
+func (UnimplementedOptionsTestServiceServer) mustEmbedUnimplementedOptionsTestServiceServer() {}
+func (UnimplementedOptionsTestServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeOptionsTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OptionsTestServiceServer will
+// result in compilation errors.
+type UnsafeOptionsTestServiceServer interface {
+	mustEmbedUnimplementedOptionsTestServiceServer()
+}
+
+func RegisterOptionsTestServiceServer(s grpc.ServiceRegistrar, srv OptionsTestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOptionsTestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OptionsTestService_ServiceDesc, srv)
+}
+
+func _OptionsTestService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionsTestServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionsTestService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionsTestServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionsTestService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionsTestServiceServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionsTestService_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionsTestServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionsTestService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionsTestServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionsTestService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionsTestServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionsTestService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionsTestServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionsTestService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionsTestServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionsTestService_PurgeProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionsTestServiceServer).PurgeProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionsTestService_PurgeProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionsTestServiceServer).PurgeProducts(ctx, req.(*PurgeProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OptionsTestService_ServiceDesc is the grpc.ServiceDesc for OptionsTestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OptionsTestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.OptionsTestService",
+	HandlerType: (*OptionsTestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProducts",
+			Handler:    _OptionsTestService_ListProducts_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _OptionsTestService_GetProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _OptionsTestService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _OptionsTestService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "PurgeProducts",
+			Handler:    _OptionsTestService_PurgeProducts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/options_test.proto",
+}
+
+const (
+	ExcludedTestService_PurgeProducts_FullMethodName = "/testdata.ExcludedTestService/PurgeProducts"
+)
+
+// ExcludedTestServiceClient is the client API for ExcludedTestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ExcludedTestService must not be exposed at all
+type ExcludedTestServiceClient interface {
+	// PurgeProducts is an admin operation that must not be exposed
+	PurgeProducts(ctx context.Context, in *PurgeProductsRequest, opts ...grpc.CallOption) (*PurgeProductsResponse, error)
+}
+
+type excludedTestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExcludedTestServiceClient(cc grpc.ClientConnInterface) ExcludedTestServiceClient {
+	return &excludedTestServiceClient{cc}
+}
+
+func (c *excludedTestServiceClient) PurgeProducts(ctx context.Context, in *PurgeProductsRequest, opts ...grpc.CallOption) (*PurgeProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeProductsResponse)
+	err := c.cc.Invoke(ctx, ExcludedTestService_PurgeProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExcludedTestServiceServer is the server API for ExcludedTestService service.
+// All implementations must embed UnimplementedExcludedTestServiceServer
+// for forward compatibility.
+//
+// ExcludedTestService must not be exposed at all
+type ExcludedTestServiceServer interface {
+	// PurgeProducts is an admin operation that must not be exposed
+	PurgeProducts(context.Context, *PurgeProductsRequest) (*PurgeProductsResponse, error)
+	mustEmbedUnimplementedExcludedTestServiceServer()
+}
+
+// UnimplementedExcludedTestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExcludedTestServiceServer struct{}
+
+func (UnimplementedExcludedTestServiceServer) PurgeProducts(context.Context, *PurgeProductsRequest) (*PurgeProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeProducts not implemented")
+}
+func (UnimplementedExcludedTestServiceServer) mustEmbedUnimplementedExcludedTestServiceServer() {}
+func (UnimplementedExcludedTestServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeExcludedTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExcludedTestServiceServer will
+// result in compilation errors.
+type UnsafeExcludedTestServiceServer interface {
+	mustEmbedUnimplementedExcludedTestServiceServer()
+}
+
+func RegisterExcludedTestServiceServer(s grpc.ServiceRegistrar, srv ExcludedTestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExcludedTestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExcludedTestService_ServiceDesc, srv)
+}
+
+func _ExcludedTestService_PurgeProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExcludedTestServiceServer).PurgeProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExcludedTestService_PurgeProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExcludedTestServiceServer).PurgeProducts(ctx, req.(*PurgeProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExcludedTestService_ServiceDesc is the grpc.ServiceDesc for ExcludedTestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExcludedTestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.ExcludedTestService",
+	HandlerType: (*ExcludedTestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PurgeProducts",
+			Handler:    _ExcludedTestService_PurgeProducts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/options_test.proto",
+}
@@ -0,0 +1,346 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: testdata/pagination_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Shelf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Theme         string                 `protobuf:"bytes,2,opt,name=theme,proto3" json:"theme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shelf) Reset() {
+	*x = Shelf{}
+	mi := &file_testdata_pagination_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shelf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shelf) ProtoMessage() {}
+
+func (x *Shelf) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_pagination_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shelf.ProtoReflect.Descriptor instead.
+func (*Shelf) Descriptor() ([]byte, []int) {
+	return file_testdata_pagination_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Shelf) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Shelf) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+type ListShelvesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of shelves to return
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token of a previous call
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShelvesRequest) Reset() {
+	*x = ListShelvesRequest{}
+	mi := &file_testdata_pagination_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShelvesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShelvesRequest) ProtoMessage() {}
+
+func (x *ListShelvesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_pagination_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShelvesRequest.ProtoReflect.Descriptor instead.
+func (*ListShelvesRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_pagination_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListShelvesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListShelvesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListShelvesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListShelvesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Shelves []*Shelf               `protobuf:"bytes,1,rep,name=shelves,proto3" json:"shelves,omitempty"`
+	// Token of the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShelvesResponse) Reset() {
+	*x = ListShelvesResponse{}
+	mi := &file_testdata_pagination_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShelvesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShelvesResponse) ProtoMessage() {}
+
+func (x *ListShelvesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_pagination_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShelvesResponse.ProtoReflect.Descriptor instead.
+func (*ListShelvesResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_pagination_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListShelvesResponse) GetShelves() []*Shelf {
+	if x != nil {
+		return x.Shelves
+	}
+	return nil
+}
+
+func (x *ListShelvesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListShelvesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type SearchShelvesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchShelvesRequest) Reset() {
+	*x = SearchShelvesRequest{}
+	mi := &file_testdata_pagination_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchShelvesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchShelvesRequest) ProtoMessage() {}
+
+func (x *SearchShelvesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_pagination_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchShelvesRequest.ProtoReflect.Descriptor instead.
+func (*SearchShelvesRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_pagination_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchShelvesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchShelvesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+var File_testdata_pagination_test_proto protoreflect.FileDescriptor
+
+const file_testdata_pagination_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1etestdata/pagination_test.proto\x12\btestdata\"1\n" +
+	"\x05Shelf\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05theme\x18\x02 \x01(\tR\x05theme\"h\n" +
+	"\x12ListShelvesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x87\x01\n" +
+	"\x13ListShelvesResponse\x12)\n" +
+	"\ashelves\x18\x01 \x03(\v2\x0f.testdata.ShelfR\ashelves\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"K\n" +
+	"\x14SearchShelvesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken2\xb3\x01\n" +
+	"\x15PaginationTestService\x12J\n" +
+	"\vListShelves\x12\x1c.testdata.ListShelvesRequest\x1a\x1d.testdata.ListShelvesResponse\x12N\n" +
+	"\rSearchShelves\x12\x1e.testdata.SearchShelvesRequest\x1a\x1d.testdata.ListShelvesResponseB\xa6\x01\n" +
+	"\fcom.testdataB\x13PaginationTestProtoP\x01ZAgithub.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_pagination_test_proto_rawDescOnce sync.Once
+	file_testdata_pagination_test_proto_rawDescData []byte
+)
+
+func file_testdata_pagination_test_proto_rawDescGZIP() []byte {
+	file_testdata_pagination_test_proto_rawDescOnce.Do(func() {
+		file_testdata_pagination_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_pagination_test_proto_rawDesc), len(file_testdata_pagination_test_proto_rawDesc)))
+	})
+	return file_testdata_pagination_test_proto_rawDescData
+}
+
+var file_testdata_pagination_test_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testdata_pagination_test_proto_goTypes = []any{
+	(*Shelf)(nil),                // 0: testdata.Shelf
+	(*ListShelvesRequest)(nil),   // 1: testdata.ListShelvesRequest
+	(*ListShelvesResponse)(nil),  // 2: testdata.ListShelvesResponse
+	(*SearchShelvesRequest)(nil), // 3: testdata.SearchShelvesRequest
+}
+var file_testdata_pagination_test_proto_depIdxs = []int32{
+	0, // 0: testdata.ListShelvesResponse.shelves:type_name -> testdata.Shelf
+	1, // 1: testdata.PaginationTestService.ListShelves:input_type -> testdata.ListShelvesRequest
+	3, // 2: testdata.PaginationTestService.SearchShelves:input_type -> testdata.SearchShelvesRequest
+	2, // 3: testdata.PaginationTestService.ListShelves:output_type -> testdata.ListShelvesResponse
+	2, // 4: testdata.PaginationTestService.SearchShelves:output_type -> testdata.ListShelvesResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_pagination_test_proto_init() }
+func file_testdata_pagination_test_proto_init() {
+	if File_testdata_pagination_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_pagination_test_proto_rawDesc), len(file_testdata_pagination_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_pagination_test_proto_goTypes,
+		DependencyIndexes: file_testdata_pagination_test_proto_depIdxs,
+		MessageInfos:      file_testdata_pagination_test_proto_msgTypes,
+	}.Build()
+	File_testdata_pagination_test_proto = out.File
+	file_testdata_pagination_test_proto_goTypes = nil
+	file_testdata_pagination_test_proto_depIdxs = nil
+}
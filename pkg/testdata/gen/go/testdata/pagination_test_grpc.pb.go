@@ -0,0 +1,181 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/pagination_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PaginationTestService_ListShelves_FullMethodName   = "/testdata.PaginationTestService/ListShelves"
+	PaginationTestService_SearchShelves_FullMethodName = "/testdata.PaginationTestService/SearchShelves"
+)
+
+// PaginationTestServiceClient is the client API for PaginationTestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PaginationTestService provides paginated list methods
+type PaginationTestServiceClient interface {
+	// ListShelves lists shelves
+	ListShelves(ctx context.Context, in *ListShelvesRequest, opts ...grpc.CallOption) (*ListShelvesResponse, error)
+	// SearchShelves has no page size, so it is not an AIP-158 list method
+	SearchShelves(ctx context.Context, in *SearchShelvesRequest, opts ...grpc.CallOption) (*ListShelvesResponse, error)
+}
+
+type paginationTestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaginationTestServiceClient(cc grpc.ClientConnInterface) PaginationTestServiceClient {
+	return &paginationTestServiceClient{cc}
+}
+
+func (c *paginationTestServiceClient) ListShelves(ctx context.Context, in *ListShelvesRequest, opts ...grpc.CallOption) (*ListShelvesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShelvesResponse)
+	err := c.cc.Invoke(ctx, PaginationTestService_ListShelves_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paginationTestServiceClient) SearchShelves(ctx context.Context, in *SearchShelvesRequest, opts ...grpc.CallOption) (*ListShelvesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShelvesResponse)
+	err := c.cc.Invoke(ctx, PaginationTestService_SearchShelves_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaginationTestServiceServer is the server API for PaginationTestService service.
+// All implementations must embed UnimplementedPaginationTestServiceServer
+// for forward compatibility.
+//
+// PaginationTestService provides paginated list methods
+type PaginationTestServiceServer interface {
+	// ListShelves lists shelves
+	ListShelves(context.Context, *ListShelvesRequest) (*ListShelvesResponse, error)
+	// SearchShelves has no page size, so it is not an AIP-158 list method
+	SearchShelves(context.Context, *SearchShelvesRequest) (*ListShelvesResponse, error)
+	mustEmbedUnimplementedPaginationTestServiceServer()
+}
+
+// UnimplementedPaginationTestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaginationTestServiceServer struct{}
+
+func (UnimplementedPaginationTestServiceServer) ListShelves(context.Context, *ListShelvesRequest) (*ListShelvesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShelves not implemented")
+}
+func (UnimplementedPaginationTestServiceServer) SearchShelves(context.Context, *SearchShelvesRequest) (*ListShelvesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchShelves not implemented")
+}
+func (UnimplementedPaginationTestServiceServer) mustEmbedUnimplementedPaginationTestServiceServer() {}
+func (UnimplementedPaginationTestServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafePaginationTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaginationTestServiceServer will
+// result in compilation errors.
+type UnsafePaginationTestServiceServer interface {
+	mustEmbedUnimplementedPaginationTestServiceServer()
+}
+
+func RegisterPaginationTestServiceServer(s grpc.ServiceRegistrar, srv PaginationTestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaginationTestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaginationTestService_ServiceDesc, srv)
+}
+
+func _PaginationTestService_ListShelves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShelvesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaginationTestServiceServer).ListShelves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaginationTestService_ListShelves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaginationTestServiceServer).ListShelves(ctx, req.(*ListShelvesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaginationTestService_SearchShelves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchShelvesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaginationTestServiceServer).SearchShelves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaginationTestService_SearchShelves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaginationTestServiceServer).SearchShelves(ctx, req.(*SearchShelvesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaginationTestService_ServiceDesc is the grpc.ServiceDesc for PaginationTestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaginationTestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.PaginationTestService",
+	HandlerType: (*PaginationTestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListShelves",
+			Handler:    _PaginationTestService_ListShelves_Handler,
+		},
+		{
+			MethodName: "SearchShelves",
+			Handler:    _PaginationTestService_SearchShelves_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/pagination_test.proto",
+}
@@ -0,0 +1,518 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: testdata/recursive_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TreeNode is a node of a tree
+type TreeNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Child nodes
+	Children        []*TreeNode          `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	LabeledChildren map[string]*TreeNode `protobuf:"bytes,3,rep,name=labeled_children,json=labeledChildren,proto3" json:"labeled_children,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Expression      *Expression          `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TreeNode) Reset() {
+	*x = TreeNode{}
+	mi := &file_testdata_recursive_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeNode) ProtoMessage() {}
+
+func (x *TreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_recursive_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeNode.ProtoReflect.Descriptor instead.
+func (*TreeNode) Descriptor() ([]byte, []int) {
+	return file_testdata_recursive_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TreeNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TreeNode) GetChildren() []*TreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *TreeNode) GetLabeledChildren() map[string]*TreeNode {
+	if x != nil {
+		return x.LabeledChildren
+	}
+	return nil
+}
+
+func (x *TreeNode) GetExpression() *Expression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
+// Expression references itself through BinaryExpression
+type Expression struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Expression_Literal
+	//	*Expression_Binary
+	Kind          isExpression_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expression) Reset() {
+	*x = Expression{}
+	mi := &file_testdata_recursive_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expression) ProtoMessage() {}
+
+func (x *Expression) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_recursive_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expression.ProtoReflect.Descriptor instead.
+func (*Expression) Descriptor() ([]byte, []int) {
+	return file_testdata_recursive_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Expression) GetKind() isExpression_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Expression) GetLiteral() string {
+	if x != nil {
+		if x, ok := x.Kind.(*Expression_Literal); ok {
+			return x.Literal
+		}
+	}
+	return ""
+}
+
+func (x *Expression) GetBinary() *BinaryExpression {
+	if x != nil {
+		if x, ok := x.Kind.(*Expression_Binary); ok {
+			return x.Binary
+		}
+	}
+	return nil
+}
+
+type isExpression_Kind interface {
+	isExpression_Kind()
+}
+
+type Expression_Literal struct {
+	Literal string `protobuf:"bytes,1,opt,name=literal,proto3,oneof"`
+}
+
+type Expression_Binary struct {
+	Binary *BinaryExpression `protobuf:"bytes,2,opt,name=binary,proto3,oneof"`
+}
+
+func (*Expression_Literal) isExpression_Kind() {}
+
+func (*Expression_Binary) isExpression_Kind() {}
+
+type BinaryExpression struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operator      string                 `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Left          *Expression            `protobuf:"bytes,2,opt,name=left,proto3" json:"left,omitempty"`
+	Right         *Expression            `protobuf:"bytes,3,opt,name=right,proto3" json:"right,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinaryExpression) Reset() {
+	*x = BinaryExpression{}
+	mi := &file_testdata_recursive_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinaryExpression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryExpression) ProtoMessage() {}
+
+func (x *BinaryExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_recursive_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryExpression.ProtoReflect.Descriptor instead.
+func (*BinaryExpression) Descriptor() ([]byte, []int) {
+	return file_testdata_recursive_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BinaryExpression) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *BinaryExpression) GetLeft() *Expression {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *BinaryExpression) GetRight() *Expression {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+// Address is referenced from several fields
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_testdata_recursive_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_recursive_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_testdata_recursive_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type CreateTreeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Root            *TreeNode              `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	BillingAddress  *Address               `protobuf:"bytes,2,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	ShippingAddress *Address               `protobuf:"bytes,3,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTreeRequest) Reset() {
+	*x = CreateTreeRequest{}
+	mi := &file_testdata_recursive_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTreeRequest) ProtoMessage() {}
+
+func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_recursive_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTreeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTreeRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_recursive_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTreeRequest) GetRoot() *TreeNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *CreateTreeRequest) GetBillingAddress() *Address {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *CreateTreeRequest) GetShippingAddress() *Address {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+type CreateTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          *TreeNode              `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTreeResponse) Reset() {
+	*x = CreateTreeResponse{}
+	mi := &file_testdata_recursive_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTreeResponse) ProtoMessage() {}
+
+func (x *CreateTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_recursive_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTreeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTreeResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_recursive_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTreeResponse) GetRoot() *TreeNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+var File_testdata_recursive_test_proto protoreflect.FileDescriptor
+
+const file_testdata_recursive_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtestdata/recursive_test.proto\x12\btestdata\"\xb0\x02\n" +
+	"\bTreeNode\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\bchildren\x18\x02 \x03(\v2\x12.testdata.TreeNodeR\bchildren\x12R\n" +
+	"\x10labeled_children\x18\x03 \x03(\v2'.testdata.TreeNode.LabeledChildrenEntryR\x0flabeledChildren\x124\n" +
+	"\n" +
+	"expression\x18\x04 \x01(\v2\x14.testdata.ExpressionR\n" +
+	"expression\x1aV\n" +
+	"\x14LabeledChildrenEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.testdata.TreeNodeR\x05value:\x028\x01\"f\n" +
+	"\n" +
+	"Expression\x12\x1a\n" +
+	"\aliteral\x18\x01 \x01(\tH\x00R\aliteral\x124\n" +
+	"\x06binary\x18\x02 \x01(\v2\x1a.testdata.BinaryExpressionH\x00R\x06binaryB\x06\n" +
+	"\x04kind\"\x84\x01\n" +
+	"\x10BinaryExpression\x12\x1a\n" +
+	"\boperator\x18\x01 \x01(\tR\boperator\x12(\n" +
+	"\x04left\x18\x02 \x01(\v2\x14.testdata.ExpressionR\x04left\x12*\n" +
+	"\x05right\x18\x03 \x01(\v2\x14.testdata.ExpressionR\x05right\"5\n" +
+	"\aAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\"\xb5\x01\n" +
+	"\x11CreateTreeRequest\x12&\n" +
+	"\x04root\x18\x01 \x01(\v2\x12.testdata.TreeNodeR\x04root\x12:\n" +
+	"\x0fbilling_address\x18\x02 \x01(\v2\x11.testdata.AddressR\x0ebillingAddress\x12<\n" +
+	"\x10shipping_address\x18\x03 \x01(\v2\x11.testdata.AddressR\x0fshippingAddress\"<\n" +
+	"\x12CreateTreeResponse\x12&\n" +
+	"\x04root\x18\x01 \x01(\v2\x12.testdata.TreeNodeR\x04root2\xa0\x01\n" +
+	"\x14RecursiveTestService\x12G\n" +
+	"\n" +
+	"CreateTree\x12\x1b.testdata.CreateTreeRequest\x1a\x1c.testdata.CreateTreeResponse\x12?\n" +
+	"\bWalkTree\x12\x1b.testdata.CreateTreeRequest\x1a\x12.testdata.TreeNode(\x010\x01B\xa5\x01\n" +
+	"\fcom.testdataB\x12RecursiveTestProtoP\x01ZAgithub.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_recursive_test_proto_rawDescOnce sync.Once
+	file_testdata_recursive_test_proto_rawDescData []byte
+)
+
+func file_testdata_recursive_test_proto_rawDescGZIP() []byte {
+	file_testdata_recursive_test_proto_rawDescOnce.Do(func() {
+		file_testdata_recursive_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_recursive_test_proto_rawDesc), len(file_testdata_recursive_test_proto_rawDesc)))
+	})
+	return file_testdata_recursive_test_proto_rawDescData
+}
+
+var file_testdata_recursive_test_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_testdata_recursive_test_proto_goTypes = []any{
+	(*TreeNode)(nil),           // 0: testdata.TreeNode
+	(*Expression)(nil),         // 1: testdata.Expression
+	(*BinaryExpression)(nil),   // 2: testdata.BinaryExpression
+	(*Address)(nil),            // 3: testdata.Address
+	(*CreateTreeRequest)(nil),  // 4: testdata.CreateTreeRequest
+	(*CreateTreeResponse)(nil), // 5: testdata.CreateTreeResponse
+	nil,                        // 6: testdata.TreeNode.LabeledChildrenEntry
+}
+var file_testdata_recursive_test_proto_depIdxs = []int32{
+	0,  // 0: testdata.TreeNode.children:type_name -> testdata.TreeNode
+	6,  // 1: testdata.TreeNode.labeled_children:type_name -> testdata.TreeNode.LabeledChildrenEntry
+	1,  // 2: testdata.TreeNode.expression:type_name -> testdata.Expression
+	2,  // 3: testdata.Expression.binary:type_name -> testdata.BinaryExpression
+	1,  // 4: testdata.BinaryExpression.left:type_name -> testdata.Expression
+	1,  // 5: testdata.BinaryExpression.right:type_name -> testdata.Expression
+	0,  // 6: testdata.CreateTreeRequest.root:type_name -> testdata.TreeNode
+	3,  // 7: testdata.CreateTreeRequest.billing_address:type_name -> testdata.Address
+	3,  // 8: testdata.CreateTreeRequest.shipping_address:type_name -> testdata.Address
+	0,  // 9: testdata.CreateTreeResponse.root:type_name -> testdata.TreeNode
+	0,  // 10: testdata.TreeNode.LabeledChildrenEntry.value:type_name -> testdata.TreeNode
+	4,  // 11: testdata.RecursiveTestService.CreateTree:input_type -> testdata.CreateTreeRequest
+	4,  // 12: testdata.RecursiveTestService.WalkTree:input_type -> testdata.CreateTreeRequest
+	5,  // 13: testdata.RecursiveTestService.CreateTree:output_type -> testdata.CreateTreeResponse
+	0,  // 14: testdata.RecursiveTestService.WalkTree:output_type -> testdata.TreeNode
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_testdata_recursive_test_proto_init() }
+func file_testdata_recursive_test_proto_init() {
+	if File_testdata_recursive_test_proto != nil {
+		return
+	}
+	file_testdata_recursive_test_proto_msgTypes[1].OneofWrappers = []any{
+		(*Expression_Literal)(nil),
+		(*Expression_Binary)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_recursive_test_proto_rawDesc), len(file_testdata_recursive_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_recursive_test_proto_goTypes,
+		DependencyIndexes: file_testdata_recursive_test_proto_depIdxs,
+		MessageInfos:      file_testdata_recursive_test_proto_msgTypes,
+	}.Build()
+	File_testdata_recursive_test_proto = out.File
+	file_testdata_recursive_test_proto_goTypes = nil
+	file_testdata_recursive_test_proto_depIdxs = nil
+}
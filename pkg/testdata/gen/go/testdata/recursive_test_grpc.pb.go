@@ -0,0 +1,176 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/recursive_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RecursiveTestService_CreateTree_FullMethodName = "/testdata.RecursiveTestService/CreateTree"
+	RecursiveTestService_WalkTree_FullMethodName   = "/testdata.RecursiveTestService/WalkTree"
+)
+
+// RecursiveTestServiceClient is the client API for RecursiveTestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RecursiveTestService provides operations on recursive messages
+type RecursiveTestServiceClient interface {
+	// CreateTree creates a tree of nodes
+	CreateTree(ctx context.Context, in *CreateTreeRequest, opts ...grpc.CallOption) (*CreateTreeResponse, error)
+	// WalkTree streams the nodes of trees
+	WalkTree(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CreateTreeRequest, TreeNode], error)
+}
+
+type recursiveTestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecursiveTestServiceClient(cc grpc.ClientConnInterface) RecursiveTestServiceClient {
+	return &recursiveTestServiceClient{cc}
+}
+
+func (c *recursiveTestServiceClient) CreateTree(ctx context.Context, in *CreateTreeRequest, opts ...grpc.CallOption) (*CreateTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTreeResponse)
+	err := c.cc.Invoke(ctx, RecursiveTestService_CreateTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursiveTestServiceClient) WalkTree(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CreateTreeRequest, TreeNode], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecursiveTestService_ServiceDesc.Streams[0], RecursiveTestService_WalkTree_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateTreeRequest, TreeNode]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecursiveTestService_WalkTreeClient = grpc.BidiStreamingClient[CreateTreeRequest, TreeNode]
+
+// RecursiveTestServiceServer is the server API for RecursiveTestService service.
+// All implementations must embed UnimplementedRecursiveTestServiceServer
+// for forward compatibility.
+//
+// RecursiveTestService provides operations on recursive messages
+type RecursiveTestServiceServer interface {
+	// CreateTree creates a tree of nodes
+	CreateTree(context.Context, *CreateTreeRequest) (*CreateTreeResponse, error)
+	// WalkTree streams the nodes of trees
+	WalkTree(grpc.BidiStreamingServer[CreateTreeRequest, TreeNode]) error
+	mustEmbedUnimplementedRecursiveTestServiceServer()
+}
+
+// UnimplementedRecursiveTestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecursiveTestServiceServer struct{}
+
+func (UnimplementedRecursiveTestServiceServer) CreateTree(context.Context, *CreateTreeRequest) (*CreateTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTree not implemented")
+}
+func (UnimplementedRecursiveTestServiceServer) WalkTree(grpc.BidiStreamingServer[CreateTreeRequest, TreeNode]) error {
+	return status.Errorf(codes.Unimplemented, "method WalkTree not implemented")
+}
+func (UnimplementedRecursiveTestServiceServer) mustEmbedUnimplementedRecursiveTestServiceServer() {}
+func (UnimplementedRecursiveTestServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeRecursiveTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecursiveTestServiceServer will
+// result in compilation errors.
+type UnsafeRecursiveTestServiceServer interface {
+	mustEmbedUnimplementedRecursiveTestServiceServer()
+}
+
+func RegisterRecursiveTestServiceServer(s grpc.ServiceRegistrar, srv RecursiveTestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecursiveTestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecursiveTestService_ServiceDesc, srv)
+}
+
+func _RecursiveTestService_CreateTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursiveTestServiceServer).CreateTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursiveTestService_CreateTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursiveTestServiceServer).CreateTree(ctx, req.(*CreateTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursiveTestService_WalkTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RecursiveTestServiceServer).WalkTree(&grpc.GenericServerStream[CreateTreeRequest, TreeNode]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecursiveTestService_WalkTreeServer = grpc.BidiStreamingServer[CreateTreeRequest, TreeNode]
+
+// RecursiveTestService_ServiceDesc is the grpc.ServiceDesc for RecursiveTestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecursiveTestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.RecursiveTestService",
+	HandlerType: (*RecursiveTestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTree",
+			Handler:    _RecursiveTestService_CreateTree_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WalkTree",
+			Handler:       _RecursiveTestService_WalkTree_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "testdata/recursive_test.proto",
+}
@@ -0,0 +1,429 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: testdata/streaming_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatchItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prefix of the item IDs to watch
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Number of events to emit
+	Count         int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchItemsRequest) Reset() {
+	*x = WatchItemsRequest{}
+	mi := &file_testdata_streaming_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchItemsRequest) ProtoMessage() {}
+
+func (x *WatchItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_streaming_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchItemsRequest.ProtoReflect.Descriptor instead.
+func (*WatchItemsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_streaming_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchItemsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *WatchItemsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type WatchItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the changed item
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Sequence number of the event
+	Sequence      int32 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchItemsResponse) Reset() {
+	*x = WatchItemsResponse{}
+	mi := &file_testdata_streaming_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchItemsResponse) ProtoMessage() {}
+
+func (x *WatchItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_streaming_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchItemsResponse.ProtoReflect.Descriptor instead.
+func (*WatchItemsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_streaming_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchItemsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchItemsResponse) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type UploadItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the uploaded item
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the uploaded item
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadItemsRequest) Reset() {
+	*x = UploadItemsRequest{}
+	mi := &file_testdata_streaming_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadItemsRequest) ProtoMessage() {}
+
+func (x *UploadItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_streaming_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadItemsRequest.ProtoReflect.Descriptor instead.
+func (*UploadItemsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_streaming_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UploadItemsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadItemsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UploadItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of all uploaded items
+	Ids           []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadItemsResponse) Reset() {
+	*x = UploadItemsResponse{}
+	mi := &file_testdata_streaming_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadItemsResponse) ProtoMessage() {}
+
+func (x *UploadItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_streaming_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadItemsResponse.ProtoReflect.Descriptor instead.
+func (*UploadItemsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_streaming_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UploadItemsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type SyncItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the item to sync
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncItemsRequest) Reset() {
+	*x = SyncItemsRequest{}
+	mi := &file_testdata_streaming_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncItemsRequest) ProtoMessage() {}
+
+func (x *SyncItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_streaming_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncItemsRequest.ProtoReflect.Descriptor instead.
+func (*SyncItemsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_streaming_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SyncItemsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SyncItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the synced item
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Whether the item is in sync
+	InSync        bool `protobuf:"varint,2,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncItemsResponse) Reset() {
+	*x = SyncItemsResponse{}
+	mi := &file_testdata_streaming_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncItemsResponse) ProtoMessage() {}
+
+func (x *SyncItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_streaming_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncItemsResponse.ProtoReflect.Descriptor instead.
+func (*SyncItemsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_streaming_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SyncItemsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SyncItemsResponse) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+var File_testdata_streaming_test_proto protoreflect.FileDescriptor
+
+const file_testdata_streaming_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtestdata/streaming_test.proto\x12\btestdata\"A\n" +
+	"\x11WatchItemsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"@\n" +
+	"\x12WatchItemsResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x05R\bsequence\"8\n" +
+	"\x12UploadItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"'\n" +
+	"\x13UploadItemsResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\"\n" +
+	"\x10SyncItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x11SyncItemsResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ain_sync\x18\x02 \x01(\bR\x06inSync2\xf9\x01\n" +
+	"\x14StreamingTestService\x12I\n" +
+	"\n" +
+	"WatchItems\x12\x1b.testdata.WatchItemsRequest\x1a\x1c.testdata.WatchItemsResponse0\x01\x12L\n" +
+	"\vUploadItems\x12\x1c.testdata.UploadItemsRequest\x1a\x1d.testdata.UploadItemsResponse(\x01\x12H\n" +
+	"\tSyncItems\x12\x1a.testdata.SyncItemsRequest\x1a\x1b.testdata.SyncItemsResponse(\x010\x01B\xa5\x01\n" +
+	"\fcom.testdataB\x12StreamingTestProtoP\x01ZAgithub.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_streaming_test_proto_rawDescOnce sync.Once
+	file_testdata_streaming_test_proto_rawDescData []byte
+)
+
+func file_testdata_streaming_test_proto_rawDescGZIP() []byte {
+	file_testdata_streaming_test_proto_rawDescOnce.Do(func() {
+		file_testdata_streaming_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_streaming_test_proto_rawDesc), len(file_testdata_streaming_test_proto_rawDesc)))
+	})
+	return file_testdata_streaming_test_proto_rawDescData
+}
+
+var file_testdata_streaming_test_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_testdata_streaming_test_proto_goTypes = []any{
+	(*WatchItemsRequest)(nil),   // 0: testdata.WatchItemsRequest
+	(*WatchItemsResponse)(nil),  // 1: testdata.WatchItemsResponse
+	(*UploadItemsRequest)(nil),  // 2: testdata.UploadItemsRequest
+	(*UploadItemsResponse)(nil), // 3: testdata.UploadItemsResponse
+	(*SyncItemsRequest)(nil),    // 4: testdata.SyncItemsRequest
+	(*SyncItemsResponse)(nil),   // 5: testdata.SyncItemsResponse
+}
+var file_testdata_streaming_test_proto_depIdxs = []int32{
+	0, // 0: testdata.StreamingTestService.WatchItems:input_type -> testdata.WatchItemsRequest
+	2, // 1: testdata.StreamingTestService.UploadItems:input_type -> testdata.UploadItemsRequest
+	4, // 2: testdata.StreamingTestService.SyncItems:input_type -> testdata.SyncItemsRequest
+	1, // 3: testdata.StreamingTestService.WatchItems:output_type -> testdata.WatchItemsResponse
+	3, // 4: testdata.StreamingTestService.UploadItems:output_type -> testdata.UploadItemsResponse
+	5, // 5: testdata.StreamingTestService.SyncItems:output_type -> testdata.SyncItemsResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_streaming_test_proto_init() }
+func file_testdata_streaming_test_proto_init() {
+	if File_testdata_streaming_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_streaming_test_proto_rawDesc), len(file_testdata_streaming_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_streaming_test_proto_goTypes,
+		DependencyIndexes: file_testdata_streaming_test_proto_depIdxs,
+		MessageInfos:      file_testdata_streaming_test_proto_msgTypes,
+	}.Build()
+	File_testdata_streaming_test_proto = out.File
+	file_testdata_streaming_test_proto_goTypes = nil
+	file_testdata_streaming_test_proto_depIdxs = nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/streaming_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StreamingTestService_WatchItems_FullMethodName  = "/testdata.StreamingTestService/WatchItems"
+	StreamingTestService_UploadItems_FullMethodName = "/testdata.StreamingTestService/UploadItems"
+	StreamingTestService_SyncItems_FullMethodName   = "/testdata.StreamingTestService/SyncItems"
+)
+
+// StreamingTestServiceClient is the client API for StreamingTestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StreamingTestService provides streaming test operations
+type StreamingTestServiceClient interface {
+	// WatchItems streams item events
+	WatchItems(ctx context.Context, in *WatchItemsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchItemsResponse], error)
+	// UploadItems uploads items in bulk
+	UploadItems(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadItemsRequest, UploadItemsResponse], error)
+	// SyncItems echoes the state of every item it receives
+	SyncItems(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SyncItemsRequest, SyncItemsResponse], error)
+}
+
+type streamingTestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamingTestServiceClient(cc grpc.ClientConnInterface) StreamingTestServiceClient {
+	return &streamingTestServiceClient{cc}
+}
+
+func (c *streamingTestServiceClient) WatchItems(ctx context.Context, in *WatchItemsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchItemsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StreamingTestService_ServiceDesc.Streams[0], StreamingTestService_WatchItems_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchItemsRequest, WatchItemsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingTestService_WatchItemsClient = grpc.ServerStreamingClient[WatchItemsResponse]
+
+func (c *streamingTestServiceClient) UploadItems(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadItemsRequest, UploadItemsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StreamingTestService_ServiceDesc.Streams[1], StreamingTestService_UploadItems_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadItemsRequest, UploadItemsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingTestService_UploadItemsClient = grpc.ClientStreamingClient[UploadItemsRequest, UploadItemsResponse]
+
+func (c *streamingTestServiceClient) SyncItems(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SyncItemsRequest, SyncItemsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StreamingTestService_ServiceDesc.Streams[2], StreamingTestService_SyncItems_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SyncItemsRequest, SyncItemsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingTestService_SyncItemsClient = grpc.BidiStreamingClient[SyncItemsRequest, SyncItemsResponse]
+
+// StreamingTestServiceServer is the server API for StreamingTestService service.
+// All implementations must embed UnimplementedStreamingTestServiceServer
+// for forward compatibility.
+//
+// StreamingTestService provides streaming test operations
+type StreamingTestServiceServer interface {
+	// WatchItems streams item events
+	WatchItems(*WatchItemsRequest, grpc.ServerStreamingServer[WatchItemsResponse]) error
+	// UploadItems uploads items in bulk
+	UploadItems(grpc.ClientStreamingServer[UploadItemsRequest, UploadItemsResponse]) error
+	// SyncItems echoes the state of every item it receives
+	SyncItems(grpc.BidiStreamingServer[SyncItemsRequest, SyncItemsResponse]) error
+	mustEmbedUnimplementedStreamingTestServiceServer()
+}
+
+// UnimplementedStreamingTestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStreamingTestServiceServer struct{}
+
+func (UnimplementedStreamingTestServiceServer) WatchItems(*WatchItemsRequest, grpc.ServerStreamingServer[WatchItemsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchItems not implemented")
+}
+func (UnimplementedStreamingTestServiceServer) UploadItems(grpc.ClientStreamingServer[UploadItemsRequest, UploadItemsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadItems not implemented")
+}
+func (UnimplementedStreamingTestServiceServer) SyncItems(grpc.BidiStreamingServer[SyncItemsRequest, SyncItemsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SyncItems not implemented")
+}
+func (UnimplementedStreamingTestServiceServer) mustEmbedUnimplementedStreamingTestServiceServer() {}
+func (UnimplementedStreamingTestServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeStreamingTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamingTestServiceServer will
+// result in compilation errors.
+type UnsafeStreamingTestServiceServer interface {
+	mustEmbedUnimplementedStreamingTestServiceServer()
+}
+
+func RegisterStreamingTestServiceServer(s grpc.ServiceRegistrar, srv StreamingTestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStreamingTestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StreamingTestService_ServiceDesc, srv)
+}
+
+func _StreamingTestService_WatchItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchItemsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingTestServiceServer).WatchItems(m, &grpc.GenericServerStream[WatchItemsRequest, WatchItemsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingTestService_WatchItemsServer = grpc.ServerStreamingServer[WatchItemsResponse]
+
+func _StreamingTestService_UploadItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamingTestServiceServer).UploadItems(&grpc.GenericServerStream[UploadItemsRequest, UploadItemsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingTestService_UploadItemsServer = grpc.ClientStreamingServer[UploadItemsRequest, UploadItemsResponse]
+
+func _StreamingTestService_SyncItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamingTestServiceServer).SyncItems(&grpc.GenericServerStream[SyncItemsRequest, SyncItemsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingTestService_SyncItemsServer = grpc.BidiStreamingServer[SyncItemsRequest, SyncItemsResponse]
+
+// StreamingTestService_ServiceDesc is the grpc.ServiceDesc for StreamingTestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StreamingTestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.StreamingTestService",
+	HandlerType: (*StreamingTestServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchItems",
+			Handler:       _StreamingTestService_WatchItems_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadItems",
+			Handler:       _StreamingTestService_UploadItems_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SyncItems",
+			Handler:       _StreamingTestService_SyncItems_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "testdata/streaming_test.proto",
+}
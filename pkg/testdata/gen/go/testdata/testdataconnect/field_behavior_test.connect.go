@@ -0,0 +1,126 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: testdata/field_behavior_test.proto
+
+package testdataconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// FieldBehaviorTestServiceName is the fully-qualified name of the FieldBehaviorTestService service.
+	FieldBehaviorTestServiceName = "testdata.FieldBehaviorTestService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// FieldBehaviorTestServiceUpdateBookProcedure is the fully-qualified name of the
+	// FieldBehaviorTestService's UpdateBook RPC.
+	FieldBehaviorTestServiceUpdateBookProcedure = "/testdata.FieldBehaviorTestService/UpdateBook"
+)
+
+// FieldBehaviorTestServiceClient is a client for the testdata.FieldBehaviorTestService service.
+type FieldBehaviorTestServiceClient interface {
+	// UpdateBook updates a book
+	UpdateBook(context.Context, *connect.Request[testdata.UpdateBookRequest]) (*connect.Response[testdata.Book], error)
+}
+
+// NewFieldBehaviorTestServiceClient constructs a client for the testdata.FieldBehaviorTestService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewFieldBehaviorTestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) FieldBehaviorTestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	fieldBehaviorTestServiceMethods := testdata.File_testdata_field_behavior_test_proto.Services().ByName("FieldBehaviorTestService").Methods()
+	return &fieldBehaviorTestServiceClient{
+		updateBook: connect.NewClient[testdata.UpdateBookRequest, testdata.Book](
+			httpClient,
+			baseURL+FieldBehaviorTestServiceUpdateBookProcedure,
+			connect.WithSchema(fieldBehaviorTestServiceMethods.ByName("UpdateBook")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// fieldBehaviorTestServiceClient implements FieldBehaviorTestServiceClient.
+type fieldBehaviorTestServiceClient struct {
+	updateBook *connect.Client[testdata.UpdateBookRequest, testdata.Book]
+}
+
+// UpdateBook calls testdata.FieldBehaviorTestService.UpdateBook.
+func (c *fieldBehaviorTestServiceClient) UpdateBook(ctx context.Context, req *connect.Request[testdata.UpdateBookRequest]) (*connect.Response[testdata.Book], error) {
+	return c.updateBook.CallUnary(ctx, req)
+}
+
+// FieldBehaviorTestServiceHandler is an implementation of the testdata.FieldBehaviorTestService
+// service.
+type FieldBehaviorTestServiceHandler interface {
+	// UpdateBook updates a book
+	UpdateBook(context.Context, *connect.Request[testdata.UpdateBookRequest]) (*connect.Response[testdata.Book], error)
+}
+
+// NewFieldBehaviorTestServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewFieldBehaviorTestServiceHandler(svc FieldBehaviorTestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	fieldBehaviorTestServiceMethods := testdata.File_testdata_field_behavior_test_proto.Services().ByName("FieldBehaviorTestService").Methods()
+	fieldBehaviorTestServiceUpdateBookHandler := connect.NewUnaryHandler(
+		FieldBehaviorTestServiceUpdateBookProcedure,
+		svc.UpdateBook,
+		connect.WithSchema(fieldBehaviorTestServiceMethods.ByName("UpdateBook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/testdata.FieldBehaviorTestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FieldBehaviorTestServiceUpdateBookProcedure:
+			fieldBehaviorTestServiceUpdateBookHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedFieldBehaviorTestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedFieldBehaviorTestServiceHandler struct{}
+
+func (UnimplementedFieldBehaviorTestServiceHandler) UpdateBook(context.Context, *connect.Request[testdata.UpdateBookRequest]) (*connect.Response[testdata.Book], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.FieldBehaviorTestService.UpdateBook is not implemented"))
+}
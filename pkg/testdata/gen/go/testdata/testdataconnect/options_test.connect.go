@@ -0,0 +1,330 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: testdata/options_test.proto
+
+package testdataconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OptionsTestServiceName is the fully-qualified name of the OptionsTestService service.
+	OptionsTestServiceName = "testdata.OptionsTestService"
+	// ExcludedTestServiceName is the fully-qualified name of the ExcludedTestService service.
+	ExcludedTestServiceName = "testdata.ExcludedTestService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OptionsTestServiceListProductsProcedure is the fully-qualified name of the OptionsTestService's
+	// ListProducts RPC.
+	OptionsTestServiceListProductsProcedure = "/testdata.OptionsTestService/ListProducts"
+	// OptionsTestServiceGetProductProcedure is the fully-qualified name of the OptionsTestService's
+	// GetProduct RPC.
+	OptionsTestServiceGetProductProcedure = "/testdata.OptionsTestService/GetProduct"
+	// OptionsTestServiceUpdateProductProcedure is the fully-qualified name of the OptionsTestService's
+	// UpdateProduct RPC.
+	OptionsTestServiceUpdateProductProcedure = "/testdata.OptionsTestService/UpdateProduct"
+	// OptionsTestServiceDeleteProductProcedure is the fully-qualified name of the OptionsTestService's
+	// DeleteProduct RPC.
+	OptionsTestServiceDeleteProductProcedure = "/testdata.OptionsTestService/DeleteProduct"
+	// OptionsTestServicePurgeProductsProcedure is the fully-qualified name of the OptionsTestService's
+	// PurgeProducts RPC.
+	OptionsTestServicePurgeProductsProcedure = "/testdata.OptionsTestService/PurgeProducts"
+	// ExcludedTestServicePurgeProductsProcedure is the fully-qualified name of the
+	// ExcludedTestService's PurgeProducts RPC.
+	ExcludedTestServicePurgeProductsProcedure = "/testdata.ExcludedTestService/PurgeProducts"
+)
+
+// OptionsTestServiceClient is a client for the testdata.OptionsTestService service.
+type OptionsTestServiceClient interface {
+	// ListProducts lists all products
+	ListProducts(context.Context, *connect.Request[testdata.ListProductsRequest]) (*connect.Response[testdata.ListProductsResponse], error)
+	// GetProduct returns a single product
+	GetProduct(context.Context, *connect.Request[testdata.GetProductRequest]) (*connect.Response[testdata.GetProductResponse], error)
+	// UpdateProduct replaces a product
+	UpdateProduct(context.Context, *connect.Request[testdata.UpdateProductRequest]) (*connect.Response[testdata.UpdateProductResponse], error)
+	// DeleteProduct deletes a product
+	DeleteProduct(context.Context, *connect.Request[testdata.DeleteProductRequest]) (*connect.Response[testdata.DeleteProductResponse], error)
+	// PurgeProducts is an admin operation that must not be exposed
+	PurgeProducts(context.Context, *connect.Request[testdata.PurgeProductsRequest]) (*connect.Response[testdata.PurgeProductsResponse], error)
+}
+
+// NewOptionsTestServiceClient constructs a client for the testdata.OptionsTestService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOptionsTestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OptionsTestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	optionsTestServiceMethods := testdata.File_testdata_options_test_proto.Services().ByName("OptionsTestService").Methods()
+	return &optionsTestServiceClient{
+		listProducts: connect.NewClient[testdata.ListProductsRequest, testdata.ListProductsResponse](
+			httpClient,
+			baseURL+OptionsTestServiceListProductsProcedure,
+			connect.WithSchema(optionsTestServiceMethods.ByName("ListProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		getProduct: connect.NewClient[testdata.GetProductRequest, testdata.GetProductResponse](
+			httpClient,
+			baseURL+OptionsTestServiceGetProductProcedure,
+			connect.WithSchema(optionsTestServiceMethods.ByName("GetProduct")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateProduct: connect.NewClient[testdata.UpdateProductRequest, testdata.UpdateProductResponse](
+			httpClient,
+			baseURL+OptionsTestServiceUpdateProductProcedure,
+			connect.WithSchema(optionsTestServiceMethods.ByName("UpdateProduct")),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		deleteProduct: connect.NewClient[testdata.DeleteProductRequest, testdata.DeleteProductResponse](
+			httpClient,
+			baseURL+OptionsTestServiceDeleteProductProcedure,
+			connect.WithSchema(optionsTestServiceMethods.ByName("DeleteProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeProducts: connect.NewClient[testdata.PurgeProductsRequest, testdata.PurgeProductsResponse](
+			httpClient,
+			baseURL+OptionsTestServicePurgeProductsProcedure,
+			connect.WithSchema(optionsTestServiceMethods.ByName("PurgeProducts")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// optionsTestServiceClient implements OptionsTestServiceClient.
+type optionsTestServiceClient struct {
+	listProducts  *connect.Client[testdata.ListProductsRequest, testdata.ListProductsResponse]
+	getProduct    *connect.Client[testdata.GetProductRequest, testdata.GetProductResponse]
+	updateProduct *connect.Client[testdata.UpdateProductRequest, testdata.UpdateProductResponse]
+	deleteProduct *connect.Client[testdata.DeleteProductRequest, testdata.DeleteProductResponse]
+	purgeProducts *connect.Client[testdata.PurgeProductsRequest, testdata.PurgeProductsResponse]
+}
+
+// ListProducts calls testdata.OptionsTestService.ListProducts.
+func (c *optionsTestServiceClient) ListProducts(ctx context.Context, req *connect.Request[testdata.ListProductsRequest]) (*connect.Response[testdata.ListProductsResponse], error) {
+	return c.listProducts.CallUnary(ctx, req)
+}
+
+// GetProduct calls testdata.OptionsTestService.GetProduct.
+func (c *optionsTestServiceClient) GetProduct(ctx context.Context, req *connect.Request[testdata.GetProductRequest]) (*connect.Response[testdata.GetProductResponse], error) {
+	return c.getProduct.CallUnary(ctx, req)
+}
+
+// UpdateProduct calls testdata.OptionsTestService.UpdateProduct.
+func (c *optionsTestServiceClient) UpdateProduct(ctx context.Context, req *connect.Request[testdata.UpdateProductRequest]) (*connect.Response[testdata.UpdateProductResponse], error) {
+	return c.updateProduct.CallUnary(ctx, req)
+}
+
+// DeleteProduct calls testdata.OptionsTestService.DeleteProduct.
+func (c *optionsTestServiceClient) DeleteProduct(ctx context.Context, req *connect.Request[testdata.DeleteProductRequest]) (*connect.Response[testdata.DeleteProductResponse], error) {
+	return c.deleteProduct.CallUnary(ctx, req)
+}
+
+// PurgeProducts calls testdata.OptionsTestService.PurgeProducts.
+func (c *optionsTestServiceClient) PurgeProducts(ctx context.Context, req *connect.Request[testdata.PurgeProductsRequest]) (*connect.Response[testdata.PurgeProductsResponse], error) {
+	return c.purgeProducts.CallUnary(ctx, req)
+}
+
+// OptionsTestServiceHandler is an implementation of the testdata.OptionsTestService service.
+type OptionsTestServiceHandler interface {
+	// ListProducts lists all products
+	ListProducts(context.Context, *connect.Request[testdata.ListProductsRequest]) (*connect.Response[testdata.ListProductsResponse], error)
+	// GetProduct returns a single product
+	GetProduct(context.Context, *connect.Request[testdata.GetProductRequest]) (*connect.Response[testdata.GetProductResponse], error)
+	// UpdateProduct replaces a product
+	UpdateProduct(context.Context, *connect.Request[testdata.UpdateProductRequest]) (*connect.Response[testdata.UpdateProductResponse], error)
+	// DeleteProduct deletes a product
+	DeleteProduct(context.Context, *connect.Request[testdata.DeleteProductRequest]) (*connect.Response[testdata.DeleteProductResponse], error)
+	// PurgeProducts is an admin operation that must not be exposed
+	PurgeProducts(context.Context, *connect.Request[testdata.PurgeProductsRequest]) (*connect.Response[testdata.PurgeProductsResponse], error)
+}
+
+// NewOptionsTestServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOptionsTestServiceHandler(svc OptionsTestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	optionsTestServiceMethods := testdata.File_testdata_options_test_proto.Services().ByName("OptionsTestService").Methods()
+	optionsTestServiceListProductsHandler := connect.NewUnaryHandler(
+		OptionsTestServiceListProductsProcedure,
+		svc.ListProducts,
+		connect.WithSchema(optionsTestServiceMethods.ByName("ListProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	optionsTestServiceGetProductHandler := connect.NewUnaryHandler(
+		OptionsTestServiceGetProductProcedure,
+		svc.GetProduct,
+		connect.WithSchema(optionsTestServiceMethods.ByName("GetProduct")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	optionsTestServiceUpdateProductHandler := connect.NewUnaryHandler(
+		OptionsTestServiceUpdateProductProcedure,
+		svc.UpdateProduct,
+		connect.WithSchema(optionsTestServiceMethods.ByName("UpdateProduct")),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	optionsTestServiceDeleteProductHandler := connect.NewUnaryHandler(
+		OptionsTestServiceDeleteProductProcedure,
+		svc.DeleteProduct,
+		connect.WithSchema(optionsTestServiceMethods.ByName("DeleteProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	optionsTestServicePurgeProductsHandler := connect.NewUnaryHandler(
+		OptionsTestServicePurgeProductsProcedure,
+		svc.PurgeProducts,
+		connect.WithSchema(optionsTestServiceMethods.ByName("PurgeProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/testdata.OptionsTestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OptionsTestServiceListProductsProcedure:
+			optionsTestServiceListProductsHandler.ServeHTTP(w, r)
+		case OptionsTestServiceGetProductProcedure:
+			optionsTestServiceGetProductHandler.ServeHTTP(w, r)
+		case OptionsTestServiceUpdateProductProcedure:
+			optionsTestServiceUpdateProductHandler.ServeHTTP(w, r)
+		case OptionsTestServiceDeleteProductProcedure:
+			optionsTestServiceDeleteProductHandler.ServeHTTP(w, r)
+		case OptionsTestServicePurgeProductsProcedure:
+			optionsTestServicePurgeProductsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOptionsTestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOptionsTestServiceHandler struct{}
+
+func (UnimplementedOptionsTestServiceHandler) ListProducts(context.Context, *connect.Request[testdata.ListProductsRequest]) (*connect.Response[testdata.ListProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.OptionsTestService.ListProducts is not implemented"))
+}
+
+func (UnimplementedOptionsTestServiceHandler) GetProduct(context.Context, *connect.Request[testdata.GetProductRequest]) (*connect.Response[testdata.GetProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.OptionsTestService.GetProduct is not implemented"))
+}
+
+func (UnimplementedOptionsTestServiceHandler) UpdateProduct(context.Context, *connect.Request[testdata.UpdateProductRequest]) (*connect.Response[testdata.UpdateProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.OptionsTestService.UpdateProduct is not implemented"))
+}
+
+func (UnimplementedOptionsTestServiceHandler) DeleteProduct(context.Context, *connect.Request[testdata.DeleteProductRequest]) (*connect.Response[testdata.DeleteProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.OptionsTestService.DeleteProduct is not implemented"))
+}
+
+func (UnimplementedOptionsTestServiceHandler) PurgeProducts(context.Context, *connect.Request[testdata.PurgeProductsRequest]) (*connect.Response[testdata.PurgeProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.OptionsTestService.PurgeProducts is not implemented"))
+}
+
+// ExcludedTestServiceClient is a client for the testdata.ExcludedTestService service.
+type ExcludedTestServiceClient interface {
+	// PurgeProducts is an admin operation that must not be exposed
+	PurgeProducts(context.Context, *connect.Request[testdata.PurgeProductsRequest]) (*connect.Response[testdata.PurgeProductsResponse], error)
+}
+
+// NewExcludedTestServiceClient constructs a client for the testdata.ExcludedTestService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewExcludedTestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ExcludedTestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	excludedTestServiceMethods := testdata.File_testdata_options_test_proto.Services().ByName("ExcludedTestService").Methods()
+	return &excludedTestServiceClient{
+		purgeProducts: connect.NewClient[testdata.PurgeProductsRequest, testdata.PurgeProductsResponse](
+			httpClient,
+			baseURL+ExcludedTestServicePurgeProductsProcedure,
+			connect.WithSchema(excludedTestServiceMethods.ByName("PurgeProducts")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// excludedTestServiceClient implements ExcludedTestServiceClient.
+type excludedTestServiceClient struct {
+	purgeProducts *connect.Client[testdata.PurgeProductsRequest, testdata.PurgeProductsResponse]
+}
+
+// PurgeProducts calls testdata.ExcludedTestService.PurgeProducts.
+func (c *excludedTestServiceClient) PurgeProducts(ctx context.Context, req *connect.Request[testdata.PurgeProductsRequest]) (*connect.Response[testdata.PurgeProductsResponse], error) {
+	return c.purgeProducts.CallUnary(ctx, req)
+}
+
+// ExcludedTestServiceHandler is an implementation of the testdata.ExcludedTestService service.
+type ExcludedTestServiceHandler interface {
+	// PurgeProducts is an admin operation that must not be exposed
+	PurgeProducts(context.Context, *connect.Request[testdata.PurgeProductsRequest]) (*connect.Response[testdata.PurgeProductsResponse], error)
+}
+
+// NewExcludedTestServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewExcludedTestServiceHandler(svc ExcludedTestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	excludedTestServiceMethods := testdata.File_testdata_options_test_proto.Services().ByName("ExcludedTestService").Methods()
+	excludedTestServicePurgeProductsHandler := connect.NewUnaryHandler(
+		ExcludedTestServicePurgeProductsProcedure,
+		svc.PurgeProducts,
+		connect.WithSchema(excludedTestServiceMethods.ByName("PurgeProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/testdata.ExcludedTestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ExcludedTestServicePurgeProductsProcedure:
+			excludedTestServicePurgeProductsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedExcludedTestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedExcludedTestServiceHandler struct{}
+
+func (UnimplementedExcludedTestServiceHandler) PurgeProducts(context.Context, *connect.Request[testdata.PurgeProductsRequest]) (*connect.Response[testdata.PurgeProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.ExcludedTestService.PurgeProducts is not implemented"))
+}
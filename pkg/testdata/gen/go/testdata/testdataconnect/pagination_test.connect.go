@@ -0,0 +1,156 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: testdata/pagination_test.proto
+
+package testdataconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PaginationTestServiceName is the fully-qualified name of the PaginationTestService service.
+	PaginationTestServiceName = "testdata.PaginationTestService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PaginationTestServiceListShelvesProcedure is the fully-qualified name of the
+	// PaginationTestService's ListShelves RPC.
+	PaginationTestServiceListShelvesProcedure = "/testdata.PaginationTestService/ListShelves"
+	// PaginationTestServiceSearchShelvesProcedure is the fully-qualified name of the
+	// PaginationTestService's SearchShelves RPC.
+	PaginationTestServiceSearchShelvesProcedure = "/testdata.PaginationTestService/SearchShelves"
+)
+
+// PaginationTestServiceClient is a client for the testdata.PaginationTestService service.
+type PaginationTestServiceClient interface {
+	// ListShelves lists shelves
+	ListShelves(context.Context, *connect.Request[testdata.ListShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error)
+	// SearchShelves has no page size, so it is not an AIP-158 list method
+	SearchShelves(context.Context, *connect.Request[testdata.SearchShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error)
+}
+
+// NewPaginationTestServiceClient constructs a client for the testdata.PaginationTestService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPaginationTestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PaginationTestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	paginationTestServiceMethods := testdata.File_testdata_pagination_test_proto.Services().ByName("PaginationTestService").Methods()
+	return &paginationTestServiceClient{
+		listShelves: connect.NewClient[testdata.ListShelvesRequest, testdata.ListShelvesResponse](
+			httpClient,
+			baseURL+PaginationTestServiceListShelvesProcedure,
+			connect.WithSchema(paginationTestServiceMethods.ByName("ListShelves")),
+			connect.WithClientOptions(opts...),
+		),
+		searchShelves: connect.NewClient[testdata.SearchShelvesRequest, testdata.ListShelvesResponse](
+			httpClient,
+			baseURL+PaginationTestServiceSearchShelvesProcedure,
+			connect.WithSchema(paginationTestServiceMethods.ByName("SearchShelves")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// paginationTestServiceClient implements PaginationTestServiceClient.
+type paginationTestServiceClient struct {
+	listShelves   *connect.Client[testdata.ListShelvesRequest, testdata.ListShelvesResponse]
+	searchShelves *connect.Client[testdata.SearchShelvesRequest, testdata.ListShelvesResponse]
+}
+
+// ListShelves calls testdata.PaginationTestService.ListShelves.
+func (c *paginationTestServiceClient) ListShelves(ctx context.Context, req *connect.Request[testdata.ListShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error) {
+	return c.listShelves.CallUnary(ctx, req)
+}
+
+// SearchShelves calls testdata.PaginationTestService.SearchShelves.
+func (c *paginationTestServiceClient) SearchShelves(ctx context.Context, req *connect.Request[testdata.SearchShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error) {
+	return c.searchShelves.CallUnary(ctx, req)
+}
+
+// PaginationTestServiceHandler is an implementation of the testdata.PaginationTestService service.
+type PaginationTestServiceHandler interface {
+	// ListShelves lists shelves
+	ListShelves(context.Context, *connect.Request[testdata.ListShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error)
+	// SearchShelves has no page size, so it is not an AIP-158 list method
+	SearchShelves(context.Context, *connect.Request[testdata.SearchShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error)
+}
+
+// NewPaginationTestServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPaginationTestServiceHandler(svc PaginationTestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	paginationTestServiceMethods := testdata.File_testdata_pagination_test_proto.Services().ByName("PaginationTestService").Methods()
+	paginationTestServiceListShelvesHandler := connect.NewUnaryHandler(
+		PaginationTestServiceListShelvesProcedure,
+		svc.ListShelves,
+		connect.WithSchema(paginationTestServiceMethods.ByName("ListShelves")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paginationTestServiceSearchShelvesHandler := connect.NewUnaryHandler(
+		PaginationTestServiceSearchShelvesProcedure,
+		svc.SearchShelves,
+		connect.WithSchema(paginationTestServiceMethods.ByName("SearchShelves")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/testdata.PaginationTestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PaginationTestServiceListShelvesProcedure:
+			paginationTestServiceListShelvesHandler.ServeHTTP(w, r)
+		case PaginationTestServiceSearchShelvesProcedure:
+			paginationTestServiceSearchShelvesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPaginationTestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPaginationTestServiceHandler struct{}
+
+func (UnimplementedPaginationTestServiceHandler) ListShelves(context.Context, *connect.Request[testdata.ListShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.PaginationTestService.ListShelves is not implemented"))
+}
+
+func (UnimplementedPaginationTestServiceHandler) SearchShelves(context.Context, *connect.Request[testdata.SearchShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.PaginationTestService.SearchShelves is not implemented"))
+}
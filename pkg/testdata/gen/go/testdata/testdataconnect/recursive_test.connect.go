@@ -0,0 +1,156 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: testdata/recursive_test.proto
+
+package testdataconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RecursiveTestServiceName is the fully-qualified name of the RecursiveTestService service.
+	RecursiveTestServiceName = "testdata.RecursiveTestService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RecursiveTestServiceCreateTreeProcedure is the fully-qualified name of the RecursiveTestService's
+	// CreateTree RPC.
+	RecursiveTestServiceCreateTreeProcedure = "/testdata.RecursiveTestService/CreateTree"
+	// RecursiveTestServiceWalkTreeProcedure is the fully-qualified name of the RecursiveTestService's
+	// WalkTree RPC.
+	RecursiveTestServiceWalkTreeProcedure = "/testdata.RecursiveTestService/WalkTree"
+)
+
+// RecursiveTestServiceClient is a client for the testdata.RecursiveTestService service.
+type RecursiveTestServiceClient interface {
+	// CreateTree creates a tree of nodes
+	CreateTree(context.Context, *connect.Request[testdata.CreateTreeRequest]) (*connect.Response[testdata.CreateTreeResponse], error)
+	// WalkTree streams the nodes of trees
+	WalkTree(context.Context) *connect.BidiStreamForClient[testdata.CreateTreeRequest, testdata.TreeNode]
+}
+
+// NewRecursiveTestServiceClient constructs a client for the testdata.RecursiveTestService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRecursiveTestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RecursiveTestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	recursiveTestServiceMethods := testdata.File_testdata_recursive_test_proto.Services().ByName("RecursiveTestService").Methods()
+	return &recursiveTestServiceClient{
+		createTree: connect.NewClient[testdata.CreateTreeRequest, testdata.CreateTreeResponse](
+			httpClient,
+			baseURL+RecursiveTestServiceCreateTreeProcedure,
+			connect.WithSchema(recursiveTestServiceMethods.ByName("CreateTree")),
+			connect.WithClientOptions(opts...),
+		),
+		walkTree: connect.NewClient[testdata.CreateTreeRequest, testdata.TreeNode](
+			httpClient,
+			baseURL+RecursiveTestServiceWalkTreeProcedure,
+			connect.WithSchema(recursiveTestServiceMethods.ByName("WalkTree")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// recursiveTestServiceClient implements RecursiveTestServiceClient.
+type recursiveTestServiceClient struct {
+	createTree *connect.Client[testdata.CreateTreeRequest, testdata.CreateTreeResponse]
+	walkTree   *connect.Client[testdata.CreateTreeRequest, testdata.TreeNode]
+}
+
+// CreateTree calls testdata.RecursiveTestService.CreateTree.
+func (c *recursiveTestServiceClient) CreateTree(ctx context.Context, req *connect.Request[testdata.CreateTreeRequest]) (*connect.Response[testdata.CreateTreeResponse], error) {
+	return c.createTree.CallUnary(ctx, req)
+}
+
+// WalkTree calls testdata.RecursiveTestService.WalkTree.
+func (c *recursiveTestServiceClient) WalkTree(ctx context.Context) *connect.BidiStreamForClient[testdata.CreateTreeRequest, testdata.TreeNode] {
+	return c.walkTree.CallBidiStream(ctx)
+}
+
+// RecursiveTestServiceHandler is an implementation of the testdata.RecursiveTestService service.
+type RecursiveTestServiceHandler interface {
+	// CreateTree creates a tree of nodes
+	CreateTree(context.Context, *connect.Request[testdata.CreateTreeRequest]) (*connect.Response[testdata.CreateTreeResponse], error)
+	// WalkTree streams the nodes of trees
+	WalkTree(context.Context, *connect.BidiStream[testdata.CreateTreeRequest, testdata.TreeNode]) error
+}
+
+// NewRecursiveTestServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRecursiveTestServiceHandler(svc RecursiveTestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	recursiveTestServiceMethods := testdata.File_testdata_recursive_test_proto.Services().ByName("RecursiveTestService").Methods()
+	recursiveTestServiceCreateTreeHandler := connect.NewUnaryHandler(
+		RecursiveTestServiceCreateTreeProcedure,
+		svc.CreateTree,
+		connect.WithSchema(recursiveTestServiceMethods.ByName("CreateTree")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recursiveTestServiceWalkTreeHandler := connect.NewBidiStreamHandler(
+		RecursiveTestServiceWalkTreeProcedure,
+		svc.WalkTree,
+		connect.WithSchema(recursiveTestServiceMethods.ByName("WalkTree")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/testdata.RecursiveTestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RecursiveTestServiceCreateTreeProcedure:
+			recursiveTestServiceCreateTreeHandler.ServeHTTP(w, r)
+		case RecursiveTestServiceWalkTreeProcedure:
+			recursiveTestServiceWalkTreeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRecursiveTestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRecursiveTestServiceHandler struct{}
+
+func (UnimplementedRecursiveTestServiceHandler) CreateTree(context.Context, *connect.Request[testdata.CreateTreeRequest]) (*connect.Response[testdata.CreateTreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.RecursiveTestService.CreateTree is not implemented"))
+}
+
+func (UnimplementedRecursiveTestServiceHandler) WalkTree(context.Context, *connect.BidiStream[testdata.CreateTreeRequest, testdata.TreeNode]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("testdata.RecursiveTestService.WalkTree is not implemented"))
+}
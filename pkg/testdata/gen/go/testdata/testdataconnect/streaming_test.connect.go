@@ -0,0 +1,187 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: testdata/streaming_test.proto
+
+package testdataconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StreamingTestServiceName is the fully-qualified name of the StreamingTestService service.
+	StreamingTestServiceName = "testdata.StreamingTestService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StreamingTestServiceWatchItemsProcedure is the fully-qualified name of the StreamingTestService's
+	// WatchItems RPC.
+	StreamingTestServiceWatchItemsProcedure = "/testdata.StreamingTestService/WatchItems"
+	// StreamingTestServiceUploadItemsProcedure is the fully-qualified name of the
+	// StreamingTestService's UploadItems RPC.
+	StreamingTestServiceUploadItemsProcedure = "/testdata.StreamingTestService/UploadItems"
+	// StreamingTestServiceSyncItemsProcedure is the fully-qualified name of the StreamingTestService's
+	// SyncItems RPC.
+	StreamingTestServiceSyncItemsProcedure = "/testdata.StreamingTestService/SyncItems"
+)
+
+// StreamingTestServiceClient is a client for the testdata.StreamingTestService service.
+type StreamingTestServiceClient interface {
+	// WatchItems streams item events
+	WatchItems(context.Context, *connect.Request[testdata.WatchItemsRequest]) (*connect.ServerStreamForClient[testdata.WatchItemsResponse], error)
+	// UploadItems uploads items in bulk
+	UploadItems(context.Context) *connect.ClientStreamForClient[testdata.UploadItemsRequest, testdata.UploadItemsResponse]
+	// SyncItems echoes the state of every item it receives
+	SyncItems(context.Context) *connect.BidiStreamForClient[testdata.SyncItemsRequest, testdata.SyncItemsResponse]
+}
+
+// NewStreamingTestServiceClient constructs a client for the testdata.StreamingTestService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStreamingTestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StreamingTestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	streamingTestServiceMethods := testdata.File_testdata_streaming_test_proto.Services().ByName("StreamingTestService").Methods()
+	return &streamingTestServiceClient{
+		watchItems: connect.NewClient[testdata.WatchItemsRequest, testdata.WatchItemsResponse](
+			httpClient,
+			baseURL+StreamingTestServiceWatchItemsProcedure,
+			connect.WithSchema(streamingTestServiceMethods.ByName("WatchItems")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadItems: connect.NewClient[testdata.UploadItemsRequest, testdata.UploadItemsResponse](
+			httpClient,
+			baseURL+StreamingTestServiceUploadItemsProcedure,
+			connect.WithSchema(streamingTestServiceMethods.ByName("UploadItems")),
+			connect.WithClientOptions(opts...),
+		),
+		syncItems: connect.NewClient[testdata.SyncItemsRequest, testdata.SyncItemsResponse](
+			httpClient,
+			baseURL+StreamingTestServiceSyncItemsProcedure,
+			connect.WithSchema(streamingTestServiceMethods.ByName("SyncItems")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// streamingTestServiceClient implements StreamingTestServiceClient.
+type streamingTestServiceClient struct {
+	watchItems  *connect.Client[testdata.WatchItemsRequest, testdata.WatchItemsResponse]
+	uploadItems *connect.Client[testdata.UploadItemsRequest, testdata.UploadItemsResponse]
+	syncItems   *connect.Client[testdata.SyncItemsRequest, testdata.SyncItemsResponse]
+}
+
+// WatchItems calls testdata.StreamingTestService.WatchItems.
+func (c *streamingTestServiceClient) WatchItems(ctx context.Context, req *connect.Request[testdata.WatchItemsRequest]) (*connect.ServerStreamForClient[testdata.WatchItemsResponse], error) {
+	return c.watchItems.CallServerStream(ctx, req)
+}
+
+// UploadItems calls testdata.StreamingTestService.UploadItems.
+func (c *streamingTestServiceClient) UploadItems(ctx context.Context) *connect.ClientStreamForClient[testdata.UploadItemsRequest, testdata.UploadItemsResponse] {
+	return c.uploadItems.CallClientStream(ctx)
+}
+
+// SyncItems calls testdata.StreamingTestService.SyncItems.
+func (c *streamingTestServiceClient) SyncItems(ctx context.Context) *connect.BidiStreamForClient[testdata.SyncItemsRequest, testdata.SyncItemsResponse] {
+	return c.syncItems.CallBidiStream(ctx)
+}
+
+// StreamingTestServiceHandler is an implementation of the testdata.StreamingTestService service.
+type StreamingTestServiceHandler interface {
+	// WatchItems streams item events
+	WatchItems(context.Context, *connect.Request[testdata.WatchItemsRequest], *connect.ServerStream[testdata.WatchItemsResponse]) error
+	// UploadItems uploads items in bulk
+	UploadItems(context.Context, *connect.ClientStream[testdata.UploadItemsRequest]) (*connect.Response[testdata.UploadItemsResponse], error)
+	// SyncItems echoes the state of every item it receives
+	SyncItems(context.Context, *connect.BidiStream[testdata.SyncItemsRequest, testdata.SyncItemsResponse]) error
+}
+
+// NewStreamingTestServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStreamingTestServiceHandler(svc StreamingTestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	streamingTestServiceMethods := testdata.File_testdata_streaming_test_proto.Services().ByName("StreamingTestService").Methods()
+	streamingTestServiceWatchItemsHandler := connect.NewServerStreamHandler(
+		StreamingTestServiceWatchItemsProcedure,
+		svc.WatchItems,
+		connect.WithSchema(streamingTestServiceMethods.ByName("WatchItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	streamingTestServiceUploadItemsHandler := connect.NewClientStreamHandler(
+		StreamingTestServiceUploadItemsProcedure,
+		svc.UploadItems,
+		connect.WithSchema(streamingTestServiceMethods.ByName("UploadItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	streamingTestServiceSyncItemsHandler := connect.NewBidiStreamHandler(
+		StreamingTestServiceSyncItemsProcedure,
+		svc.SyncItems,
+		connect.WithSchema(streamingTestServiceMethods.ByName("SyncItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/testdata.StreamingTestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StreamingTestServiceWatchItemsProcedure:
+			streamingTestServiceWatchItemsHandler.ServeHTTP(w, r)
+		case StreamingTestServiceUploadItemsProcedure:
+			streamingTestServiceUploadItemsHandler.ServeHTTP(w, r)
+		case StreamingTestServiceSyncItemsProcedure:
+			streamingTestServiceSyncItemsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStreamingTestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStreamingTestServiceHandler struct{}
+
+func (UnimplementedStreamingTestServiceHandler) WatchItems(context.Context, *connect.Request[testdata.WatchItemsRequest], *connect.ServerStream[testdata.WatchItemsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("testdata.StreamingTestService.WatchItems is not implemented"))
+}
+
+func (UnimplementedStreamingTestServiceHandler) UploadItems(context.Context, *connect.ClientStream[testdata.UploadItemsRequest]) (*connect.Response[testdata.UploadItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.StreamingTestService.UploadItems is not implemented"))
+}
+
+func (UnimplementedStreamingTestServiceHandler) SyncItems(context.Context, *connect.BidiStream[testdata.SyncItemsRequest, testdata.SyncItemsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("testdata.StreamingTestService.SyncItems is not implemented"))
+}
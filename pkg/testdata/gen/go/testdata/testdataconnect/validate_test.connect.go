@@ -0,0 +1,125 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: testdata/validate_test.proto
+
+package testdataconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ValidateTestServiceName is the fully-qualified name of the ValidateTestService service.
+	ValidateTestServiceName = "testdata.ValidateTestService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ValidateTestServiceCreateUserProcedure is the fully-qualified name of the ValidateTestService's
+	// CreateUser RPC.
+	ValidateTestServiceCreateUserProcedure = "/testdata.ValidateTestService/CreateUser"
+)
+
+// ValidateTestServiceClient is a client for the testdata.ValidateTestService service.
+type ValidateTestServiceClient interface {
+	// CreateUser creates a user
+	CreateUser(context.Context, *connect.Request[testdata.CreateUserRequest]) (*connect.Response[testdata.CreateUserResponse], error)
+}
+
+// NewValidateTestServiceClient constructs a client for the testdata.ValidateTestService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewValidateTestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ValidateTestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	validateTestServiceMethods := testdata.File_testdata_validate_test_proto.Services().ByName("ValidateTestService").Methods()
+	return &validateTestServiceClient{
+		createUser: connect.NewClient[testdata.CreateUserRequest, testdata.CreateUserResponse](
+			httpClient,
+			baseURL+ValidateTestServiceCreateUserProcedure,
+			connect.WithSchema(validateTestServiceMethods.ByName("CreateUser")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// validateTestServiceClient implements ValidateTestServiceClient.
+type validateTestServiceClient struct {
+	createUser *connect.Client[testdata.CreateUserRequest, testdata.CreateUserResponse]
+}
+
+// CreateUser calls testdata.ValidateTestService.CreateUser.
+func (c *validateTestServiceClient) CreateUser(ctx context.Context, req *connect.Request[testdata.CreateUserRequest]) (*connect.Response[testdata.CreateUserResponse], error) {
+	return c.createUser.CallUnary(ctx, req)
+}
+
+// ValidateTestServiceHandler is an implementation of the testdata.ValidateTestService service.
+type ValidateTestServiceHandler interface {
+	// CreateUser creates a user
+	CreateUser(context.Context, *connect.Request[testdata.CreateUserRequest]) (*connect.Response[testdata.CreateUserResponse], error)
+}
+
+// NewValidateTestServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewValidateTestServiceHandler(svc ValidateTestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	validateTestServiceMethods := testdata.File_testdata_validate_test_proto.Services().ByName("ValidateTestService").Methods()
+	validateTestServiceCreateUserHandler := connect.NewUnaryHandler(
+		ValidateTestServiceCreateUserProcedure,
+		svc.CreateUser,
+		connect.WithSchema(validateTestServiceMethods.ByName("CreateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/testdata.ValidateTestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ValidateTestServiceCreateUserProcedure:
+			validateTestServiceCreateUserHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedValidateTestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedValidateTestServiceHandler struct{}
+
+func (UnimplementedValidateTestServiceHandler) CreateUser(context.Context, *connect.Request[testdata.CreateUserRequest]) (*connect.Response[testdata.CreateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("testdata.ValidateTestService.CreateUser is not implemented"))
+}
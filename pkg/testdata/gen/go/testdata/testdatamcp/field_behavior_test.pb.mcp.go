@@ -0,0 +1,711 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/field_behavior_test.proto
+
+package testdatamcp
+
+import (
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+import (
+	"context"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"connectrpc.com/connect"
+	grpc "google.golang.org/grpc"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+)
+
+var (
+	FieldBehaviorTestService_UpdateBookTool = mcp.Tool{
+		Name:        "testdata_FieldBehaviorTestService_UpdateBook",
+		Description: "UpdateBook updates a book\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "book": {
+      "properties": {
+        "authors": {
+          "items": {
+            "properties": {
+              "display_name": {
+                "type": "string"
+              }
+            },
+            "required": [],
+            "type": "object"
+          },
+          "type": "array"
+        },
+        "isbn": {
+          "description": "The ISBN of the book\n\nImmutable, it can only be set when the resource is created.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The resource name of the book\n\nIdentifies the resource, it is not changed by this call.",
+          "type": "string"
+        },
+        "publisher": {
+          "description": "The publisher of the book",
+          "type": "string"
+        },
+        "subtitle": {
+          "type": "string"
+        },
+        "title": {
+          "description": "The title of the book",
+          "type": "string"
+        }
+      },
+      "required": [
+        "title",
+        "publisher"
+      ],
+      "type": "object"
+    },
+    "update_mask": {
+      "type": "string"
+    }
+  },
+  "required": [
+    "book"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "authors": {
+      "items": {
+        "properties": {
+          "display_name": {
+            "type": "string"
+          },
+          "uid": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "create_time": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "etag": {
+      "type": "string"
+    },
+    "isbn": {
+      "description": "The ISBN of the book",
+      "type": "string"
+    },
+    "name": {
+      "description": "The resource name of the book",
+      "type": "string"
+    },
+    "publisher": {
+      "description": "The publisher of the book",
+      "type": "string"
+    },
+    "subtitle": {
+      "type": "string"
+    },
+    "title": {
+      "description": "The title of the book",
+      "type": "string"
+    }
+  },
+  "required": [
+    "title",
+    "etag",
+    "publisher"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	FieldBehaviorTestService_UpdateBookToolOpenAI = mcp.Tool{
+		Name:        "testdata_FieldBehaviorTestService_UpdateBook",
+		Description: "UpdateBook updates a book\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "book": {
+      "additionalProperties": false,
+      "properties": {
+        "authors": {
+          "items": {
+            "additionalProperties": false,
+            "properties": {
+              "display_name": {
+                "type": "string"
+              }
+            },
+            "required": [
+              "display_name"
+            ],
+            "type": "object"
+          },
+          "type": "array"
+        },
+        "isbn": {
+          "description": "The ISBN of the book\n\nImmutable, it can only be set when the resource is created.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The resource name of the book\n\nIdentifies the resource, it is not changed by this call.",
+          "type": "string"
+        },
+        "publisher": {
+          "description": "The publisher of the book",
+          "type": "string"
+        },
+        "subtitle": {
+          "type": "string"
+        },
+        "title": {
+          "description": "The title of the book",
+          "type": "string"
+        }
+      },
+      "required": [
+        "title",
+        "publisher",
+        "authors",
+        "isbn",
+        "name",
+        "subtitle"
+      ],
+      "type": "object"
+    },
+    "update_mask": {
+      "type": "string"
+    }
+  },
+  "required": [
+    "book",
+    "update_mask"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "authors": {
+      "items": {
+        "properties": {
+          "display_name": {
+            "type": "string"
+          },
+          "uid": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "create_time": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "etag": {
+      "type": "string"
+    },
+    "isbn": {
+      "description": "The ISBN of the book",
+      "type": "string"
+    },
+    "name": {
+      "description": "The resource name of the book",
+      "type": "string"
+    },
+    "publisher": {
+      "description": "The publisher of the book",
+      "type": "string"
+    },
+    "subtitle": {
+      "type": "string"
+    },
+    "title": {
+      "description": "The title of the book",
+      "type": "string"
+    }
+  },
+  "required": [
+    "title",
+    "etag",
+    "publisher"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	FieldBehaviorTestService_UpdateBookToolGemini = mcp.Tool{
+		Name:        "testdata_FieldBehaviorTestService_UpdateBook",
+		Description: "UpdateBook updates a book\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "book": {
+      "properties": {
+        "authors": {
+          "items": {
+            "properties": {
+              "display_name": {
+                "type": "string"
+              }
+            },
+            "required": [],
+            "type": "object"
+          },
+          "type": "array"
+        },
+        "isbn": {
+          "description": "The ISBN of the book\n\nImmutable, it can only be set when the resource is created.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The resource name of the book\n\nIdentifies the resource, it is not changed by this call.",
+          "type": "string"
+        },
+        "publisher": {
+          "description": "The publisher of the book",
+          "type": "string"
+        },
+        "subtitle": {
+          "type": "string"
+        },
+        "title": {
+          "description": "The title of the book",
+          "type": "string"
+        }
+      },
+      "required": [
+        "title",
+        "publisher"
+      ],
+      "type": "object"
+    },
+    "update_mask": {
+      "type": "string"
+    }
+  },
+  "required": [
+    "book"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "authors": {
+      "items": {
+        "properties": {
+          "display_name": {
+            "type": "string"
+          },
+          "uid": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "create_time": {
+      "format": "date-time",
+      "type": [
+        "string",
+        "null"
+      ]
+    },
+    "etag": {
+      "type": "string"
+    },
+    "isbn": {
+      "description": "The ISBN of the book",
+      "type": "string"
+    },
+    "name": {
+      "description": "The resource name of the book",
+      "type": "string"
+    },
+    "publisher": {
+      "description": "The publisher of the book",
+      "type": "string"
+    },
+    "subtitle": {
+      "type": "string"
+    },
+    "title": {
+      "description": "The title of the book",
+      "type": "string"
+    }
+  },
+  "required": [
+    "title",
+    "etag",
+    "publisher"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// FieldBehaviorTestServiceServer is compatible with the grpc-go server interface.
+type FieldBehaviorTestServiceServer interface {
+	UpdateBook(ctx context.Context, req *testdata.UpdateBookRequest) (*testdata.Book, error)
+}
+
+// RegisterFieldBehaviorTestServiceHandler registers standard MCP handlers for FieldBehaviorTestService
+func RegisterFieldBehaviorTestServiceHandler(s *mcpserver.MCPServer, srv FieldBehaviorTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	UpdateBookTool := FieldBehaviorTestService_UpdateBookTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UpdateBookTool = runtime.TransformTool(UpdateBookTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateBookTool = runtime.AddExtraPropertiesToTool(UpdateBookTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateBookTool = runtime.BindFieldsToTool(UpdateBookTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateBookTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateBookRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.FieldBehaviorTestService/UpdateBook", &req, func(ctx context.Context, req any) (*testdata.Book, error) {
+			return srv.UpdateBook(ctx, req.(*testdata.UpdateBookRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterFieldBehaviorTestServiceHandlerOpenAI registers OpenAI-compatible MCP handlers for FieldBehaviorTestService
+func RegisterFieldBehaviorTestServiceHandlerOpenAI(s *mcpserver.MCPServer, srv FieldBehaviorTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	UpdateBookToolOpenAI := FieldBehaviorTestService_UpdateBookToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateBookToolOpenAI = runtime.AddExtraPropertiesToTool(UpdateBookToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateBookToolOpenAI = runtime.BindFieldsToTool(UpdateBookToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateBookToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateBookRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.FieldBehaviorTestService/UpdateBook", &req, func(ctx context.Context, req any) (*testdata.Book, error) {
+			return srv.UpdateBook(ctx, req.(*testdata.UpdateBookRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterFieldBehaviorTestServiceHandlerGemini registers Gemini-compatible MCP handlers for FieldBehaviorTestService
+func RegisterFieldBehaviorTestServiceHandlerGemini(s *mcpserver.MCPServer, srv FieldBehaviorTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	UpdateBookToolGemini := FieldBehaviorTestService_UpdateBookToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateBookToolGemini = runtime.AddExtraPropertiesToTool(UpdateBookToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateBookToolGemini = runtime.BindFieldsToTool(UpdateBookToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateBookToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateBookRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.FieldBehaviorTestService/UpdateBook", &req, func(ctx context.Context, req any) (*testdata.Book, error) {
+			return srv.UpdateBook(ctx, req.(*testdata.UpdateBookRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterFieldBehaviorTestServiceHandlerWithProvider registers handlers for the specified LLM provider
+func RegisterFieldBehaviorTestServiceHandlerWithProvider(s *mcpserver.MCPServer, srv FieldBehaviorTestServiceServer, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterFieldBehaviorTestServiceHandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterFieldBehaviorTestServiceHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterFieldBehaviorTestServiceHandler(s, srv, opts...)
+	}
+}
+
+// FieldBehaviorTestServiceClient is compatible with the grpc-go client interface.
+type FieldBehaviorTestServiceClient interface {
+	UpdateBook(ctx context.Context, req *testdata.UpdateBookRequest, opts ...grpc.CallOption) (*testdata.Book, error)
+}
+
+// ConnectFieldBehaviorTestServiceClient is compatible with the connectrpc-go client interface.
+type ConnectFieldBehaviorTestServiceClient interface {
+	UpdateBook(ctx context.Context, req *connect.Request[testdata.UpdateBookRequest]) (*connect.Response[testdata.Book], error)
+}
+
+// ForwardToConnectFieldBehaviorTestServiceClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectFieldBehaviorTestServiceClient(s *mcpserver.MCPServer, client ConnectFieldBehaviorTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	UpdateBookTool := FieldBehaviorTestService_UpdateBookTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UpdateBookTool = runtime.TransformTool(UpdateBookTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateBookTool = runtime.AddExtraPropertiesToTool(UpdateBookTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateBookTool = runtime.BindFieldsToTool(UpdateBookTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateBookTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateBookRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.FieldBehaviorTestService/UpdateBook", &req, func(ctx context.Context, req any) (*testdata.Book, error) {
+			connectReq := connect.NewRequest(req.(*testdata.UpdateBookRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.UpdateBook(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// ForwardToFieldBehaviorTestServiceClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToFieldBehaviorTestServiceClient(s *mcpserver.MCPServer, client FieldBehaviorTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	UpdateBookTool := FieldBehaviorTestService_UpdateBookTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UpdateBookTool = runtime.TransformTool(UpdateBookTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UpdateBookTool = runtime.AddExtraPropertiesToTool(UpdateBookTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UpdateBookTool = runtime.BindFieldsToTool(UpdateBookTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(UpdateBookTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.UpdateBookRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.FieldBehaviorTestService/UpdateBook", &req, func(ctx context.Context, req any) (*testdata.Book, error) {
+			return client.UpdateBook(ctx, req.(*testdata.UpdateBookRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
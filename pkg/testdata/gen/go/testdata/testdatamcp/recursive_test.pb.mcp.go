@@ -0,0 +1,3011 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/recursive_test.proto
+
+package testdatamcp
+
+import (
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+import (
+	"context"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"connectrpc.com/connect"
+	grpc "google.golang.org/grpc"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+)
+
+var (
+	RecursiveTestService_CreateTreeTool = mcp.Tool{
+		Name:        "testdata_RecursiveTestService_CreateTree",
+		Description: "CreateTree creates a tree of nodes\n",
+		RawInputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Address": {
+      "description": "Address is referenced from several fields",
+      "properties": {
+        "city": {
+          "type": "string"
+        },
+        "street": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    },
+    "testdata.Expression": {
+      "anyOf": [
+        {
+          "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+          "oneOf": [
+            {
+              "properties": {
+                "literal": {
+                  "type": "string"
+                }
+              },
+              "required": [
+                "literal"
+              ]
+            },
+            {
+              "properties": {
+                "binary": {
+                  "properties": {
+                    "left": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    },
+                    "operator": {
+                      "type": "string"
+                    },
+                    "right": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [
+                "binary"
+              ]
+            }
+          ]
+        }
+      ],
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {},
+      "required": [],
+      "type": "object"
+    },
+    "testdata.TreeNode": {
+      "description": "TreeNode is a node of a tree",
+      "properties": {
+        "children": {
+          "description": "Child nodes",
+          "items": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "type": "array"
+        },
+        "expression": {
+          "$ref": "#/$defs/testdata.Expression"
+        },
+        "labeled_children": {
+          "additionalProperties": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "properties": {
+    "billing_address": {
+      "$ref": "#/$defs/testdata.Address"
+    },
+    "root": {
+      "$ref": "#/$defs/testdata.TreeNode"
+    },
+    "shipping_address": {
+      "$ref": "#/$defs/testdata.Address"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Expression": {
+      "anyOf": [
+        {
+          "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+          "oneOf": [
+            {
+              "properties": {
+                "literal": {
+                  "type": "string"
+                }
+              },
+              "required": [
+                "literal"
+              ]
+            },
+            {
+              "properties": {
+                "binary": {
+                  "properties": {
+                    "left": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    },
+                    "operator": {
+                      "type": "string"
+                    },
+                    "right": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [
+                "binary"
+              ]
+            }
+          ]
+        }
+      ],
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {},
+      "required": [],
+      "type": "object"
+    },
+    "testdata.TreeNode": {
+      "description": "TreeNode is a node of a tree",
+      "properties": {
+        "children": {
+          "description": "Child nodes",
+          "items": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "type": "array"
+        },
+        "expression": {
+          "$ref": "#/$defs/testdata.Expression"
+        },
+        "labeled_children": {
+          "additionalProperties": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "properties": {
+    "root": {
+      "$ref": "#/$defs/testdata.TreeNode"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	RecursiveTestService_WalkTreeTool = mcp.Tool{
+		Name:        "testdata_RecursiveTestService_WalkTree",
+		Description: "WalkTree streams the nodes of trees\n",
+		RawInputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Address": {
+      "description": "Address is referenced from several fields",
+      "properties": {
+        "city": {
+          "type": "string"
+        },
+        "street": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    },
+    "testdata.Expression": {
+      "anyOf": [
+        {
+          "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+          "oneOf": [
+            {
+              "properties": {
+                "literal": {
+                  "type": "string"
+                }
+              },
+              "required": [
+                "literal"
+              ]
+            },
+            {
+              "properties": {
+                "binary": {
+                  "properties": {
+                    "left": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    },
+                    "operator": {
+                      "type": "string"
+                    },
+                    "right": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [
+                "binary"
+              ]
+            }
+          ]
+        }
+      ],
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {},
+      "required": [],
+      "type": "object"
+    },
+    "testdata.TreeNode": {
+      "description": "TreeNode is a node of a tree",
+      "properties": {
+        "children": {
+          "description": "Child nodes",
+          "items": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "type": "array"
+        },
+        "expression": {
+          "$ref": "#/$defs/testdata.Expression"
+        },
+        "labeled_children": {
+          "additionalProperties": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "properties": {
+          "billing_address": {
+            "$ref": "#/$defs/testdata.Address"
+          },
+          "root": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "shipping_address": {
+            "$ref": "#/$defs/testdata.Address"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Expression": {
+      "anyOf": [
+        {
+          "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+          "oneOf": [
+            {
+              "properties": {
+                "literal": {
+                  "type": "string"
+                }
+              },
+              "required": [
+                "literal"
+              ]
+            },
+            {
+              "properties": {
+                "binary": {
+                  "properties": {
+                    "left": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    },
+                    "operator": {
+                      "type": "string"
+                    },
+                    "right": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [
+                "binary"
+              ]
+            }
+          ]
+        }
+      ],
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {},
+      "required": [],
+      "type": "object"
+    }
+  },
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "TreeNode is a node of a tree",
+        "properties": {
+          "children": {
+            "description": "Child nodes",
+            "items": {
+              "$ref": "#/properties/messages/items"
+            },
+            "type": "array"
+          },
+          "expression": {
+            "$ref": "#/$defs/testdata.Expression"
+          },
+          "labeled_children": {
+            "additionalProperties": {
+              "$ref": "#/properties/messages/items"
+            },
+            "propertyNames": {
+              "type": "string"
+            },
+            "type": "object"
+          },
+          "name": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	RecursiveTestService_CreateTreeToolOpenAI = mcp.Tool{
+		Name:        "testdata_RecursiveTestService_CreateTree",
+		Description: "CreateTree creates a tree of nodes\n",
+		RawInputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Address": {
+      "additionalProperties": false,
+      "description": "Address is referenced from several fields",
+      "properties": {
+        "city": {
+          "type": "string"
+        },
+        "street": {
+          "type": "string"
+        }
+      },
+      "required": [
+        "city",
+        "street"
+      ],
+      "type": "object"
+    },
+    "testdata.Expression": {
+      "additionalProperties": false,
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {
+        "binary": {
+          "additionalProperties": false,
+          "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+          "properties": {
+            "left": {
+              "anyOf": [
+                {
+                  "$ref": "#/$defs/testdata.Expression"
+                },
+                {
+                  "type": "null"
+                }
+              ]
+            },
+            "operator": {
+              "type": "string"
+            },
+            "right": {
+              "anyOf": [
+                {
+                  "$ref": "#/$defs/testdata.Expression"
+                },
+                {
+                  "type": "null"
+                }
+              ]
+            }
+          },
+          "required": [
+            "left",
+            "operator",
+            "right"
+          ],
+          "type": [
+            "object",
+            "null"
+          ]
+        },
+        "literal": {
+          "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "required": [
+        "binary",
+        "literal"
+      ],
+      "type": "object"
+    },
+    "testdata.TreeNode": {
+      "additionalProperties": false,
+      "description": "TreeNode is a node of a tree",
+      "properties": {
+        "children": {
+          "description": "Child nodes",
+          "items": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "type": "array"
+        },
+        "expression": {
+          "anyOf": [
+            {
+              "$ref": "#/$defs/testdata.Expression"
+            },
+            {
+              "type": "null"
+            }
+          ]
+        },
+        "labeled_children": {
+          "description": "List of key value pairs",
+          "items": {
+            "additionalProperties": false,
+            "properties": {
+              "key": {
+                "type": "string"
+              },
+              "value": {
+                "$ref": "#/$defs/testdata.TreeNode"
+              }
+            },
+            "required": [
+              "key",
+              "value"
+            ],
+            "type": "object"
+          },
+          "type": "array"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "required": [
+        "children",
+        "expression",
+        "labeled_children",
+        "name"
+      ],
+      "type": "object"
+    }
+  },
+  "additionalProperties": false,
+  "properties": {
+    "billing_address": {
+      "anyOf": [
+        {
+          "$ref": "#/$defs/testdata.Address"
+        },
+        {
+          "type": "null"
+        }
+      ]
+    },
+    "root": {
+      "anyOf": [
+        {
+          "$ref": "#/$defs/testdata.TreeNode"
+        },
+        {
+          "type": "null"
+        }
+      ]
+    },
+    "shipping_address": {
+      "anyOf": [
+        {
+          "$ref": "#/$defs/testdata.Address"
+        },
+        {
+          "type": "null"
+        }
+      ]
+    }
+  },
+  "required": [
+    "billing_address",
+    "root",
+    "shipping_address"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Expression": {
+      "anyOf": [
+        {
+          "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+          "oneOf": [
+            {
+              "properties": {
+                "literal": {
+                  "type": "string"
+                }
+              },
+              "required": [
+                "literal"
+              ]
+            },
+            {
+              "properties": {
+                "binary": {
+                  "properties": {
+                    "left": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    },
+                    "operator": {
+                      "type": "string"
+                    },
+                    "right": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [
+                "binary"
+              ]
+            }
+          ]
+        }
+      ],
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {},
+      "required": [],
+      "type": "object"
+    },
+    "testdata.TreeNode": {
+      "description": "TreeNode is a node of a tree",
+      "properties": {
+        "children": {
+          "description": "Child nodes",
+          "items": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "type": "array"
+        },
+        "expression": {
+          "$ref": "#/$defs/testdata.Expression"
+        },
+        "labeled_children": {
+          "additionalProperties": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "properties": {
+    "root": {
+      "$ref": "#/$defs/testdata.TreeNode"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	RecursiveTestService_WalkTreeToolOpenAI = mcp.Tool{
+		Name:        "testdata_RecursiveTestService_WalkTree",
+		Description: "WalkTree streams the nodes of trees\n",
+		RawInputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Address": {
+      "additionalProperties": false,
+      "description": "Address is referenced from several fields",
+      "properties": {
+        "city": {
+          "type": "string"
+        },
+        "street": {
+          "type": "string"
+        }
+      },
+      "required": [
+        "city",
+        "street"
+      ],
+      "type": "object"
+    },
+    "testdata.Expression": {
+      "additionalProperties": false,
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {
+        "binary": {
+          "additionalProperties": false,
+          "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+          "properties": {
+            "left": {
+              "anyOf": [
+                {
+                  "$ref": "#/$defs/testdata.Expression"
+                },
+                {
+                  "type": "null"
+                }
+              ]
+            },
+            "operator": {
+              "type": "string"
+            },
+            "right": {
+              "anyOf": [
+                {
+                  "$ref": "#/$defs/testdata.Expression"
+                },
+                {
+                  "type": "null"
+                }
+              ]
+            }
+          },
+          "required": [
+            "left",
+            "operator",
+            "right"
+          ],
+          "type": [
+            "object",
+            "null"
+          ]
+        },
+        "literal": {
+          "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+          "type": [
+            "string",
+            "null"
+          ]
+        }
+      },
+      "required": [
+        "binary",
+        "literal"
+      ],
+      "type": "object"
+    },
+    "testdata.TreeNode": {
+      "additionalProperties": false,
+      "description": "TreeNode is a node of a tree",
+      "properties": {
+        "children": {
+          "description": "Child nodes",
+          "items": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "type": "array"
+        },
+        "expression": {
+          "anyOf": [
+            {
+              "$ref": "#/$defs/testdata.Expression"
+            },
+            {
+              "type": "null"
+            }
+          ]
+        },
+        "labeled_children": {
+          "description": "List of key value pairs",
+          "items": {
+            "additionalProperties": false,
+            "properties": {
+              "key": {
+                "type": "string"
+              },
+              "value": {
+                "$ref": "#/$defs/testdata.TreeNode"
+              }
+            },
+            "required": [
+              "key",
+              "value"
+            ],
+            "type": "object"
+          },
+          "type": "array"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "required": [
+        "children",
+        "expression",
+        "labeled_children",
+        "name"
+      ],
+      "type": "object"
+    }
+  },
+  "additionalProperties": false,
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "additionalProperties": false,
+        "properties": {
+          "billing_address": {
+            "anyOf": [
+              {
+                "$ref": "#/$defs/testdata.Address"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          },
+          "root": {
+            "anyOf": [
+              {
+                "$ref": "#/$defs/testdata.TreeNode"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          },
+          "shipping_address": {
+            "anyOf": [
+              {
+                "$ref": "#/$defs/testdata.Address"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          }
+        },
+        "required": [
+          "billing_address",
+          "root",
+          "shipping_address"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Expression": {
+      "anyOf": [
+        {
+          "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+          "oneOf": [
+            {
+              "properties": {
+                "literal": {
+                  "type": "string"
+                }
+              },
+              "required": [
+                "literal"
+              ]
+            },
+            {
+              "properties": {
+                "binary": {
+                  "properties": {
+                    "left": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    },
+                    "operator": {
+                      "type": "string"
+                    },
+                    "right": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [
+                "binary"
+              ]
+            }
+          ]
+        }
+      ],
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {},
+      "required": [],
+      "type": "object"
+    }
+  },
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "TreeNode is a node of a tree",
+        "properties": {
+          "children": {
+            "description": "Child nodes",
+            "items": {
+              "$ref": "#/properties/messages/items"
+            },
+            "type": "array"
+          },
+          "expression": {
+            "$ref": "#/$defs/testdata.Expression"
+          },
+          "labeled_children": {
+            "additionalProperties": {
+              "$ref": "#/properties/messages/items"
+            },
+            "propertyNames": {
+              "type": "string"
+            },
+            "type": "object"
+          },
+          "name": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	RecursiveTestService_CreateTreeToolGemini = mcp.Tool{
+		Name:        "testdata_RecursiveTestService_CreateTree",
+		Description: "CreateTree creates a tree of nodes\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "billing_address": {
+      "description": "Address is referenced from several fields",
+      "properties": {
+        "city": {
+          "type": "string"
+        },
+        "street": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    },
+    "root": {
+      "description": "TreeNode is a node of a tree",
+      "properties": {
+        "children": {
+          "description": "Child nodes",
+          "items": {
+            "description": "TreeNode is a node of a tree",
+            "properties": {
+              "children": {
+                "description": "Child nodes",
+                "items": {
+                  "description": "TreeNode is a node of a tree",
+                  "properties": {
+                    "children": {
+                      "description": "Child nodes",
+                      "type": "array"
+                    },
+                    "expression": {
+                      "description": "Expression references itself through BinaryExpression",
+                      "properties": {
+                        "binary": {
+                          "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                          "nullable": true,
+                          "properties": {
+                            "operator": {
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        },
+                        "literal": {
+                          "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                          "nullable": true,
+                          "type": "string"
+                        }
+                      },
+                      "required": [],
+                      "type": "object"
+                    },
+                    "labeled_children": {
+                      "description": "List of key value pairs",
+                      "items": {
+                        "properties": {
+                          "key": {
+                            "type": "string"
+                          }
+                        },
+                        "required": [
+                          "key"
+                        ],
+                        "type": "object"
+                      },
+                      "type": "array"
+                    },
+                    "name": {
+                      "type": "string"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                },
+                "type": "array"
+              },
+              "expression": {
+                "description": "Expression references itself through BinaryExpression",
+                "properties": {
+                  "binary": {
+                    "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                    "nullable": true,
+                    "properties": {
+                      "left": {
+                        "description": "Expression references itself through BinaryExpression",
+                        "properties": {
+                          "binary": {
+                            "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                            "nullable": true,
+                            "properties": {
+                              "operator": {
+                                "type": "string"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          },
+                          "literal": {
+                            "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                            "nullable": true,
+                            "type": "string"
+                          }
+                        },
+                        "required": [],
+                        "type": "object"
+                      },
+                      "operator": {
+                        "type": "string"
+                      },
+                      "right": {
+                        "description": "Expression references itself through BinaryExpression",
+                        "properties": {
+                          "binary": {
+                            "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                            "nullable": true,
+                            "properties": {
+                              "operator": {
+                                "type": "string"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          },
+                          "literal": {
+                            "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                            "nullable": true,
+                            "type": "string"
+                          }
+                        },
+                        "required": [],
+                        "type": "object"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  },
+                  "literal": {
+                    "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                    "nullable": true,
+                    "type": "string"
+                  }
+                },
+                "required": [],
+                "type": "object"
+              },
+              "labeled_children": {
+                "description": "List of key value pairs",
+                "items": {
+                  "properties": {
+                    "key": {
+                      "type": "string"
+                    },
+                    "value": {
+                      "description": "TreeNode is a node of a tree",
+                      "properties": {
+                        "children": {
+                          "description": "Child nodes",
+                          "type": "array"
+                        },
+                        "expression": {
+                          "description": "Expression references itself through BinaryExpression",
+                          "properties": {
+                            "binary": {
+                              "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "properties": {
+                                "operator": {
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "literal": {
+                              "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        },
+                        "labeled_children": {
+                          "description": "List of key value pairs",
+                          "items": {
+                            "properties": {
+                              "key": {
+                                "type": "string"
+                              }
+                            },
+                            "required": [
+                              "key"
+                            ],
+                            "type": "object"
+                          },
+                          "type": "array"
+                        },
+                        "name": {
+                          "type": "string"
+                        }
+                      },
+                      "required": [],
+                      "type": "object"
+                    }
+                  },
+                  "required": [
+                    "key",
+                    "value"
+                  ],
+                  "type": "object"
+                },
+                "type": "array"
+              },
+              "name": {
+                "type": "string"
+              }
+            },
+            "required": [],
+            "type": "object"
+          },
+          "type": "array"
+        },
+        "expression": {
+          "description": "Expression references itself through BinaryExpression",
+          "properties": {
+            "binary": {
+              "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+              "nullable": true,
+              "properties": {
+                "left": {
+                  "description": "Expression references itself through BinaryExpression",
+                  "properties": {
+                    "binary": {
+                      "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                      "nullable": true,
+                      "properties": {
+                        "left": {
+                          "description": "Expression references itself through BinaryExpression",
+                          "properties": {
+                            "binary": {
+                              "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "properties": {
+                                "operator": {
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "literal": {
+                              "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        },
+                        "operator": {
+                          "type": "string"
+                        },
+                        "right": {
+                          "description": "Expression references itself through BinaryExpression",
+                          "properties": {
+                            "binary": {
+                              "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "properties": {
+                                "operator": {
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "literal": {
+                              "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        }
+                      },
+                      "required": [],
+                      "type": "object"
+                    },
+                    "literal": {
+                      "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                      "nullable": true,
+                      "type": "string"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                },
+                "operator": {
+                  "type": "string"
+                },
+                "right": {
+                  "description": "Expression references itself through BinaryExpression",
+                  "properties": {
+                    "binary": {
+                      "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                      "nullable": true,
+                      "properties": {
+                        "left": {
+                          "description": "Expression references itself through BinaryExpression",
+                          "properties": {
+                            "binary": {
+                              "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "properties": {
+                                "operator": {
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "literal": {
+                              "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        },
+                        "operator": {
+                          "type": "string"
+                        },
+                        "right": {
+                          "description": "Expression references itself through BinaryExpression",
+                          "properties": {
+                            "binary": {
+                              "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "properties": {
+                                "operator": {
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "literal": {
+                              "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        }
+                      },
+                      "required": [],
+                      "type": "object"
+                    },
+                    "literal": {
+                      "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                      "nullable": true,
+                      "type": "string"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [],
+              "type": "object"
+            },
+            "literal": {
+              "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+              "nullable": true,
+              "type": "string"
+            }
+          },
+          "required": [],
+          "type": "object"
+        },
+        "labeled_children": {
+          "description": "List of key value pairs",
+          "items": {
+            "properties": {
+              "key": {
+                "type": "string"
+              },
+              "value": {
+                "description": "TreeNode is a node of a tree",
+                "properties": {
+                  "children": {
+                    "description": "Child nodes",
+                    "items": {
+                      "description": "TreeNode is a node of a tree",
+                      "properties": {
+                        "children": {
+                          "description": "Child nodes",
+                          "type": "array"
+                        },
+                        "expression": {
+                          "description": "Expression references itself through BinaryExpression",
+                          "properties": {
+                            "binary": {
+                              "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "properties": {
+                                "operator": {
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "literal": {
+                              "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        },
+                        "labeled_children": {
+                          "description": "List of key value pairs",
+                          "items": {
+                            "properties": {
+                              "key": {
+                                "type": "string"
+                              }
+                            },
+                            "required": [
+                              "key"
+                            ],
+                            "type": "object"
+                          },
+                          "type": "array"
+                        },
+                        "name": {
+                          "type": "string"
+                        }
+                      },
+                      "required": [],
+                      "type": "object"
+                    },
+                    "type": "array"
+                  },
+                  "expression": {
+                    "description": "Expression references itself through BinaryExpression",
+                    "properties": {
+                      "binary": {
+                        "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                        "nullable": true,
+                        "properties": {
+                          "left": {
+                            "description": "Expression references itself through BinaryExpression",
+                            "properties": {
+                              "binary": {
+                                "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                "nullable": true,
+                                "properties": {
+                                  "operator": {
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              },
+                              "literal": {
+                                "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                "nullable": true,
+                                "type": "string"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          },
+                          "operator": {
+                            "type": "string"
+                          },
+                          "right": {
+                            "description": "Expression references itself through BinaryExpression",
+                            "properties": {
+                              "binary": {
+                                "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                "nullable": true,
+                                "properties": {
+                                  "operator": {
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              },
+                              "literal": {
+                                "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                "nullable": true,
+                                "type": "string"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          }
+                        },
+                        "required": [],
+                        "type": "object"
+                      },
+                      "literal": {
+                        "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                        "nullable": true,
+                        "type": "string"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  },
+                  "labeled_children": {
+                    "description": "List of key value pairs",
+                    "items": {
+                      "properties": {
+                        "key": {
+                          "type": "string"
+                        },
+                        "value": {
+                          "description": "TreeNode is a node of a tree",
+                          "properties": {
+                            "children": {
+                              "description": "Child nodes",
+                              "type": "array"
+                            },
+                            "expression": {
+                              "description": "Expression references itself through BinaryExpression",
+                              "properties": {
+                                "binary": {
+                                  "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                  "nullable": true,
+                                  "properties": {
+                                    "operator": {
+                                      "type": "string"
+                                    }
+                                  },
+                                  "required": [],
+                                  "type": "object"
+                                },
+                                "literal": {
+                                  "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                  "nullable": true,
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "labeled_children": {
+                              "description": "List of key value pairs",
+                              "items": {
+                                "properties": {
+                                  "key": {
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [
+                                  "key"
+                                ],
+                                "type": "object"
+                              },
+                              "type": "array"
+                            },
+                            "name": {
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        }
+                      },
+                      "required": [
+                        "key",
+                        "value"
+                      ],
+                      "type": "object"
+                    },
+                    "type": "array"
+                  },
+                  "name": {
+                    "type": "string"
+                  }
+                },
+                "required": [],
+                "type": "object"
+              }
+            },
+            "required": [
+              "key",
+              "value"
+            ],
+            "type": "object"
+          },
+          "type": "array"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    },
+    "shipping_address": {
+      "description": "Address is referenced from several fields",
+      "properties": {
+        "city": {
+          "type": "string"
+        },
+        "street": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Expression": {
+      "anyOf": [
+        {
+          "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+          "oneOf": [
+            {
+              "properties": {
+                "literal": {
+                  "type": "string"
+                }
+              },
+              "required": [
+                "literal"
+              ]
+            },
+            {
+              "properties": {
+                "binary": {
+                  "properties": {
+                    "left": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    },
+                    "operator": {
+                      "type": "string"
+                    },
+                    "right": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [
+                "binary"
+              ]
+            }
+          ]
+        }
+      ],
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {},
+      "required": [],
+      "type": "object"
+    },
+    "testdata.TreeNode": {
+      "description": "TreeNode is a node of a tree",
+      "properties": {
+        "children": {
+          "description": "Child nodes",
+          "items": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "type": "array"
+        },
+        "expression": {
+          "$ref": "#/$defs/testdata.Expression"
+        },
+        "labeled_children": {
+          "additionalProperties": {
+            "$ref": "#/$defs/testdata.TreeNode"
+          },
+          "propertyNames": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "required": [],
+      "type": "object"
+    }
+  },
+  "properties": {
+    "root": {
+      "$ref": "#/$defs/testdata.TreeNode"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	RecursiveTestService_WalkTreeToolGemini = mcp.Tool{
+		Name:        "testdata_RecursiveTestService_WalkTree",
+		Description: "WalkTree streams the nodes of trees\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "properties": {
+          "billing_address": {
+            "description": "Address is referenced from several fields",
+            "properties": {
+              "city": {
+                "type": "string"
+              },
+              "street": {
+                "type": "string"
+              }
+            },
+            "required": [],
+            "type": "object"
+          },
+          "root": {
+            "description": "TreeNode is a node of a tree",
+            "properties": {
+              "children": {
+                "description": "Child nodes",
+                "items": {
+                  "description": "TreeNode is a node of a tree",
+                  "properties": {
+                    "children": {
+                      "description": "Child nodes",
+                      "items": {
+                        "description": "TreeNode is a node of a tree",
+                        "properties": {
+                          "children": {
+                            "description": "Child nodes",
+                            "type": "array"
+                          },
+                          "expression": {
+                            "description": "Expression references itself through BinaryExpression",
+                            "properties": {
+                              "binary": {
+                                "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                "nullable": true,
+                                "properties": {
+                                  "operator": {
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              },
+                              "literal": {
+                                "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                "nullable": true,
+                                "type": "string"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          },
+                          "labeled_children": {
+                            "description": "List of key value pairs",
+                            "items": {
+                              "properties": {
+                                "key": {
+                                  "type": "string"
+                                }
+                              },
+                              "required": [
+                                "key"
+                              ],
+                              "type": "object"
+                            },
+                            "type": "array"
+                          },
+                          "name": {
+                            "type": "string"
+                          }
+                        },
+                        "required": [],
+                        "type": "object"
+                      },
+                      "type": "array"
+                    },
+                    "expression": {
+                      "description": "Expression references itself through BinaryExpression",
+                      "properties": {
+                        "binary": {
+                          "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                          "nullable": true,
+                          "properties": {
+                            "left": {
+                              "description": "Expression references itself through BinaryExpression",
+                              "properties": {
+                                "binary": {
+                                  "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                  "nullable": true,
+                                  "properties": {
+                                    "operator": {
+                                      "type": "string"
+                                    }
+                                  },
+                                  "required": [],
+                                  "type": "object"
+                                },
+                                "literal": {
+                                  "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                  "nullable": true,
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "operator": {
+                              "type": "string"
+                            },
+                            "right": {
+                              "description": "Expression references itself through BinaryExpression",
+                              "properties": {
+                                "binary": {
+                                  "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                  "nullable": true,
+                                  "properties": {
+                                    "operator": {
+                                      "type": "string"
+                                    }
+                                  },
+                                  "required": [],
+                                  "type": "object"
+                                },
+                                "literal": {
+                                  "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                  "nullable": true,
+                                  "type": "string"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        },
+                        "literal": {
+                          "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                          "nullable": true,
+                          "type": "string"
+                        }
+                      },
+                      "required": [],
+                      "type": "object"
+                    },
+                    "labeled_children": {
+                      "description": "List of key value pairs",
+                      "items": {
+                        "properties": {
+                          "key": {
+                            "type": "string"
+                          },
+                          "value": {
+                            "description": "TreeNode is a node of a tree",
+                            "properties": {
+                              "children": {
+                                "description": "Child nodes",
+                                "type": "array"
+                              },
+                              "expression": {
+                                "description": "Expression references itself through BinaryExpression",
+                                "properties": {
+                                  "binary": {
+                                    "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "properties": {
+                                      "operator": {
+                                        "type": "string"
+                                      }
+                                    },
+                                    "required": [],
+                                    "type": "object"
+                                  },
+                                  "literal": {
+                                    "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              },
+                              "labeled_children": {
+                                "description": "List of key value pairs",
+                                "items": {
+                                  "properties": {
+                                    "key": {
+                                      "type": "string"
+                                    }
+                                  },
+                                  "required": [
+                                    "key"
+                                  ],
+                                  "type": "object"
+                                },
+                                "type": "array"
+                              },
+                              "name": {
+                                "type": "string"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          }
+                        },
+                        "required": [
+                          "key",
+                          "value"
+                        ],
+                        "type": "object"
+                      },
+                      "type": "array"
+                    },
+                    "name": {
+                      "type": "string"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                },
+                "type": "array"
+              },
+              "expression": {
+                "description": "Expression references itself through BinaryExpression",
+                "properties": {
+                  "binary": {
+                    "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                    "nullable": true,
+                    "properties": {
+                      "left": {
+                        "description": "Expression references itself through BinaryExpression",
+                        "properties": {
+                          "binary": {
+                            "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                            "nullable": true,
+                            "properties": {
+                              "left": {
+                                "description": "Expression references itself through BinaryExpression",
+                                "properties": {
+                                  "binary": {
+                                    "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "properties": {
+                                      "operator": {
+                                        "type": "string"
+                                      }
+                                    },
+                                    "required": [],
+                                    "type": "object"
+                                  },
+                                  "literal": {
+                                    "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              },
+                              "operator": {
+                                "type": "string"
+                              },
+                              "right": {
+                                "description": "Expression references itself through BinaryExpression",
+                                "properties": {
+                                  "binary": {
+                                    "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "properties": {
+                                      "operator": {
+                                        "type": "string"
+                                      }
+                                    },
+                                    "required": [],
+                                    "type": "object"
+                                  },
+                                  "literal": {
+                                    "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          },
+                          "literal": {
+                            "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                            "nullable": true,
+                            "type": "string"
+                          }
+                        },
+                        "required": [],
+                        "type": "object"
+                      },
+                      "operator": {
+                        "type": "string"
+                      },
+                      "right": {
+                        "description": "Expression references itself through BinaryExpression",
+                        "properties": {
+                          "binary": {
+                            "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                            "nullable": true,
+                            "properties": {
+                              "left": {
+                                "description": "Expression references itself through BinaryExpression",
+                                "properties": {
+                                  "binary": {
+                                    "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "properties": {
+                                      "operator": {
+                                        "type": "string"
+                                      }
+                                    },
+                                    "required": [],
+                                    "type": "object"
+                                  },
+                                  "literal": {
+                                    "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              },
+                              "operator": {
+                                "type": "string"
+                              },
+                              "right": {
+                                "description": "Expression references itself through BinaryExpression",
+                                "properties": {
+                                  "binary": {
+                                    "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "properties": {
+                                      "operator": {
+                                        "type": "string"
+                                      }
+                                    },
+                                    "required": [],
+                                    "type": "object"
+                                  },
+                                  "literal": {
+                                    "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          },
+                          "literal": {
+                            "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                            "nullable": true,
+                            "type": "string"
+                          }
+                        },
+                        "required": [],
+                        "type": "object"
+                      }
+                    },
+                    "required": [],
+                    "type": "object"
+                  },
+                  "literal": {
+                    "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                    "nullable": true,
+                    "type": "string"
+                  }
+                },
+                "required": [],
+                "type": "object"
+              },
+              "labeled_children": {
+                "description": "List of key value pairs",
+                "items": {
+                  "properties": {
+                    "key": {
+                      "type": "string"
+                    },
+                    "value": {
+                      "description": "TreeNode is a node of a tree",
+                      "properties": {
+                        "children": {
+                          "description": "Child nodes",
+                          "items": {
+                            "description": "TreeNode is a node of a tree",
+                            "properties": {
+                              "children": {
+                                "description": "Child nodes",
+                                "type": "array"
+                              },
+                              "expression": {
+                                "description": "Expression references itself through BinaryExpression",
+                                "properties": {
+                                  "binary": {
+                                    "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "properties": {
+                                      "operator": {
+                                        "type": "string"
+                                      }
+                                    },
+                                    "required": [],
+                                    "type": "object"
+                                  },
+                                  "literal": {
+                                    "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                    "nullable": true,
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              },
+                              "labeled_children": {
+                                "description": "List of key value pairs",
+                                "items": {
+                                  "properties": {
+                                    "key": {
+                                      "type": "string"
+                                    }
+                                  },
+                                  "required": [
+                                    "key"
+                                  ],
+                                  "type": "object"
+                                },
+                                "type": "array"
+                              },
+                              "name": {
+                                "type": "string"
+                              }
+                            },
+                            "required": [],
+                            "type": "object"
+                          },
+                          "type": "array"
+                        },
+                        "expression": {
+                          "description": "Expression references itself through BinaryExpression",
+                          "properties": {
+                            "binary": {
+                              "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "properties": {
+                                "left": {
+                                  "description": "Expression references itself through BinaryExpression",
+                                  "properties": {
+                                    "binary": {
+                                      "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                      "nullable": true,
+                                      "properties": {
+                                        "operator": {
+                                          "type": "string"
+                                        }
+                                      },
+                                      "required": [],
+                                      "type": "object"
+                                    },
+                                    "literal": {
+                                      "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                      "nullable": true,
+                                      "type": "string"
+                                    }
+                                  },
+                                  "required": [],
+                                  "type": "object"
+                                },
+                                "operator": {
+                                  "type": "string"
+                                },
+                                "right": {
+                                  "description": "Expression references itself through BinaryExpression",
+                                  "properties": {
+                                    "binary": {
+                                      "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                      "nullable": true,
+                                      "properties": {
+                                        "operator": {
+                                          "type": "string"
+                                        }
+                                      },
+                                      "required": [],
+                                      "type": "object"
+                                    },
+                                    "literal": {
+                                      "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                      "nullable": true,
+                                      "type": "string"
+                                    }
+                                  },
+                                  "required": [],
+                                  "type": "object"
+                                }
+                              },
+                              "required": [],
+                              "type": "object"
+                            },
+                            "literal": {
+                              "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                              "nullable": true,
+                              "type": "string"
+                            }
+                          },
+                          "required": [],
+                          "type": "object"
+                        },
+                        "labeled_children": {
+                          "description": "List of key value pairs",
+                          "items": {
+                            "properties": {
+                              "key": {
+                                "type": "string"
+                              },
+                              "value": {
+                                "description": "TreeNode is a node of a tree",
+                                "properties": {
+                                  "children": {
+                                    "description": "Child nodes",
+                                    "type": "array"
+                                  },
+                                  "expression": {
+                                    "description": "Expression references itself through BinaryExpression",
+                                    "properties": {
+                                      "binary": {
+                                        "description": "Note: This field is part of a oneof group with \"literal\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                        "nullable": true,
+                                        "properties": {
+                                          "operator": {
+                                            "type": "string"
+                                          }
+                                        },
+                                        "required": [],
+                                        "type": "object"
+                                      },
+                                      "literal": {
+                                        "description": "Note: This field is part of a oneof group with \"binary\". Only one field in this group can be set at a time. Setting multiple fields in the group WILL result in an error. Protobuf oneOf semantics apply.",
+                                        "nullable": true,
+                                        "type": "string"
+                                      }
+                                    },
+                                    "required": [],
+                                    "type": "object"
+                                  },
+                                  "labeled_children": {
+                                    "description": "List of key value pairs",
+                                    "items": {
+                                      "properties": {
+                                        "key": {
+                                          "type": "string"
+                                        }
+                                      },
+                                      "required": [
+                                        "key"
+                                      ],
+                                      "type": "object"
+                                    },
+                                    "type": "array"
+                                  },
+                                  "name": {
+                                    "type": "string"
+                                  }
+                                },
+                                "required": [],
+                                "type": "object"
+                              }
+                            },
+                            "required": [
+                              "key",
+                              "value"
+                            ],
+                            "type": "object"
+                          },
+                          "type": "array"
+                        },
+                        "name": {
+                          "type": "string"
+                        }
+                      },
+                      "required": [],
+                      "type": "object"
+                    }
+                  },
+                  "required": [
+                    "key",
+                    "value"
+                  ],
+                  "type": "object"
+                },
+                "type": "array"
+              },
+              "name": {
+                "type": "string"
+              }
+            },
+            "required": [],
+            "type": "object"
+          },
+          "shipping_address": {
+            "description": "Address is referenced from several fields",
+            "properties": {
+              "city": {
+                "type": "string"
+              },
+              "street": {
+                "type": "string"
+              }
+            },
+            "required": [],
+            "type": "object"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "$defs": {
+    "testdata.Expression": {
+      "anyOf": [
+        {
+          "$comment": "In this schema, there is a oneOf group for every protobuf oneOf block in the message.",
+          "oneOf": [
+            {
+              "properties": {
+                "literal": {
+                  "type": "string"
+                }
+              },
+              "required": [
+                "literal"
+              ]
+            },
+            {
+              "properties": {
+                "binary": {
+                  "properties": {
+                    "left": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    },
+                    "operator": {
+                      "type": "string"
+                    },
+                    "right": {
+                      "$ref": "#/$defs/testdata.Expression"
+                    }
+                  },
+                  "required": [],
+                  "type": "object"
+                }
+              },
+              "required": [
+                "binary"
+              ]
+            }
+          ]
+        }
+      ],
+      "description": "Expression references itself through BinaryExpression",
+      "properties": {},
+      "required": [],
+      "type": "object"
+    }
+  },
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "description": "TreeNode is a node of a tree",
+        "properties": {
+          "children": {
+            "description": "Child nodes",
+            "items": {
+              "$ref": "#/properties/messages/items"
+            },
+            "type": "array"
+          },
+          "expression": {
+            "$ref": "#/$defs/testdata.Expression"
+          },
+          "labeled_children": {
+            "additionalProperties": {
+              "$ref": "#/properties/messages/items"
+            },
+            "propertyNames": {
+              "type": "string"
+            },
+            "type": "object"
+          },
+          "name": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// RecursiveTestServiceServer is compatible with the grpc-go server interface.
+type RecursiveTestServiceServer interface {
+	CreateTree(ctx context.Context, req *testdata.CreateTreeRequest) (*testdata.CreateTreeResponse, error)
+	WalkTree(stream grpc.BidiStreamingServer[testdata.CreateTreeRequest, testdata.TreeNode]) error
+}
+
+// RegisterRecursiveTestServiceHandler registers standard MCP handlers for RecursiveTestService
+func RegisterRecursiveTestServiceHandler(s *mcpserver.MCPServer, srv RecursiveTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateTreeTool := RecursiveTestService_CreateTreeTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateTreeTool = runtime.TransformTool(CreateTreeTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateTreeTool = runtime.AddExtraPropertiesToTool(CreateTreeTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateTreeTool = runtime.BindFieldsToTool(CreateTreeTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateTreeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateTreeRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/CreateTree", &req, func(ctx context.Context, req any) (*testdata.CreateTreeResponse, error) {
+			return srv.CreateTree(ctx, req.(*testdata.CreateTreeRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WalkTreeTool := RecursiveTestService_WalkTreeTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WalkTreeTool = runtime.TransformTool(WalkTreeTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WalkTreeTool = runtime.AddExtraPropertiesToTool(WalkTreeTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WalkTreeTool = runtime.BindFieldsToTool(WalkTreeTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WalkTreeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.CreateTreeRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/WalkTree", reqs, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.WalkTree(runtime.NewClientStream[testdata.CreateTreeRequest, testdata.TreeNode](ctx, req.([]*testdata.CreateTreeRequest), collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+}
+
+// RegisterRecursiveTestServiceHandlerOpenAI registers OpenAI-compatible MCP handlers for RecursiveTestService
+func RegisterRecursiveTestServiceHandlerOpenAI(s *mcpserver.MCPServer, srv RecursiveTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CreateTreeToolOpenAI := RecursiveTestService_CreateTreeToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateTreeToolOpenAI = runtime.AddExtraPropertiesToTool(CreateTreeToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateTreeToolOpenAI = runtime.BindFieldsToTool(CreateTreeToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateTreeToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateTreeRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/CreateTree", &req, func(ctx context.Context, req any) (*testdata.CreateTreeResponse, error) {
+			return srv.CreateTree(ctx, req.(*testdata.CreateTreeRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WalkTreeToolOpenAI := RecursiveTestService_WalkTreeToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WalkTreeToolOpenAI = runtime.AddExtraPropertiesToTool(WalkTreeToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WalkTreeToolOpenAI = runtime.BindFieldsToTool(WalkTreeToolOpenAI, config.FieldBindings, true)
+	}
+
+	s.AddTool(WalkTreeToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.CreateTreeRequest](message, runtime.LLMProviderOpenAI)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/WalkTree", reqs, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.WalkTree(runtime.NewClientStream[testdata.CreateTreeRequest, testdata.TreeNode](ctx, req.([]*testdata.CreateTreeRequest), collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+}
+
+// RegisterRecursiveTestServiceHandlerGemini registers Gemini-compatible MCP handlers for RecursiveTestService
+func RegisterRecursiveTestServiceHandlerGemini(s *mcpserver.MCPServer, srv RecursiveTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CreateTreeToolGemini := RecursiveTestService_CreateTreeToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateTreeToolGemini = runtime.AddExtraPropertiesToTool(CreateTreeToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateTreeToolGemini = runtime.BindFieldsToTool(CreateTreeToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateTreeToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateTreeRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/CreateTree", &req, func(ctx context.Context, req any) (*testdata.CreateTreeResponse, error) {
+			return srv.CreateTree(ctx, req.(*testdata.CreateTreeRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WalkTreeToolGemini := RecursiveTestService_WalkTreeToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WalkTreeToolGemini = runtime.AddExtraPropertiesToTool(WalkTreeToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WalkTreeToolGemini = runtime.BindFieldsToTool(WalkTreeToolGemini, config.FieldBindings, true)
+	}
+
+	s.AddTool(WalkTreeToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.CreateTreeRequest](message, runtime.LLMProviderGemini)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/WalkTree", reqs, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.WalkTree(runtime.NewClientStream[testdata.CreateTreeRequest, testdata.TreeNode](ctx, req.([]*testdata.CreateTreeRequest), collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+}
+
+// RegisterRecursiveTestServiceHandlerWithProvider registers handlers for the specified LLM provider
+func RegisterRecursiveTestServiceHandlerWithProvider(s *mcpserver.MCPServer, srv RecursiveTestServiceServer, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterRecursiveTestServiceHandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterRecursiveTestServiceHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterRecursiveTestServiceHandler(s, srv, opts...)
+	}
+}
+
+// RecursiveTestServiceClient is compatible with the grpc-go client interface.
+type RecursiveTestServiceClient interface {
+	CreateTree(ctx context.Context, req *testdata.CreateTreeRequest, opts ...grpc.CallOption) (*testdata.CreateTreeResponse, error)
+	WalkTree(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[testdata.CreateTreeRequest, testdata.TreeNode], error)
+}
+
+// ConnectRecursiveTestServiceClient is compatible with the connectrpc-go client interface.
+type ConnectRecursiveTestServiceClient interface {
+	CreateTree(ctx context.Context, req *connect.Request[testdata.CreateTreeRequest]) (*connect.Response[testdata.CreateTreeResponse], error)
+	WalkTree(ctx context.Context) *connect.BidiStreamForClient[testdata.CreateTreeRequest, testdata.TreeNode]
+}
+
+// ForwardToConnectRecursiveTestServiceClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectRecursiveTestServiceClient(s *mcpserver.MCPServer, client ConnectRecursiveTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateTreeTool := RecursiveTestService_CreateTreeTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateTreeTool = runtime.TransformTool(CreateTreeTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateTreeTool = runtime.AddExtraPropertiesToTool(CreateTreeTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateTreeTool = runtime.BindFieldsToTool(CreateTreeTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateTreeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateTreeRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/CreateTree", &req, func(ctx context.Context, req any) (*testdata.CreateTreeResponse, error) {
+			connectReq := connect.NewRequest(req.(*testdata.CreateTreeRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.CreateTree(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WalkTreeTool := RecursiveTestService_WalkTreeTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WalkTreeTool = runtime.TransformTool(WalkTreeTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WalkTreeTool = runtime.AddExtraPropertiesToTool(WalkTreeTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WalkTreeTool = runtime.BindFieldsToTool(WalkTreeTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WalkTreeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.CreateTreeRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/WalkTree", reqs, func(ctx context.Context, req any) (any, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream := client.WalkTree(ctx)
+			defer stream.CloseResponse()
+			runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+			return nil, runtime.Exchange(collector, req.([]*testdata.CreateTreeRequest), stream.Send, stream.CloseRequest, stream.Receive, cancel)
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		return collector.Result()
+	})
+}
+
+// ForwardToRecursiveTestServiceClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToRecursiveTestServiceClient(s *mcpserver.MCPServer, client RecursiveTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateTreeTool := RecursiveTestService_CreateTreeTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateTreeTool = runtime.TransformTool(CreateTreeTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateTreeTool = runtime.AddExtraPropertiesToTool(CreateTreeTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateTreeTool = runtime.BindFieldsToTool(CreateTreeTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateTreeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateTreeRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/CreateTree", &req, func(ctx context.Context, req any) (*testdata.CreateTreeResponse, error) {
+			return client.CreateTree(ctx, req.(*testdata.CreateTreeRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WalkTreeTool := RecursiveTestService_WalkTreeTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WalkTreeTool = runtime.TransformTool(WalkTreeTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WalkTreeTool = runtime.AddExtraPropertiesToTool(WalkTreeTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WalkTreeTool = runtime.BindFieldsToTool(WalkTreeTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(WalkTreeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.CreateTreeRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.RecursiveTestService/WalkTree", reqs, func(ctx context.Context, req any) (any, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.WalkTree(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return nil, runtime.Exchange(collector, req.([]*testdata.CreateTreeRequest), stream.Send, stream.CloseSend, stream.Recv, cancel)
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		return collector.Result()
+	})
+}
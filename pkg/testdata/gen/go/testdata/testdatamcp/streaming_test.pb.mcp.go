@@ -0,0 +1,1344 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/streaming_test.proto
+
+package testdatamcp
+
+import (
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+import (
+	"context"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"connectrpc.com/connect"
+	grpc "google.golang.org/grpc"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+)
+
+var (
+	StreamingTestService_SyncItemsTool = mcp.Tool{
+		Name:        "testdata_StreamingTestService_SyncItems",
+		Description: "SyncItems echoes the state of every item it receives\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the item to sync",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the synced item",
+            "type": "string"
+          },
+          "in_sync": {
+            "description": "Whether the item is in sync",
+            "type": "boolean"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	StreamingTestService_UploadItemsTool = mcp.Tool{
+		Name:        "testdata_StreamingTestService_UploadItems",
+		Description: "UploadItems uploads items in bulk\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the uploaded item",
+            "type": "string"
+          },
+          "name": {
+            "description": "Name of the uploaded item",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "ids": {
+      "description": "IDs of all uploaded items",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	StreamingTestService_WatchItemsTool = mcp.Tool{
+		Name:        "testdata_StreamingTestService_WatchItems",
+		Description: "WatchItems streams item events\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "count": {
+      "description": "Number of events to emit",
+      "type": "integer"
+    },
+    "prefix": {
+      "description": "Prefix of the item IDs to watch",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the changed item",
+            "type": "string"
+          },
+          "sequence": {
+            "description": "Sequence number of the event",
+            "type": "integer"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	StreamingTestService_SyncItemsToolOpenAI = mcp.Tool{
+		Name:        "testdata_StreamingTestService_SyncItems",
+		Description: "SyncItems echoes the state of every item it receives\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "additionalProperties": false,
+        "properties": {
+          "id": {
+            "description": "ID of the item to sync",
+            "type": "string"
+          }
+        },
+        "required": [
+          "id"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the synced item",
+            "type": "string"
+          },
+          "in_sync": {
+            "description": "Whether the item is in sync",
+            "type": "boolean"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	StreamingTestService_UploadItemsToolOpenAI = mcp.Tool{
+		Name:        "testdata_StreamingTestService_UploadItems",
+		Description: "UploadItems uploads items in bulk\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "additionalProperties": false,
+        "properties": {
+          "id": {
+            "description": "ID of the uploaded item",
+            "type": "string"
+          },
+          "name": {
+            "description": "Name of the uploaded item",
+            "type": "string"
+          }
+        },
+        "required": [
+          "id",
+          "name"
+        ],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "ids": {
+      "description": "IDs of all uploaded items",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	StreamingTestService_WatchItemsToolOpenAI = mcp.Tool{
+		Name:        "testdata_StreamingTestService_WatchItems",
+		Description: "WatchItems streams item events\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "count": {
+      "description": "Number of events to emit",
+      "type": "integer"
+    },
+    "prefix": {
+      "description": "Prefix of the item IDs to watch",
+      "type": "string"
+    }
+  },
+  "required": [
+    "count",
+    "prefix"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the changed item",
+            "type": "string"
+          },
+          "sequence": {
+            "description": "Sequence number of the event",
+            "type": "integer"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	StreamingTestService_SyncItemsToolGemini = mcp.Tool{
+		Name:        "testdata_StreamingTestService_SyncItems",
+		Description: "SyncItems echoes the state of every item it receives\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the item to sync",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the synced item",
+            "type": "string"
+          },
+          "in_sync": {
+            "description": "Whether the item is in sync",
+            "type": "boolean"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	StreamingTestService_UploadItemsToolGemini = mcp.Tool{
+		Name:        "testdata_StreamingTestService_UploadItems",
+		Description: "UploadItems uploads items in bulk\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "messages": {
+      "description": "Messages to send on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the uploaded item",
+            "type": "string"
+          },
+          "name": {
+            "description": "Name of the uploaded item",
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "ids": {
+      "description": "IDs of all uploaded items",
+      "items": {
+        "type": "string"
+      },
+      "type": "array"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	StreamingTestService_WatchItemsToolGemini = mcp.Tool{
+		Name:        "testdata_StreamingTestService_WatchItems",
+		Description: "WatchItems streams item events\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "count": {
+      "description": "Number of events to emit",
+      "type": "integer"
+    },
+    "prefix": {
+      "description": "Prefix of the item IDs to watch",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "dropped_messages": {
+      "description": "Number of earlier messages that were omitted from the result.",
+      "type": "integer"
+    },
+    "messages": {
+      "description": "Messages received on the stream, in order.",
+      "items": {
+        "properties": {
+          "id": {
+            "description": "ID of the changed item",
+            "type": "string"
+          },
+          "sequence": {
+            "description": "Sequence number of the event",
+            "type": "integer"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    }
+  },
+  "required": [
+    "messages"
+  ],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// StreamingTestServiceServer is compatible with the grpc-go server interface.
+type StreamingTestServiceServer interface {
+	SyncItems(stream grpc.BidiStreamingServer[testdata.SyncItemsRequest, testdata.SyncItemsResponse]) error
+	UploadItems(stream grpc.ClientStreamingServer[testdata.UploadItemsRequest, testdata.UploadItemsResponse]) error
+	WatchItems(req *testdata.WatchItemsRequest, stream grpc.ServerStreamingServer[testdata.WatchItemsResponse]) error
+}
+
+// RegisterStreamingTestServiceHandler registers standard MCP handlers for StreamingTestService
+func RegisterStreamingTestServiceHandler(s *mcpserver.MCPServer, srv StreamingTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	SyncItemsTool := StreamingTestService_SyncItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		SyncItemsTool = runtime.TransformTool(SyncItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SyncItemsTool = runtime.AddExtraPropertiesToTool(SyncItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SyncItemsTool = runtime.BindFieldsToTool(SyncItemsTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(SyncItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.SyncItemsRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/SyncItems", reqs, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.SyncItems(runtime.NewClientStream[testdata.SyncItemsRequest, testdata.SyncItemsResponse](ctx, req.([]*testdata.SyncItemsRequest), collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+	UploadItemsTool := StreamingTestService_UploadItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UploadItemsTool = runtime.TransformTool(UploadItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UploadItemsTool = runtime.AddExtraPropertiesToTool(UploadItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UploadItemsTool = runtime.BindFieldsToTool(UploadItemsTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(UploadItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.UploadItemsRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/UploadItems", reqs, func(ctx context.Context, req any) (*testdata.UploadItemsResponse, error) {
+			stream := runtime.NewClientStream[testdata.UploadItemsRequest, testdata.UploadItemsResponse](ctx, req.([]*testdata.UploadItemsRequest), nil)
+			if err := srv.UploadItems(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WatchItemsTool := StreamingTestService_WatchItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WatchItemsTool = runtime.TransformTool(WatchItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WatchItemsTool = runtime.AddExtraPropertiesToTool(WatchItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WatchItemsTool = runtime.BindFieldsToTool(WatchItemsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(WatchItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.WatchItemsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/WatchItems", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.WatchItems(req.(*testdata.WatchItemsRequest), runtime.NewServerStream[testdata.WatchItemsResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+}
+
+// RegisterStreamingTestServiceHandlerOpenAI registers OpenAI-compatible MCP handlers for StreamingTestService
+func RegisterStreamingTestServiceHandlerOpenAI(s *mcpserver.MCPServer, srv StreamingTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	SyncItemsToolOpenAI := StreamingTestService_SyncItemsToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SyncItemsToolOpenAI = runtime.AddExtraPropertiesToTool(SyncItemsToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SyncItemsToolOpenAI = runtime.BindFieldsToTool(SyncItemsToolOpenAI, config.FieldBindings, true)
+	}
+
+	s.AddTool(SyncItemsToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.SyncItemsRequest](message, runtime.LLMProviderOpenAI)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/SyncItems", reqs, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.SyncItems(runtime.NewClientStream[testdata.SyncItemsRequest, testdata.SyncItemsResponse](ctx, req.([]*testdata.SyncItemsRequest), collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+	UploadItemsToolOpenAI := StreamingTestService_UploadItemsToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UploadItemsToolOpenAI = runtime.AddExtraPropertiesToTool(UploadItemsToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UploadItemsToolOpenAI = runtime.BindFieldsToTool(UploadItemsToolOpenAI, config.FieldBindings, true)
+	}
+
+	s.AddTool(UploadItemsToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.UploadItemsRequest](message, runtime.LLMProviderOpenAI)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/UploadItems", reqs, func(ctx context.Context, req any) (*testdata.UploadItemsResponse, error) {
+			stream := runtime.NewClientStream[testdata.UploadItemsRequest, testdata.UploadItemsResponse](ctx, req.([]*testdata.UploadItemsRequest), nil)
+			if err := srv.UploadItems(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WatchItemsToolOpenAI := StreamingTestService_WatchItemsToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WatchItemsToolOpenAI = runtime.AddExtraPropertiesToTool(WatchItemsToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WatchItemsToolOpenAI = runtime.BindFieldsToTool(WatchItemsToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(WatchItemsToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.WatchItemsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/WatchItems", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.WatchItems(req.(*testdata.WatchItemsRequest), runtime.NewServerStream[testdata.WatchItemsResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+}
+
+// RegisterStreamingTestServiceHandlerGemini registers Gemini-compatible MCP handlers for StreamingTestService
+func RegisterStreamingTestServiceHandlerGemini(s *mcpserver.MCPServer, srv StreamingTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	SyncItemsToolGemini := StreamingTestService_SyncItemsToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SyncItemsToolGemini = runtime.AddExtraPropertiesToTool(SyncItemsToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SyncItemsToolGemini = runtime.BindFieldsToTool(SyncItemsToolGemini, config.FieldBindings, true)
+	}
+
+	s.AddTool(SyncItemsToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.SyncItemsRequest](message, runtime.LLMProviderGemini)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/SyncItems", reqs, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.SyncItems(runtime.NewClientStream[testdata.SyncItemsRequest, testdata.SyncItemsResponse](ctx, req.([]*testdata.SyncItemsRequest), collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+	UploadItemsToolGemini := StreamingTestService_UploadItemsToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UploadItemsToolGemini = runtime.AddExtraPropertiesToTool(UploadItemsToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UploadItemsToolGemini = runtime.BindFieldsToTool(UploadItemsToolGemini, config.FieldBindings, true)
+	}
+
+	s.AddTool(UploadItemsToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.UploadItemsRequest](message, runtime.LLMProviderGemini)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/UploadItems", reqs, func(ctx context.Context, req any) (*testdata.UploadItemsResponse, error) {
+			stream := runtime.NewClientStream[testdata.UploadItemsRequest, testdata.UploadItemsResponse](ctx, req.([]*testdata.UploadItemsRequest), nil)
+			if err := srv.UploadItems(stream); err != nil {
+				return nil, err
+			}
+			return stream.Response(), nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WatchItemsToolGemini := StreamingTestService_WatchItemsToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WatchItemsToolGemini = runtime.AddExtraPropertiesToTool(WatchItemsToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WatchItemsToolGemini = runtime.BindFieldsToTool(WatchItemsToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(WatchItemsToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.WatchItemsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/WatchItems", &req, func(ctx context.Context, req any) (any, error) {
+			return nil, srv.WatchItems(req.(*testdata.WatchItemsRequest), runtime.NewServerStream[testdata.WatchItemsResponse](ctx, collector))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		return collector.Result()
+	})
+}
+
+// RegisterStreamingTestServiceHandlerWithProvider registers handlers for the specified LLM provider
+func RegisterStreamingTestServiceHandlerWithProvider(s *mcpserver.MCPServer, srv StreamingTestServiceServer, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterStreamingTestServiceHandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterStreamingTestServiceHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterStreamingTestServiceHandler(s, srv, opts...)
+	}
+}
+
+// StreamingTestServiceClient is compatible with the grpc-go client interface.
+type StreamingTestServiceClient interface {
+	SyncItems(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[testdata.SyncItemsRequest, testdata.SyncItemsResponse], error)
+	UploadItems(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[testdata.UploadItemsRequest, testdata.UploadItemsResponse], error)
+	WatchItems(ctx context.Context, req *testdata.WatchItemsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[testdata.WatchItemsResponse], error)
+}
+
+// ConnectStreamingTestServiceClient is compatible with the connectrpc-go client interface.
+type ConnectStreamingTestServiceClient interface {
+	SyncItems(ctx context.Context) *connect.BidiStreamForClient[testdata.SyncItemsRequest, testdata.SyncItemsResponse]
+	UploadItems(ctx context.Context) *connect.ClientStreamForClient[testdata.UploadItemsRequest, testdata.UploadItemsResponse]
+	WatchItems(ctx context.Context, req *connect.Request[testdata.WatchItemsRequest]) (*connect.ServerStreamForClient[testdata.WatchItemsResponse], error)
+}
+
+// ForwardToConnectStreamingTestServiceClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectStreamingTestServiceClient(s *mcpserver.MCPServer, client ConnectStreamingTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	SyncItemsTool := StreamingTestService_SyncItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		SyncItemsTool = runtime.TransformTool(SyncItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SyncItemsTool = runtime.AddExtraPropertiesToTool(SyncItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SyncItemsTool = runtime.BindFieldsToTool(SyncItemsTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(SyncItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.SyncItemsRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/SyncItems", reqs, func(ctx context.Context, req any) (any, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream := client.SyncItems(ctx)
+			defer stream.CloseResponse()
+			runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+			return nil, runtime.Exchange(collector, req.([]*testdata.SyncItemsRequest), stream.Send, stream.CloseRequest, stream.Receive, cancel)
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		return collector.Result()
+	})
+	UploadItemsTool := StreamingTestService_UploadItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UploadItemsTool = runtime.TransformTool(UploadItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UploadItemsTool = runtime.AddExtraPropertiesToTool(UploadItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UploadItemsTool = runtime.BindFieldsToTool(UploadItemsTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(UploadItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.UploadItemsRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/UploadItems", reqs, func(ctx context.Context, req any) (*testdata.UploadItemsResponse, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream := client.UploadItems(ctx)
+			runtime.SetConnectHeaders(ctx, stream.RequestHeader())
+			if err := runtime.SendAll(req.([]*testdata.UploadItemsRequest), stream.Send); err != nil {
+				return nil, err
+			}
+			resp, err := stream.CloseAndReceive()
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WatchItemsTool := StreamingTestService_WatchItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WatchItemsTool = runtime.TransformTool(WatchItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WatchItemsTool = runtime.AddExtraPropertiesToTool(WatchItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WatchItemsTool = runtime.BindFieldsToTool(WatchItemsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(WatchItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.WatchItemsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/WatchItems", &req, func(ctx context.Context, req any) (any, error) {
+			connectReq := connect.NewRequest(req.(*testdata.WatchItemsRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			stream, err := client.WatchItems(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			defer stream.Close()
+
+			for stream.Receive() {
+				if err := collector.Add(stream.Msg()); err != nil {
+					return nil, err
+				}
+			}
+			return nil, stream.Err()
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		return collector.Result()
+	})
+}
+
+// ForwardToStreamingTestServiceClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToStreamingTestServiceClient(s *mcpserver.MCPServer, client StreamingTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	SyncItemsTool := StreamingTestService_SyncItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		SyncItemsTool = runtime.TransformTool(SyncItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SyncItemsTool = runtime.AddExtraPropertiesToTool(SyncItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SyncItemsTool = runtime.BindFieldsToTool(SyncItemsTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(SyncItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.SyncItemsRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/SyncItems", reqs, func(ctx context.Context, req any) (any, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.SyncItems(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return nil, runtime.Exchange(collector, req.([]*testdata.SyncItemsRequest), stream.Send, stream.CloseSend, stream.Recv, cancel)
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		return collector.Result()
+	})
+	UploadItemsTool := StreamingTestService_UploadItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		UploadItemsTool = runtime.TransformTool(UploadItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		UploadItemsTool = runtime.AddExtraPropertiesToTool(UploadItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		UploadItemsTool = runtime.BindFieldsToTool(UploadItemsTool, config.FieldBindings, true)
+	}
+
+	s.AddTool(UploadItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		reqs, err := runtime.UnmarshalStreamRequests[testdata.UploadItemsRequest](message, provider)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindStreamFields(ctx, config, request, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.ValidateStream(config, reqs); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/UploadItems", reqs, func(ctx context.Context, req any) (*testdata.UploadItemsResponse, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.UploadItems(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err := runtime.SendAll(req.([]*testdata.UploadItemsRequest), stream.Send); err != nil {
+				return nil, err
+			}
+			return stream.CloseAndRecv()
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+	WatchItemsTool := StreamingTestService_WatchItemsTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		WatchItemsTool = runtime.TransformTool(WatchItemsTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		WatchItemsTool = runtime.AddExtraPropertiesToTool(WatchItemsTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		WatchItemsTool = runtime.BindFieldsToTool(WatchItemsTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(WatchItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.WatchItemsRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		collector := runtime.NewStreamCollector(ctx, request, config)
+		_, err = runtime.Intercept(ctx, config, request, "/testdata.StreamingTestService/WatchItems", &req, func(ctx context.Context, req any) (any, error) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := client.WatchItems(ctx, req.(*testdata.WatchItemsRequest))
+			if err != nil {
+				return nil, err
+			}
+			return nil, runtime.ReceiveAll(collector, stream.Recv)
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		return collector.Result()
+	})
+}
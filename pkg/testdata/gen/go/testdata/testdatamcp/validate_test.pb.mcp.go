@@ -0,0 +1,643 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/validate_test.proto
+
+package testdatamcp
+
+import (
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+import (
+	"context"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"connectrpc.com/connect"
+	grpc "google.golang.org/grpc"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+)
+
+var (
+	ValidateTestService_CreateUserTool = mcp.Tool{
+		Name:        "testdata_ValidateTestService_CreateUser",
+		Description: "CreateUser creates a user\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "age": {
+      "exclusiveMaximum": 150,
+      "minimum": 0,
+      "type": "integer"
+    },
+    "default_role": {
+      "enum": [
+        "ROLE_VIEWER",
+        "ROLE_EDITOR"
+      ],
+      "type": "string"
+    },
+    "email": {
+      "format": "email",
+      "type": "string"
+    },
+    "handle": {
+      "pattern": "^[a-z][a-z0-9_]*$",
+      "type": "string"
+    },
+    "id": {
+      "format": "uuid",
+      "type": "string"
+    },
+    "name": {
+      "maxLength": 64,
+      "minLength": 1,
+      "type": "string"
+    },
+    "quota": {
+      "description": "Must be greater than or equal to 1.",
+      "type": "string"
+    },
+    "ratio": {
+      "description": "Must be greater than -Inf. Must not be one of NaN.",
+      "maximum": 1,
+      "type": "number"
+    },
+    "role": {
+      "enum": [
+        "ROLE_VIEWER",
+        "ROLE_EDITOR",
+        "ROLE_ADMIN"
+      ],
+      "type": "string"
+    },
+    "score": {
+      "exclusiveMinimum": 0,
+      "maximum": 1,
+      "type": "number"
+    },
+    "tags": {
+      "items": {
+        "maxLength": 32,
+        "type": "string"
+      },
+      "maxItems": 10,
+      "minItems": 1,
+      "type": "array",
+      "uniqueItems": true
+    },
+    "website": {
+      "format": "uri",
+      "type": "string"
+    }
+  },
+  "required": [
+    "email"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ValidateTestService_CreateUserToolOpenAI = mcp.Tool{
+		Name:        "testdata_ValidateTestService_CreateUser",
+		Description: "CreateUser creates a user\n",
+		RawInputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "age": {
+      "exclusiveMaximum": 150,
+      "minimum": 0,
+      "type": "integer"
+    },
+    "default_role": {
+      "enum": [
+        "ROLE_VIEWER",
+        "ROLE_EDITOR"
+      ],
+      "type": "string"
+    },
+    "email": {
+      "format": "email",
+      "type": "string"
+    },
+    "handle": {
+      "pattern": "^[a-z][a-z0-9_]*$",
+      "type": "string"
+    },
+    "id": {
+      "format": "uuid",
+      "type": "string"
+    },
+    "name": {
+      "description": "Must be at least 1 characters long. Must be at most 64 characters long.",
+      "type": "string"
+    },
+    "quota": {
+      "description": "Must be greater than or equal to 1.",
+      "type": "string"
+    },
+    "ratio": {
+      "description": "Must be greater than -Inf. Must not be one of NaN.",
+      "maximum": 1,
+      "type": "number"
+    },
+    "role": {
+      "enum": [
+        "ROLE_VIEWER",
+        "ROLE_EDITOR",
+        "ROLE_ADMIN"
+      ],
+      "type": "string"
+    },
+    "score": {
+      "exclusiveMinimum": 0,
+      "maximum": 1,
+      "type": "number"
+    },
+    "tags": {
+      "description": "Items must be unique.",
+      "items": {
+        "description": "Must be at most 32 characters long.",
+        "type": "string"
+      },
+      "maxItems": 10,
+      "minItems": 1,
+      "type": "array"
+    },
+    "website": {
+      "description": "Must be a valid absolute URI.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "email",
+    "age",
+    "default_role",
+    "handle",
+    "id",
+    "name",
+    "quota",
+    "ratio",
+    "role",
+    "score",
+    "tags",
+    "website"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+	ValidateTestService_CreateUserToolGemini = mcp.Tool{
+		Name:        "testdata_ValidateTestService_CreateUser",
+		Description: "CreateUser creates a user\n",
+		RawInputSchema: json.RawMessage(`{
+  "properties": {
+    "age": {
+      "description": "Must be less than 150.",
+      "minimum": 0,
+      "type": "integer"
+    },
+    "default_role": {
+      "enum": [
+        "ROLE_VIEWER",
+        "ROLE_EDITOR"
+      ],
+      "type": "string"
+    },
+    "email": {
+      "description": "Must be a valid email address.",
+      "type": "string"
+    },
+    "handle": {
+      "pattern": "^[a-z][a-z0-9_]*$",
+      "type": "string"
+    },
+    "id": {
+      "description": "Must be a valid UUID.",
+      "type": "string"
+    },
+    "name": {
+      "maxLength": 64,
+      "minLength": 1,
+      "type": "string"
+    },
+    "quota": {
+      "description": "Must be greater than or equal to 1.",
+      "type": "string"
+    },
+    "ratio": {
+      "description": "Must be greater than -Inf. Must not be one of NaN.",
+      "maximum": 1,
+      "type": "number"
+    },
+    "role": {
+      "enum": [
+        "ROLE_VIEWER",
+        "ROLE_EDITOR",
+        "ROLE_ADMIN"
+      ],
+      "type": "string"
+    },
+    "score": {
+      "description": "Must be greater than 0.",
+      "maximum": 1,
+      "type": "number"
+    },
+    "tags": {
+      "description": "Items must be unique.",
+      "items": {
+        "maxLength": 32,
+        "type": "string"
+      },
+      "maxItems": 10,
+      "minItems": 1,
+      "type": "array"
+    },
+    "website": {
+      "description": "Must be a valid absolute URI.",
+      "type": "string"
+    }
+  },
+  "required": [
+    "email"
+  ],
+  "type": "object"
+}`),
+		RawOutputSchema: json.RawMessage(`{
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: mcp.ToolAnnotation{Title: "", ReadOnlyHint: (*bool)(nil), DestructiveHint: (*bool)(nil), IdempotentHint: (*bool)(nil), OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// ValidateTestServiceServer is compatible with the grpc-go server interface.
+type ValidateTestServiceServer interface {
+	CreateUser(ctx context.Context, req *testdata.CreateUserRequest) (*testdata.CreateUserResponse, error)
+}
+
+// RegisterValidateTestServiceHandler registers standard MCP handlers for ValidateTestService
+func RegisterValidateTestServiceHandler(s *mcpserver.MCPServer, srv ValidateTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateUserTool := ValidateTestService_CreateUserTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateUserTool = runtime.TransformTool(CreateUserTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateUserTool = runtime.AddExtraPropertiesToTool(CreateUserTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateUserTool = runtime.BindFieldsToTool(CreateUserTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateUserTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateUserRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.ValidateTestService/CreateUser", &req, func(ctx context.Context, req any) (*testdata.CreateUserResponse, error) {
+			return srv.CreateUser(ctx, req.(*testdata.CreateUserRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterValidateTestServiceHandlerOpenAI registers OpenAI-compatible MCP handlers for ValidateTestService
+func RegisterValidateTestServiceHandlerOpenAI(s *mcpserver.MCPServer, srv ValidateTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CreateUserToolOpenAI := ValidateTestService_CreateUserToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateUserToolOpenAI = runtime.AddExtraPropertiesToTool(CreateUserToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateUserToolOpenAI = runtime.BindFieldsToTool(CreateUserToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateUserToolOpenAI, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateUserRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.ValidateTestService/CreateUser", &req, func(ctx context.Context, req any) (*testdata.CreateUserResponse, error) {
+			return srv.CreateUser(ctx, req.(*testdata.CreateUserRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterValidateTestServiceHandlerGemini registers Gemini-compatible MCP handlers for ValidateTestService
+func RegisterValidateTestServiceHandlerGemini(s *mcpserver.MCPServer, srv ValidateTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	CreateUserToolGemini := ValidateTestService_CreateUserToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateUserToolGemini = runtime.AddExtraPropertiesToTool(CreateUserToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateUserToolGemini = runtime.BindFieldsToTool(CreateUserToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateUserToolGemini, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateUserRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.ValidateTestService/CreateUser", &req, func(ctx context.Context, req any) (*testdata.CreateUserResponse, error) {
+			return srv.CreateUser(ctx, req.(*testdata.CreateUserRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// RegisterValidateTestServiceHandlerWithProvider registers handlers for the specified LLM provider
+func RegisterValidateTestServiceHandlerWithProvider(s *mcpserver.MCPServer, srv ValidateTestServiceServer, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterValidateTestServiceHandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterValidateTestServiceHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterValidateTestServiceHandler(s, srv, opts...)
+	}
+}
+
+// ValidateTestServiceClient is compatible with the grpc-go client interface.
+type ValidateTestServiceClient interface {
+	CreateUser(ctx context.Context, req *testdata.CreateUserRequest, opts ...grpc.CallOption) (*testdata.CreateUserResponse, error)
+}
+
+// ConnectValidateTestServiceClient is compatible with the connectrpc-go client interface.
+type ConnectValidateTestServiceClient interface {
+	CreateUser(ctx context.Context, req *connect.Request[testdata.CreateUserRequest]) (*connect.Response[testdata.CreateUserResponse], error)
+}
+
+// ForwardToConnectValidateTestServiceClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectValidateTestServiceClient(s *mcpserver.MCPServer, client ConnectValidateTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateUserTool := ValidateTestService_CreateUserTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateUserTool = runtime.TransformTool(CreateUserTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateUserTool = runtime.AddExtraPropertiesToTool(CreateUserTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateUserTool = runtime.BindFieldsToTool(CreateUserTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateUserTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateUserRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.ValidateTestService/CreateUser", &req, func(ctx context.Context, req any) (*testdata.CreateUserResponse, error) {
+			connectReq := connect.NewRequest(req.(*testdata.CreateUserRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.CreateUser(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
+
+// ForwardToValidateTestServiceClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToValidateTestServiceClient(s *mcpserver.MCPServer, client ValidateTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	CreateUserTool := ValidateTestService_CreateUserTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		CreateUserTool = runtime.TransformTool(CreateUserTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		CreateUserTool = runtime.AddExtraPropertiesToTool(CreateUserTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		CreateUserTool = runtime.BindFieldsToTool(CreateUserTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(CreateUserTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.CreateUserRequest
+
+		message := request.GetArguments()
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadata(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		resp, err := runtime.Intercept(ctx, config, request, "/testdata.ValidateTestService/CreateUser", &req, func(ctx context.Context, req any) (*testdata.CreateUserResponse, error) {
+			return client.CreateUser(ctx, req.(*testdata.CreateUserRequest))
+		})
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultStructured(json.RawMessage(marshaled), string(marshaled)), nil
+	})
+}
@@ -0,0 +1,1338 @@
+// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: testdata/pagination_test.proto
+
+package testdatamcpgosdk
+
+import (
+	testdata "github.com/statico/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+import (
+	"context"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"connectrpc.com/connect"
+	grpc "google.golang.org/grpc"
+	"github.com/statico/protoc-gen-go-mcp/pkg/runtime"
+)
+
+var (
+	PaginationTestService_ListShelvesTool = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_ListShelves",
+		Description: "ListShelves lists shelves\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "filter": {
+      "type": "string"
+    },
+    "page_size": {
+      "description": "Maximum number of shelves to return",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "Page token of a previous call",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	PaginationTestService_ListShelvesAllTool = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_ListShelvesAll",
+		Description: "ListShelves lists shelves\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "filter": {
+      "type": "string"
+    },
+    "page_size": {
+      "description": "Maximum number of shelves to return",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "Page token of a previous call",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	PaginationTestService_SearchShelvesTool = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_SearchShelves",
+		Description: "SearchShelves has no page size, so it is not an AIP-158 list method\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "page_token": {
+      "type": "string"
+    },
+    "query": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	PaginationTestService_ListShelvesToolOpenAI = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_ListShelves",
+		Description: "ListShelves lists shelves\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "filter": {
+      "type": "string"
+    },
+    "page_size": {
+      "description": "Maximum number of shelves to return",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "Page token of a previous call",
+      "type": "string"
+    }
+  },
+  "required": [
+    "filter",
+    "page_size",
+    "page_token"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	PaginationTestService_ListShelvesAllToolOpenAI = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_ListShelvesAll",
+		Description: "ListShelves lists shelves\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "filter": {
+      "type": "string"
+    },
+    "page_size": {
+      "description": "Maximum number of shelves to return",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "Page token of a previous call",
+      "type": "string"
+    }
+  },
+  "required": [
+    "filter",
+    "page_size",
+    "page_token"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	PaginationTestService_SearchShelvesToolOpenAI = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_SearchShelves",
+		Description: "SearchShelves has no page size, so it is not an AIP-158 list method\n",
+		InputSchema: json.RawMessage(`{
+  "additionalProperties": false,
+  "properties": {
+    "page_token": {
+      "type": "string"
+    },
+    "query": {
+      "type": "string"
+    }
+  },
+  "required": [
+    "page_token",
+    "query"
+  ],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	PaginationTestService_ListShelvesToolGemini = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_ListShelves",
+		Description: "ListShelves lists shelves\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "filter": {
+      "type": "string"
+    },
+    "page_size": {
+      "description": "Maximum number of shelves to return",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "Page token of a previous call",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	PaginationTestService_ListShelvesAllToolGemini = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_ListShelvesAll",
+		Description: "ListShelves lists shelves\n\nFollows next_page_token and returns the items of all pages, up to a budget. If next_page_token is set in the result, call again with it as page_token to list the remaining items.",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "filter": {
+      "type": "string"
+    },
+    "page_size": {
+      "description": "Maximum number of shelves to return",
+      "type": "integer"
+    },
+    "page_token": {
+      "description": "Page token of a previous call",
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+	PaginationTestService_SearchShelvesToolGemini = &mcp.Tool{
+		Name:        "testdata_PaginationTestService_SearchShelves",
+		Description: "SearchShelves has no page size, so it is not an AIP-158 list method\n",
+		InputSchema: json.RawMessage(`{
+  "properties": {
+    "page_token": {
+      "type": "string"
+    },
+    "query": {
+      "type": "string"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		OutputSchema: json.RawMessage(`{
+  "properties": {
+    "next_page_token": {
+      "description": "Token of the next page, empty on the last page",
+      "type": "string"
+    },
+    "shelves": {
+      "items": {
+        "properties": {
+          "name": {
+            "type": "string"
+          },
+          "theme": {
+            "type": "string"
+          }
+        },
+        "required": [],
+        "type": "object"
+      },
+      "type": "array"
+    },
+    "total_size": {
+      "type": "integer"
+    }
+  },
+  "required": [],
+  "type": "object"
+}`),
+		Annotations: &mcp.ToolAnnotations{Title: "", ReadOnlyHint: false, DestructiveHint: (*bool)(nil), IdempotentHint: false, OpenWorldHint: (*bool)(nil)},
+	}
+)
+
+// PaginationTestServiceServer is compatible with the grpc-go server interface.
+type PaginationTestServiceServer interface {
+	ListShelves(ctx context.Context, req *testdata.ListShelvesRequest) (*testdata.ListShelvesResponse, error)
+	SearchShelves(ctx context.Context, req *testdata.SearchShelvesRequest) (*testdata.ListShelvesResponse, error)
+}
+
+// RegisterPaginationTestServiceHandler registers standard MCP handlers for PaginationTestService
+func RegisterPaginationTestServiceHandler(s *mcp.Server, srv PaginationTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	ListShelvesTool := PaginationTestService_ListShelvesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListShelvesTool = runtime.TransformToolGoSDK(ListShelvesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesTool = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListShelvesTool = runtime.BindFieldsToToolGoSDK(ListShelvesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return srv.ListShelves(ctx, req.(*testdata.ListShelvesRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListShelvesAllTool follows the pages of ListShelves
+	ListShelvesAllTool := PaginationTestService_ListShelvesAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListShelvesAllTool = runtime.TransformToolGoSDK(ListShelvesAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesAllTool = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListShelvesAllTool = runtime.BindFieldsToToolGoSDK(ListShelvesAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesAllTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*testdata.ListShelvesRequest), "shelves", srv.ListShelves)
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	SearchShelvesTool := PaginationTestService_SearchShelvesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		SearchShelvesTool = runtime.TransformToolGoSDK(SearchShelvesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SearchShelvesTool = runtime.AddExtraPropertiesToToolGoSDK(SearchShelvesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SearchShelvesTool = runtime.BindFieldsToToolGoSDK(SearchShelvesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(SearchShelvesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.SearchShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/SearchShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return srv.SearchShelves(ctx, req.(*testdata.SearchShelvesRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterPaginationTestServiceHandlerOpenAI registers OpenAI-compatible MCP handlers for PaginationTestService
+func RegisterPaginationTestServiceHandlerOpenAI(s *mcp.Server, srv PaginationTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	ListShelvesToolOpenAI := PaginationTestService_ListShelvesToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListShelvesToolOpenAI = runtime.BindFieldsToToolGoSDK(ListShelvesToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return srv.ListShelves(ctx, req.(*testdata.ListShelvesRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListShelvesAllToolOpenAI follows the pages of ListShelves
+	ListShelvesAllToolOpenAI := PaginationTestService_ListShelvesAllToolOpenAI
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesAllToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesAllToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListShelvesAllToolOpenAI = runtime.BindFieldsToToolGoSDK(ListShelvesAllToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesAllToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*testdata.ListShelvesRequest), "shelves", srv.ListShelves)
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	SearchShelvesToolOpenAI := PaginationTestService_SearchShelvesToolOpenAI
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SearchShelvesToolOpenAI = runtime.AddExtraPropertiesToToolGoSDK(SearchShelvesToolOpenAI, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderOpenAI)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SearchShelvesToolOpenAI = runtime.BindFieldsToToolGoSDK(SearchShelvesToolOpenAI, config.FieldBindings, false)
+	}
+
+	s.AddTool(SearchShelvesToolOpenAI, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.SearchShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderOpenAI, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/SearchShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return srv.SearchShelves(ctx, req.(*testdata.SearchShelvesRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterPaginationTestServiceHandlerGemini registers Gemini-compatible MCP handlers for PaginationTestService
+func RegisterPaginationTestServiceHandlerGemini(s *mcp.Server, srv PaginationTestServiceServer, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	ListShelvesToolGemini := PaginationTestService_ListShelvesToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesToolGemini = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListShelvesToolGemini = runtime.BindFieldsToToolGoSDK(ListShelvesToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return srv.ListShelves(ctx, req.(*testdata.ListShelvesRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListShelvesAllToolGemini follows the pages of ListShelves
+	ListShelvesAllToolGemini := PaginationTestService_ListShelvesAllToolGemini
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesAllToolGemini = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesAllToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListShelvesAllToolGemini = runtime.BindFieldsToToolGoSDK(ListShelvesAllToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesAllToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*testdata.ListShelvesRequest), "shelves", srv.ListShelves)
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	SearchShelvesToolGemini := PaginationTestService_SearchShelvesToolGemini
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SearchShelvesToolGemini = runtime.AddExtraPropertiesToToolGoSDK(SearchShelvesToolGemini, config.ExtraProperties, runtime.SchemaTransforms(runtime.LLMProviderGemini)...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SearchShelvesToolGemini = runtime.BindFieldsToToolGoSDK(SearchShelvesToolGemini, config.FieldBindings, false)
+	}
+
+	s.AddTool(SearchShelvesToolGemini, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.SearchShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		runtime.Fix(runtime.LLMProviderGemini, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/SearchShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return srv.SearchShelves(ctx, req.(*testdata.SearchShelvesRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// RegisterPaginationTestServiceHandlerWithProvider registers handlers for the specified LLM provider
+func RegisterPaginationTestServiceHandlerWithProvider(s *mcp.Server, srv PaginationTestServiceServer, provider runtime.LLMProvider, opts ...runtime.Option) {
+	switch provider {
+	case runtime.LLMProviderOpenAI:
+		RegisterPaginationTestServiceHandlerOpenAI(s, srv, opts...)
+	case runtime.LLMProviderGemini:
+		RegisterPaginationTestServiceHandlerGemini(s, srv, opts...)
+	case runtime.LLMProviderStandard, runtime.LLMProviderAnthropic, runtime.LLMProviderCustom:
+		fallthrough
+	default:
+		RegisterPaginationTestServiceHandler(s, srv, opts...)
+	}
+}
+
+// PaginationTestServiceClient is compatible with the grpc-go client interface.
+type PaginationTestServiceClient interface {
+	ListShelves(ctx context.Context, req *testdata.ListShelvesRequest, opts ...grpc.CallOption) (*testdata.ListShelvesResponse, error)
+	SearchShelves(ctx context.Context, req *testdata.SearchShelvesRequest, opts ...grpc.CallOption) (*testdata.ListShelvesResponse, error)
+}
+
+// ConnectPaginationTestServiceClient is compatible with the connectrpc-go client interface.
+type ConnectPaginationTestServiceClient interface {
+	ListShelves(ctx context.Context, req *connect.Request[testdata.ListShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error)
+	SearchShelves(ctx context.Context, req *connect.Request[testdata.SearchShelvesRequest]) (*connect.Response[testdata.ListShelvesResponse], error)
+}
+
+// ForwardToConnectPaginationTestServiceClient registers a connectrpc client, to forward MCP calls to it.
+func ForwardToConnectPaginationTestServiceClient(s *mcp.Server, client ConnectPaginationTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	ListShelvesTool := PaginationTestService_ListShelvesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListShelvesTool = runtime.TransformToolGoSDK(ListShelvesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesTool = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListShelvesTool = runtime.BindFieldsToToolGoSDK(ListShelvesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			connectReq := connect.NewRequest(req.(*testdata.ListShelvesRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.ListShelves(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListShelvesAllTool follows the pages of ListShelves
+	ListShelvesAllTool := PaginationTestService_ListShelvesAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListShelvesAllTool = runtime.TransformToolGoSDK(ListShelvesAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesAllTool = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListShelvesAllTool = runtime.BindFieldsToToolGoSDK(ListShelvesAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesAllTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*testdata.ListShelvesRequest), "shelves", func(ctx context.Context, req *testdata.ListShelvesRequest) (*testdata.ListShelvesResponse, error) {
+				connectReq := connect.NewRequest(req)
+				runtime.SetConnectHeaders(ctx, connectReq.Header())
+				resp, err := client.ListShelves(ctx, connectReq)
+				if err != nil {
+					return nil, err
+				}
+				return resp.Msg, nil
+			})
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	SearchShelvesTool := PaginationTestService_SearchShelvesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		SearchShelvesTool = runtime.TransformToolGoSDK(SearchShelvesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SearchShelvesTool = runtime.AddExtraPropertiesToToolGoSDK(SearchShelvesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SearchShelvesTool = runtime.BindFieldsToToolGoSDK(SearchShelvesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(SearchShelvesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.SearchShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/SearchShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			connectReq := connect.NewRequest(req.(*testdata.SearchShelvesRequest))
+			runtime.SetConnectHeaders(ctx, connectReq.Header())
+			resp, err := client.SearchShelves(ctx, connectReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Msg, nil
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
+
+// ForwardToPaginationTestServiceClient registers a gRPC client, to forward MCP calls to it.
+func ForwardToPaginationTestServiceClient(s *mcp.Server, client PaginationTestServiceClient, opts ...runtime.Option) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	provider := runtime.LLMProviderStandard
+	if len(config.SchemaTransforms) > 0 {
+		provider = runtime.LLMProviderCustom
+	}
+	ListShelvesTool := PaginationTestService_ListShelvesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		ListShelvesTool = runtime.TransformToolGoSDK(ListShelvesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesTool = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		ListShelvesTool = runtime.BindFieldsToToolGoSDK(ListShelvesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return client.ListShelves(ctx, req.(*testdata.ListShelvesRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+
+	// ListShelvesAllTool follows the pages of ListShelves
+	ListShelvesAllTool := PaginationTestService_ListShelvesAllTool
+	if len(config.SchemaTransforms) > 0 {
+		ListShelvesAllTool = runtime.TransformToolGoSDK(ListShelvesAllTool, config.SchemaTransforms)
+	}
+	if len(config.ExtraProperties) > 0 {
+		ListShelvesAllTool = runtime.AddExtraPropertiesToToolGoSDK(ListShelvesAllTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	if len(config.FieldBindings) > 0 {
+		ListShelvesAllTool = runtime.BindFieldsToToolGoSDK(ListShelvesAllTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(ListShelvesAllTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.ListShelvesRequest
+		message := runtime.ArgumentsGoSDK(request)
+
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/ListShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return runtime.ListAll(ctx, config, req.(*testdata.ListShelvesRequest), "shelves", func(ctx context.Context, req *testdata.ListShelvesRequest) (*testdata.ListShelvesResponse, error) {
+				return client.ListShelves(ctx, req)
+			})
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+	SearchShelvesTool := PaginationTestService_SearchShelvesTool
+	// Rewrite the schema if schema transforms are configured
+	if len(config.SchemaTransforms) > 0 {
+		SearchShelvesTool = runtime.TransformToolGoSDK(SearchShelvesTool, config.SchemaTransforms)
+	}
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		SearchShelvesTool = runtime.AddExtraPropertiesToToolGoSDK(SearchShelvesTool, config.ExtraProperties, config.SchemaTransforms...)
+	}
+	// Remove bound fields from schema, they are set from the session
+	if len(config.FieldBindings) > 0 {
+		SearchShelvesTool = runtime.BindFieldsToToolGoSDK(SearchShelvesTool, config.FieldBindings, false)
+	}
+
+	s.AddTool(SearchShelvesTool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var req testdata.SearchShelvesRequest
+
+		message := runtime.ArgumentsGoSDK(request)
+
+		// Extract extra properties if configured
+		ctx, err := runtime.ExtractExtraProperties(ctx, config, message)
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+		// Forward the allowed metadata of the tool call to the backend
+		ctx = runtime.ForwardMetadataGoSDK(ctx, config, request)
+
+		runtime.Fix(provider, req.ProtoReflect().Descriptor(), message)
+
+		if err := runtime.UnmarshalArguments(message, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.BindFields(ctx, config, request, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		if err := runtime.Validate(config, &req); err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		resp, err := runtime.InterceptGoSDK(ctx, config, request, "/testdata.PaginationTestService/SearchShelves", &req, func(ctx context.Context, req any) (*testdata.ListShelvesResponse, error) {
+			return client.SearchShelves(ctx, req.(*testdata.SearchShelvesRequest))
+		})
+		if err != nil {
+			return runtime.HandleErrorGoSDK(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.StructuredResultGoSDK(marshaled), nil
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/validate_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ValidateTestService_CreateUser_FullMethodName = "/testdata.ValidateTestService/CreateUser"
+)
+
+// ValidateTestServiceClient is the client API for ValidateTestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ValidateTestService provides operations with validated requests
+type ValidateTestServiceClient interface {
+	// CreateUser creates a user
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+}
+
+type validateTestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidateTestServiceClient(cc grpc.ClientConnInterface) ValidateTestServiceClient {
+	return &validateTestServiceClient{cc}
+}
+
+func (c *validateTestServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, ValidateTestService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidateTestServiceServer is the server API for ValidateTestService service.
+// All implementations must embed UnimplementedValidateTestServiceServer
+// for forward compatibility.
+//
+// ValidateTestService provides operations with validated requests
+type ValidateTestServiceServer interface {
+	// CreateUser creates a user
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	mustEmbedUnimplementedValidateTestServiceServer()
+}
+
+// UnimplementedValidateTestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedValidateTestServiceServer struct{}
+
+func (UnimplementedValidateTestServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedValidateTestServiceServer) mustEmbedUnimplementedValidateTestServiceServer() {}
+func (UnimplementedValidateTestServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeValidateTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ValidateTestServiceServer will
+// result in compilation errors.
+type UnsafeValidateTestServiceServer interface {
+	mustEmbedUnimplementedValidateTestServiceServer()
+}
+
+func RegisterValidateTestServiceServer(s grpc.ServiceRegistrar, srv ValidateTestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedValidateTestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ValidateTestService_ServiceDesc, srv)
+}
+
+func _ValidateTestService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidateTestServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidateTestService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidateTestServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ValidateTestService_ServiceDesc is the grpc.ServiceDesc for ValidateTestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ValidateTestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.ValidateTestService",
+	HandlerType: (*ValidateTestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _ValidateTestService_CreateUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/validate_test.proto",
+}